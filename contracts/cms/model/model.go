@@ -2,6 +2,7 @@ package model
 
 import (
 	"time"
+	unionModel "younified-backend/contracts/union/model"
 	"younified-backend/contracts/user/model"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -17,9 +18,8 @@ type News struct {
 	Dislikes     []primitive.ObjectID `json:"dislikes" bson:"dislikes"`
 	Images       []string             `json:"images,omitempty" bson:"images,omitempty"`
 	Deleted      bool                 `json:"deleted,omitempty" bson:"deleted"`
+	DeletedAt    time.Time            `json:"deletedAt,omitempty" bson:"deletedAt,omitempty"`
 	CommentIDs   []primitive.ObjectID `json:"commentIDs" bson:"commentIDs"`
-	Creator      model.User
-	LikedBy      []model.User
 	Comments     []*Comment
 	Documents    []*Document `json:"documents,omitempty" bson:"documents,omitempty"`
 	Pinned       bool        `json:"pinned,omitempty" bson:"pinned,omitempty"`
@@ -37,13 +37,52 @@ type Comment struct {
 	NewsID    primitive.ObjectID   `json:"newsID,omitempty" bson:"newsID,omitempty"`
 	Content   string               `json:"content,omitempty" bson:"content,omitempty"`
 	UserID    primitive.ObjectID   `json:"userID,omitempty" bson:"userID,omitempty"`
-	Creator   *model.User          `json:"creator,omitempty" bson:"creator,omitempty"`
 	Deleted   bool                 `json:"deleted" bson:"deleted"`
+	DeletedAt time.Time            `json:"deletedAt,omitempty" bson:"deletedAt,omitempty"`
 	Likes     []primitive.ObjectID `json:"likes" bson:"likes"`
 	Dislikes  []primitive.ObjectID `json:"dislikes" bson:"dislikes"`
 	CreatedOn time.Time            `json:"createdOn,omitempty" bson:"createdOn,omitempty"`
 }
 
+// Creator references the author, resolved by userService through the gateway
+func (n *News) Creator() *model.User {
+	return userRef(n.UserID)
+}
+
+// Units references the bargaining units the post is targeted at, resolved by
+// unionService through the gateway. Targets that are not unit ids yet are skipped.
+func (n *News) Units() []*unionModel.BargainingUnit {
+	units := make([]*unionModel.BargainingUnit, 0, len(n.Unit))
+	for _, target := range n.Unit {
+		if id, err := primitive.ObjectIDFromHex(target); err == nil {
+			units = append(units, &unionModel.BargainingUnit{ID: id})
+		}
+	}
+	return units
+}
+
+// LikedBy references the members who liked the post
+func (n *News) LikedBy() []*model.User {
+	users := make([]*model.User, 0, len(n.Likes))
+	for _, id := range n.Likes {
+		users = append(users, userRef(id))
+	}
+	return users
+}
+
+// Creator references the author, resolved by userService through the gateway
+func (c *Comment) Creator() *model.User {
+	return userRef(c.UserID)
+}
+
+// userRef is a User entity reference; every other field comes from userService
+func userRef(id primitive.ObjectID) *model.User {
+	if id.IsZero() {
+		return nil
+	}
+	return &model.User{ID: id}
+}
+
 type Report struct {
 	Data   []*News
 	Pinned []*News
@@ -64,5 +103,41 @@ type Blog struct {
 	Images    []string           `json:"images,omitempty" bson:"images,omitempty"`
 	CreatedOn time.Time          `json:"createdOn,omitempty" bson:"createdOn,omitempty"`
 	Deleted   bool               `json:"deleted" bson:"deleted"`
+	DeletedAt time.Time          `json:"deletedAt,omitempty" bson:"deletedAt,omitempty"`
 	Featured  bool               `json:"featured" bson:"featured"`
-}
\ No newline at end of file
+}
+
+type MemberContent struct {
+	News          []*News              `json:"news"`
+	Comments      []*Comment           `json:"comments"`
+	LikedNews     []primitive.ObjectID `json:"likedNews"`
+	LikedComments []primitive.ObjectID `json:"likedComments"`
+}
+
+type AnonymiseMemberInput struct {
+	UnionID       primitive.ObjectID `json:"unionID"`
+	UserID        primitive.ObjectID `json:"userID"`
+	ReplacementID primitive.ObjectID `json:"replacementID"`
+}
+
+type AnonymiseReport struct {
+	News     int `json:"news"`
+	Comments int `json:"comments"`
+	Likes    int `json:"likes"`
+}
+
+type PurgeReport struct {
+	UnionID primitive.ObjectID `json:"unionID"`
+	DryRun  bool               `json:"dryRun"`
+	RanAt   time.Time          `json:"ranAt"`
+	Items   []*PurgeItem       `json:"items"`
+}
+
+type PurgeItem struct {
+	Collection    string    `json:"collection"`
+	RetentionDays int       `json:"retentionDays"`
+	Cutoff        time.Time `json:"cutoff"`
+	Matched       int       `json:"matched"`
+	Purged        int       `json:"purged"`
+	MediaDeleted  int       `json:"mediaDeleted"`
+}
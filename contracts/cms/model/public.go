@@ -0,0 +1,61 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PublicContent is what a union's public page shows. It holds no private or
+// unit-targeted posts and nothing about members: no authors, likes or comments.
+type PublicContent struct {
+	Pinned []*PublicNews `json:"pinned"`
+	News   []*PublicNews `json:"news"`
+	Blogs  []*PublicBlog `json:"blogs"`
+}
+
+// PublicNews is a news post as anyone may see it
+type PublicNews struct {
+	ID        primitive.ObjectID `json:"id"`
+	Content   string             `json:"content,omitempty"`
+	Images    []string           `json:"images,omitempty"`
+	Documents []*Document        `json:"documents,omitempty"`
+	Category  string             `json:"category,omitempty"`
+	Pinned    bool               `json:"pinned"`
+	CreatedOn time.Time          `json:"createdOn"`
+}
+
+// PublicBlog is a featured blog post as anyone may see it
+type PublicBlog struct {
+	ID        primitive.ObjectID `json:"id"`
+	Header    string             `json:"header,omitempty"`
+	SubHeader string             `json:"subHeader,omitempty"`
+	Content   string             `json:"content,omitempty"`
+	Images    []string           `json:"images,omitempty"`
+	CreatedOn time.Time          `json:"createdOn"`
+}
+
+// PublicNewsOf returns the public view of a post
+func PublicNewsOf(n *News) *PublicNews {
+	return &PublicNews{
+		ID:        n.ID,
+		Content:   n.Content,
+		Images:    n.Images,
+		Documents: n.Documents,
+		Category:  n.Category,
+		Pinned:    n.Pinned,
+		CreatedOn: n.CreatedOn,
+	}
+}
+
+// PublicBlogOf returns the public view of a blog post
+func PublicBlogOf(b *Blog) *PublicBlog {
+	return &PublicBlog{
+		ID:        b.ID,
+		Header:    b.Header,
+		SubHeader: b.SubHeader,
+		Content:   b.Content,
+		Images:    b.Images,
+		CreatedOn: b.CreatedOn,
+	}
+}
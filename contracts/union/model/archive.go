@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+// Archive states of a deleted union. A live union has none.
+const (
+	// ArchivePending is a deleted union waiting for the archive job
+	ArchivePending = "pending"
+	ArchiveRunning = "archiving"
+	// ArchiveDone is a union whose tenant database was exported and dropped
+	ArchiveDone      = "archived"
+	ArchiveRestoring = "restoring"
+)
+
+// UnionArchive is the export of a deleted union's tenant database in object storage
+type UnionArchive struct {
+	// Bucket is the private bucket of the archive; empty for archives made before there was one
+	Bucket      string     `json:"-" bson:"bucket,omitempty"`
+	Key         string     `json:"key,omitempty" bson:"key,omitempty"`
+	SizeBytes   int64      `json:"sizeBytes,omitempty" bson:"sizeBytes,omitempty"`
+	Collections int        `json:"collections,omitempty" bson:"collections,omitempty"`
+	Documents   int64      `json:"documents,omitempty" bson:"documents,omitempty"`
+	StartedOn   *time.Time `json:"startedOn,omitempty" bson:"startedOn,omitempty"`
+	ArchivedOn  *time.Time `json:"archivedOn,omitempty" bson:"archivedOn,omitempty"`
+	RestoredOn  *time.Time `json:"restoredOn,omitempty" bson:"restoredOn,omitempty"`
+	// Error is why the last archive or restore attempt failed
+	Error string `json:"error,omitempty" bson:"error,omitempty"`
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// BargainingUnit is a group of members covered by one collective agreement. Sub-units
+// name their parent. Members and news reference a unit by id, so renaming it changes
+// nothing else.
+type BargainingUnit struct {
+	ID           primitive.ObjectID  `json:"id,omitempty" bson:"_id,omitempty"`
+	UnionID      primitive.ObjectID  `json:"unionID,omitempty" bson:"unionID"`
+	Name         string              `json:"name" bson:"name"`
+	Employer     string              `json:"employer,omitempty" bson:"employer,omitempty"`
+	AgreementRef string              `json:"agreementRef,omitempty" bson:"agreementRef,omitempty"`
+	ParentID     *primitive.ObjectID `json:"parentID,omitempty" bson:"parentID,omitempty"`
+	CreatedOn    time.Time           `json:"createdOn,omitempty" bson:"createdOn"`
+	UpdatedOn    time.Time           `json:"updatedOn,omitempty" bson:"updatedOn,omitempty"`
+}
+
+// IsEntity marks BargainingUnit as a federation entity resolved by unionService
+func (BargainingUnit) IsEntity() {}
+
+// BargainingUnitInput creates or updates a bargaining unit
+type BargainingUnitInput struct {
+	Name         string              `json:"name"`
+	Employer     string              `json:"employer,omitempty"`
+	AgreementRef string              `json:"agreementRef,omitempty"`
+	ParentID     *primitive.ObjectID `json:"parentID,omitempty"`
+}
+
+// BargainingUnitCount is the membership of a unit: Members are assigned to the unit
+// itself, TotalMembers adds those of its sub-units
+type BargainingUnitCount struct {
+	Unit         *BargainingUnit `json:"unit"`
+	Members      int             `json:"members"`
+	TotalMembers int             `json:"totalMembers"`
+}
+
+// UnitMigrationReport is what moving a union from unit names to unit entities did
+type UnitMigrationReport struct {
+	UnionID         primitive.ObjectID `json:"unionID"`
+	UnitsCreated    int                `json:"unitsCreated"`
+	MembersAssigned int                `json:"membersAssigned"`
+	NewsUpdated     int                `json:"newsUpdated"`
+	Unmatched       []string           `json:"unmatched"`
+}
@@ -0,0 +1,57 @@
+package model
+
+import "strings"
+
+// UnionDomainInput sets where a union is served and who may register with it. A nil
+// field keeps the current value.
+type UnionDomainInput struct {
+	Domain             *string  `json:"domain,omitempty"`
+	BannedDomains      []string `json:"bannedDomains,omitempty"`
+	EmailDomains       []string `json:"emailDomains,omitempty"`
+	RequireEmailDomain *bool    `json:"requireEmailDomain,omitempty"`
+}
+
+// EmailDomain returns the lowercased domain of an email address, or "" when it has none
+func EmailDomain(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return ""
+	}
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(email[at+1:])), ".")
+}
+
+// BansEmail reports whether the domain of email, or a parent of it, is banned from
+// registering with the union
+func (u *Union) BansEmail(email string) bool {
+	domain := EmailDomain(email)
+	for _, banned := range u.BannedDomains {
+		if banned != nil && matchesDomain(domain, *banned) {
+			return true
+		}
+	}
+	return false
+}
+
+// AcceptsEmail reports whether email may self-register. Unions requiring an email
+// domain only accept addresses in one of their email domains.
+func (u *Union) AcceptsEmail(email string) bool {
+	if !u.RequireEmailDomain {
+		return true
+	}
+	domain := EmailDomain(email)
+	for _, allowed := range u.EmailDomains {
+		if matchesDomain(domain, allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesDomain reports whether domain is pattern or one of its subdomains
+func matchesDomain(domain, pattern string) bool {
+	pattern = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(pattern)), "@")
+	if domain == "" || pattern == "" {
+		return false
+	}
+	return domain == pattern || strings.HasSuffix(domain, "."+pattern)
+}
@@ -0,0 +1,14 @@
+package model
+
+// Roles a staff member can hold for a union
+const (
+	ManagerRoleAccount       = "account manager"
+	ManagerRoleCommunication = "communication rep"
+)
+
+// ManagerPortfolio lists the unions a staff member is responsible for
+type ManagerPortfolio struct {
+	Manager            *Manager `json:"manager"`
+	AccountManagerOf   []*Union `json:"accountManagerOf"`
+	CommunicationRepOf []*Union `json:"communicationRepOf"`
+}
@@ -48,24 +48,38 @@ type Union struct {
 	DefaultUser          DefaultUserInfo      `json:"defaultUser,omitempty" bson:"defaultUser,omitempty"`
 	AccountManagerID     []primitive.ObjectID `json:"accountManager,omitempty" bson:"accountManager,omitempty"`
 	CommunicationRepID   []primitive.ObjectID `json:"communicationRep,omitempty" bson:"communicationRep,omitempty"`
-	AccountManager       []*Manager
-	CommunicationRep     []*Manager
-	CallDropNumber       string        `json:"callDropNumber,omitempty" bson:"callDropNumber,omitempty"`
-	Domain               string        `json:"domain,omitempty" bson:"domain,omitempty"`
-	BannedDomains        []*string     `json:"bannedDomains,omitempty" bson:"bannedDomains,omitempty"`
-	Theme                string        `json:"theme,omitempty" bson:"theme,omitempty"`
-	Twitter              string        `json:"twitter,omitempty" bson:"twitter,omitempty"`
-	TwitterLinks         []*string     `json:"twitterLinks,omitempty" bson:"twitterLinks,omitempty"`
-	Facebook             string        `json:"facebook,omitempty" bson:"facebook,omitempty"`
-	FacebookLinks        []*string     `json:"facebookLinks,omitempty" bson:"facebookLinks,omitempty"`
-	Instagram            string        `json:"instagram,omitempty" bson:"instagram,omitempty"`
-	InstagramLinks       []*string     `json:"instagramLinks,omitempty" bson:"instagramLinks,omitempty"`
-	FirstUser            FirstUserInfo `json:"firstUser,omitempty" bson:"firstUser,omitempty"`
-	ThemeImage           string        `json:"themeImage,omitempty" bson:"themeImage,omitempty"`
-	ZoomID               string        `json:"zoomID,omitempty" bson:"zoomID,omitempty"`
-	HostEmail            *bool         `json:"hostEmail,omitempty" bson:"hostEmail,omitempty"`
-	DefaultEmailPassword string        `json:"defaultEmailPassword,omitempty" bson:"defaultEmailPassword,omitempty"`
-	DeletedAt            time.Time     `json:"deletedAt,omitempty" bson:"deletedAt,omitempty"`
+	CallDropNumber       string               `json:"callDropNumber,omitempty" bson:"callDropNumber,omitempty"`
+	Domain               string               `json:"domain,omitempty" bson:"domain,omitempty"`
+	BannedDomains        []*string            `json:"bannedDomains,omitempty" bson:"bannedDomains,omitempty"`
+	Theme                string               `json:"theme,omitempty" bson:"theme,omitempty"`
+	Twitter              string               `json:"twitter,omitempty" bson:"twitter,omitempty"`
+	TwitterLinks         []*string            `json:"twitterLinks,omitempty" bson:"twitterLinks,omitempty"`
+	Facebook             string               `json:"facebook,omitempty" bson:"facebook,omitempty"`
+	FacebookLinks        []*string            `json:"facebookLinks,omitempty" bson:"facebookLinks,omitempty"`
+	Instagram            string               `json:"instagram,omitempty" bson:"instagram,omitempty"`
+	InstagramLinks       []*string            `json:"instagramLinks,omitempty" bson:"instagramLinks,omitempty"`
+	FirstUser            FirstUserInfo        `json:"firstUser,omitempty" bson:"firstUser,omitempty"`
+	ThemeImage           string               `json:"themeImage,omitempty" bson:"themeImage,omitempty"`
+	ZoomID               string               `json:"zoomID,omitempty" bson:"zoomID,omitempty"`
+	HostEmail            *bool                `json:"hostEmail,omitempty" bson:"hostEmail,omitempty"`
+	DefaultEmailPassword string               `json:"defaultEmailPassword,omitempty" bson:"defaultEmailPassword,omitempty"`
+	DeletedAt            time.Time            `json:"deletedAt,omitempty" bson:"deletedAt,omitempty"`
+	RetentionPolicy      *RetentionPolicy     `json:"retentionPolicy,omitempty" bson:"retentionPolicy,omitempty"`
+	ArchiveStatus        string               `json:"archiveStatus,omitempty" bson:"archiveStatus,omitempty"`
+	Archive              *UnionArchive        `json:"archive,omitempty" bson:"archive,omitempty"`
+	EmailDomains         []string             `json:"emailDomains,omitempty" bson:"emailDomains,omitempty"`
+	RequireEmailDomain   bool                 `json:"requireEmailDomain,omitempty" bson:"requireEmailDomain,omitempty"`
+	SlugAliases          []string             `json:"slugAliases,omitempty" bson:"slugAliases,omitempty"`
+	Database             string               `json:"database,omitempty" bson:"database,omitempty"`
+}
+
+// DatabaseName is the name of the union's tenant database: the slug it was created
+// with, which Database keeps once the slug changes
+func (u *Union) DatabaseName() string {
+	if u.Database != "" {
+		return u.Database
+	}
+	return u.UnionID
 }
 
 type UnionsResponse struct {
@@ -101,6 +115,8 @@ type DefaultUserInfo struct {
 	Level    int    `json:"level,omitempty"`
 }
 
+// Manager is a staff member looking after a union; the details come from their user
+// in userService
 type Manager struct {
 	ID         primitive.ObjectID `json:"id,omitempty" bson:"_id,omitempty"`
 	FirstName  string             `json:"firstName,omitempty" bson:"firstname,omitempty"`
@@ -0,0 +1,37 @@
+package model
+
+// Modules a union can be entitled to. Services check a union's modules before they
+// serve the feature.
+const (
+	ModuleNews          = "news"
+	ModuleBlogs         = "blogs"
+	ModulePicketing     = "picketing"
+	ModuleDues          = "dues"
+	ModuleCommunication = "communication"
+)
+
+// Modules lists every module that can be enabled
+var Modules = []string{ModuleNews, ModuleBlogs, ModulePicketing, ModuleDues, ModuleCommunication}
+
+// DefaultModules are enabled on a new union
+var DefaultModules = []string{ModuleNews, ModuleBlogs}
+
+// KnownModule reports whether name is a module that can be enabled
+func KnownModule(name string) bool {
+	for _, module := range Modules {
+		if module == name {
+			return true
+		}
+	}
+	return false
+}
+
+// HasModule reports whether the union is entitled to module
+func (u *Union) HasModule(module string) bool {
+	for _, m := range u.Modules {
+		if m != nil && *m == module {
+			return true
+		}
+	}
+	return false
+}
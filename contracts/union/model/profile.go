@@ -0,0 +1,58 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PublicUnionProfile is everything a union's public page shows. It is the same for
+// every caller, so it can be cached anywhere; nothing private or about members goes in.
+type PublicUnionProfile struct {
+	Slug        string        `json:"slug"`
+	Name        string        `json:"name"`
+	Information UnionInfo     `json:"information"`
+	Social      SocialLinks   `json:"social"`
+	Theme       *UnionTheme   `json:"theme"`
+	PinnedNews  []*PublicNews `json:"pinnedNews"`
+	News        []*PublicNews `json:"news"`
+	Blogs       []*PublicBlog `json:"blogs"`
+	GeneratedOn time.Time     `json:"generatedOn"`
+}
+
+// SocialLinks are the union's social media accounts
+type SocialLinks struct {
+	Twitter        string   `json:"twitter,omitempty"`
+	TwitterLinks   []string `json:"twitterLinks,omitempty"`
+	Facebook       string   `json:"facebook,omitempty"`
+	FacebookLinks  []string `json:"facebookLinks,omitempty"`
+	Instagram      string   `json:"instagram,omitempty"`
+	InstagramLinks []string `json:"instagramLinks,omitempty"`
+}
+
+// PublicNews is a public news post of the union, as cmsService shares it
+type PublicNews struct {
+	ID        primitive.ObjectID `json:"id"`
+	Content   string             `json:"content,omitempty"`
+	Images    []string           `json:"images,omitempty"`
+	Documents []*PublicDocument  `json:"documents,omitempty"`
+	Category  string             `json:"category,omitempty"`
+	Pinned    bool               `json:"pinned"`
+	CreatedOn time.Time          `json:"createdOn"`
+}
+
+// PublicDocument is a file attached to a public news post
+type PublicDocument struct {
+	URL  string `json:"url,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// PublicBlog is a featured blog post, as cmsService shares it
+type PublicBlog struct {
+	ID        primitive.ObjectID `json:"id"`
+	Header    string             `json:"header,omitempty"`
+	SubHeader string             `json:"subHeader,omitempty"`
+	Content   string             `json:"content,omitempty"`
+	Images    []string           `json:"images,omitempty"`
+	CreatedOn time.Time          `json:"createdOn"`
+}
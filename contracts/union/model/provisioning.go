@@ -0,0 +1,80 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Provisioning steps, run in this order
+const (
+	StepUnionRecord     = "union_record"
+	StepTenantDatabase  = "tenant_database"
+	StepSeedCollections = "seed_collections"
+	StepFirstAdmin      = "first_admin"
+	StepDefaultUser     = "default_user"
+	StepWelcomeEmail    = "welcome_email"
+)
+
+// ProvisioningSteps is the order a new union is set up in
+var ProvisioningSteps = []string{
+	StepUnionRecord, StepTenantDatabase, StepSeedCollections,
+	StepFirstAdmin, StepDefaultUser, StepWelcomeEmail,
+}
+
+// Workflow states
+const (
+	ProvisioningPending   = "pending"
+	ProvisioningRunning   = "running"
+	ProvisioningCompleted = "completed"
+	// ProvisioningFailed means a step failed for good and the steps before it were undone
+	ProvisioningFailed = "failed"
+)
+
+// Step states
+const (
+	StepPending = "pending"
+	StepRunning = "running"
+	StepDone    = "done"
+	StepFailed  = "failed"
+	StepUndone  = "undone"
+	// StepSkipped is an optional step that failed without failing the workflow
+	StepSkipped = "skipped"
+)
+
+// ProvisioningWorkflow is the persisted progress of setting up a union, so that a
+// restarted service picks it up where it stopped
+type ProvisioningWorkflow struct {
+	ID      primitive.ObjectID  `json:"id,omitempty" bson:"_id,omitempty"`
+	UnionID primitive.ObjectID  `json:"unionID" bson:"unionID"`
+	Slug    string              `json:"slug" bson:"slug"`
+	Status  string              `json:"status" bson:"status"`
+	Steps   []*ProvisioningStep `json:"steps" bson:"steps"`
+	Error   string              `json:"error,omitempty" bson:"error,omitempty"`
+	// Union is the record the first step inserts
+	Union Union `json:"-" bson:"union"`
+	// LeaseUntil keeps other instances off a workflow that is being run
+	LeaseUntil  time.Time  `json:"-" bson:"leaseUntil"`
+	CreatedOn   time.Time  `json:"createdOn" bson:"createdOn"`
+	UpdatedOn   time.Time  `json:"updatedOn" bson:"updatedOn"`
+	CompletedOn *time.Time `json:"completedOn,omitempty" bson:"completedOn,omitempty"`
+}
+
+// Step returns the step called name
+func (w *ProvisioningWorkflow) Step(name string) *ProvisioningStep {
+	for _, step := range w.Steps {
+		if step.Name == name {
+			return step
+		}
+	}
+	return nil
+}
+
+type ProvisioningStep struct {
+	Name        string     `json:"name" bson:"name"`
+	Status      string     `json:"status" bson:"status"`
+	Attempts    int        `json:"attempts" bson:"attempts"`
+	Error       string     `json:"error,omitempty" bson:"error,omitempty"`
+	StartedOn   *time.Time `json:"startedOn,omitempty" bson:"startedOn,omitempty"`
+	CompletedOn *time.Time `json:"completedOn,omitempty" bson:"completedOn,omitempty"`
+}
@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// RetentionPolicy says how many days soft-deleted records are kept before the
+// purge jobs remove them. Zero keeps them forever.
+type RetentionPolicy struct {
+	DeletedUsersDays    int       `json:"deletedUsersDays" bson:"deletedUsersDays"`
+	DeletedNewsDays     int       `json:"deletedNewsDays" bson:"deletedNewsDays"`
+	DeletedCommentsDays int       `json:"deletedCommentsDays" bson:"deletedCommentsDays"`
+	UpdatedAt           time.Time `json:"updatedAt,omitempty" bson:"updatedAt,omitempty"`
+}
+
+// Cutoff returns the deletion time before which records are due, and false when
+// the policy keeps them forever
+func Cutoff(days int, now time.Time) (time.Time, bool) {
+	if days <= 0 {
+		return time.Time{}, false
+	}
+	return now.AddDate(0, 0, -days), true
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// UnionSettings are the settings a union overrides; every other setting has the
+// default of the schema
+type UnionSettings struct {
+	UnionID   primitive.ObjectID `json:"unionID" bson:"_id"`
+	Overrides []*SettingOverride `json:"overrides" bson:"overrides"`
+	// Migrations lists the settings migrations already applied to the union
+	Migrations []string `json:"migrations,omitempty" bson:"migrations,omitempty"`
+}
+
+// SettingOverride is a union's value of one setting, typed as the schema says
+type SettingOverride struct {
+	Key       string             `json:"key" bson:"key"`
+	Value     interface{}        `json:"value" bson:"value"`
+	UpdatedBy primitive.ObjectID `json:"updatedBy,omitempty" bson:"updatedBy,omitempty"`
+	UpdatedOn time.Time          `json:"updatedOn" bson:"updatedOn"`
+}
+
+// UnionSetting is one setting of the schema as a union has it. Values are written as
+// text: true or false, a whole number, or the string itself.
+type UnionSetting struct {
+	Key         string     `json:"key"`
+	Type        string     `json:"type"`
+	Description string     `json:"description"`
+	Value       string     `json:"value"`
+	Default     string     `json:"default"`
+	Overridden  bool       `json:"overridden"`
+	Options     []string   `json:"options,omitempty"`
+	Min         *int       `json:"min,omitempty"`
+	Max         *int       `json:"max,omitempty"`
+	MaxLength   *int       `json:"maxLength,omitempty"`
+	UpdatedOn   *time.Time `json:"updatedOn,omitempty"`
+}
+
+// UnionSettingInput changes one setting; a nil value goes back to the default
+type UnionSettingInput struct {
+	Key   string  `json:"key"`
+	Value *string `json:"value,omitempty"`
+}
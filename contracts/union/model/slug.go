@@ -0,0 +1,18 @@
+package model
+
+// SlugResolution is the union a slug names; Redirect is set when the slug is a former
+// one and clients should move to Slug
+type SlugResolution struct {
+	Union    *Union `json:"union"`
+	Slug     string `json:"slug"`
+	Redirect bool   `json:"redirect"`
+}
+
+// SlugAvailability tells whether a slug can be given to a union; Suggestion is the
+// closest free slug when it can't
+type SlugAvailability struct {
+	Slug       string `json:"slug"`
+	Available  bool   `json:"available"`
+	Reason     string `json:"reason,omitempty"`
+	Suggestion string `json:"suggestion,omitempty"`
+}
@@ -0,0 +1,102 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Image slots of a theme
+const (
+	ThemeImageLogo        = "logo"
+	ThemeImageLogoInverse = "logoInverse"
+	ThemeImageIcon        = "icon"
+	ThemeImageBanner      = "banner"
+	ThemeImageEmailHeader = "emailHeader"
+)
+
+// ThemeImageSlots lists every image a theme can hold
+var ThemeImageSlots = []string{ThemeImageLogo, ThemeImageLogoInverse, ThemeImageIcon, ThemeImageBanner, ThemeImageEmailHeader}
+
+// UnionTheme is one version of a union's branding. Every change saves a new version;
+// the latest is the one clients get.
+type UnionTheme struct {
+	ID        primitive.ObjectID  `json:"id,omitempty" bson:"_id,omitempty"`
+	UnionID   primitive.ObjectID  `json:"unionID" bson:"unionID"`
+	Version   int                 `json:"version" bson:"version"`
+	Palette   ThemePalette        `json:"palette" bson:"palette"`
+	Fonts     ThemeFonts          `json:"fonts" bson:"fonts"`
+	Email     ThemeEmail          `json:"email" bson:"email"`
+	Images    []*ThemeImage       `json:"images" bson:"images"`
+	Note      string              `json:"note,omitempty" bson:"note,omitempty"`
+	CreatedBy *primitive.ObjectID `json:"createdBy,omitempty" bson:"createdBy,omitempty"`
+	CreatedOn time.Time           `json:"createdOn" bson:"createdOn"`
+}
+
+// ThemePalette holds the theme colours as #rrggbb
+type ThemePalette struct {
+	Primary    string `json:"primary,omitempty" bson:"primary,omitempty"`
+	Secondary  string `json:"secondary,omitempty" bson:"secondary,omitempty"`
+	Accent     string `json:"accent,omitempty" bson:"accent,omitempty"`
+	Background string `json:"background,omitempty" bson:"background,omitempty"`
+	Text       string `json:"text,omitempty" bson:"text,omitempty"`
+}
+
+// ThemeFonts names the font families of headings and body text
+type ThemeFonts struct {
+	Heading string `json:"heading,omitempty" bson:"heading,omitempty"`
+	Body    string `json:"body,omitempty" bson:"body,omitempty"`
+}
+
+// ThemeEmail brands the emails sent on behalf of the union; the header image is the
+// emailHeader slot
+type ThemeEmail struct {
+	HeaderText string `json:"headerText,omitempty" bson:"headerText,omitempty"`
+	FooterText string `json:"footerText,omitempty" bson:"footerText,omitempty"`
+}
+
+// ThemeImage is an uploaded image of a theme. Versions share images, so the object
+// stays in the bucket when a later version replaces it.
+type ThemeImage struct {
+	Slot   string `json:"slot" bson:"slot"`
+	URL    string `json:"url" bson:"url"`
+	Key    string `json:"-" bson:"key"`
+	Width  int    `json:"width" bson:"width"`
+	Height int    `json:"height" bson:"height"`
+}
+
+// UnionThemeInput changes a theme; nil parts are kept from the current version
+type UnionThemeInput struct {
+	Palette *ThemePalette `json:"palette,omitempty"`
+	Fonts   *ThemeFonts   `json:"fonts,omitempty"`
+	Email   *ThemeEmail   `json:"email,omitempty"`
+	Note    *string       `json:"note,omitempty"`
+}
+
+// Image returns the image of slot, or nil when the theme has none
+func (t *UnionTheme) Image(slot string) *ThemeImage {
+	for _, image := range t.Images {
+		if image != nil && image.Slot == slot {
+			return image
+		}
+	}
+	return nil
+}
+
+// Public returns the theme without who made it or why, for unauthenticated clients
+func (t *UnionTheme) Public() *UnionTheme {
+	public := *t
+	public.CreatedBy = nil
+	public.Note = ""
+	return &public
+}
+
+// KnownThemeImage reports whether slot is an image slot of a theme
+func KnownThemeImage(slot string) bool {
+	for _, known := range ThemeImageSlots {
+		if known == slot {
+			return true
+		}
+	}
+	return false
+}
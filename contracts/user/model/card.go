@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// MembershipCard is a freshly rendered card with the token embedded in its QR code
+type MembershipCard struct {
+	Token           string    `json:"token"`
+	ExpiresAt       time.Time `json:"expiresAt"`
+	VerificationURL string    `json:"verificationURL,omitempty"`
+	Png             string    `json:"png"`
+	Pdf             string    `json:"pdf"`
+}
+
+// MembershipVerification is the public answer for a scanned card
+type MembershipVerification struct {
+	Valid     bool   `json:"valid"`
+	UnionName string `json:"unionName,omitempty"`
+}
@@ -0,0 +1,231 @@
+package model
+
+import (
+	"math"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Dues calculation methods
+const (
+	DuesMethodFlat       = "flat"
+	DuesMethodPercentage = "percentage"
+)
+
+// Dues ledger entry types. Assessments are positive, payments negative and
+// adjustments either way.
+const (
+	DuesEntryAssessment = "assessment"
+	DuesEntryPayment    = "payment"
+	DuesEntryAdjustment = "adjustment"
+)
+
+// DuesPeriodLayout formats the monthly period dues are assessed for
+const DuesPeriodLayout = "2006-01"
+
+// DuesSchedule sets the monthly dues of the members matching its membership and
+// employment type. An empty type matches every member.
+type DuesSchedule struct {
+	ID             primitive.ObjectID `json:"id,omitempty" bson:"_id,omitempty"`
+	UnionID        primitive.ObjectID `json:"unionID,omitempty" bson:"unionID"`
+	Name           string             `json:"name,omitempty" bson:"name"`
+	MembershipType string             `json:"membershipType,omitempty" bson:"membershipType,omitempty"`
+	EmploymentType string             `json:"employmentType,omitempty" bson:"employmentType,omitempty"`
+	Method         string             `json:"method,omitempty" bson:"method"`
+	Amount         float64            `json:"amount" bson:"amount"`
+	Percentage     float64            `json:"percentage" bson:"percentage"`
+	MinAmount      float64            `json:"minAmount" bson:"minAmount"`
+	MaxAmount      float64            `json:"maxAmount" bson:"maxAmount"`
+	GracePeriods   int                `json:"gracePeriods" bson:"gracePeriods"`
+	EffectiveFrom  string             `json:"effectiveFrom,omitempty" bson:"effectiveFrom"`
+	EffectiveTo    string             `json:"effectiveTo,omitempty" bson:"effectiveTo,omitempty"`
+	Active         bool               `json:"active" bson:"active"`
+	CreatedOn      time.Time          `json:"createdOn,omitempty" bson:"createdOn"`
+	UpdatedOn      time.Time          `json:"updatedOn,omitempty" bson:"updatedOn,omitempty"`
+}
+
+// Matches reports whether the schedule applies to user in period, and how specific
+// the match is
+func (s *DuesSchedule) Matches(user *User, period string) (bool, int) {
+	if !s.Active || period < s.EffectiveFrom || (s.EffectiveTo != "" && period > s.EffectiveTo) {
+		return false, 0
+	}
+	specificity := 0
+	if s.MembershipType != "" {
+		if s.MembershipType != user.MembershipType {
+			return false, 0
+		}
+		specificity++
+	}
+	if s.EmploymentType != "" {
+		if s.EmploymentType != user.EmploymentType {
+			return false, 0
+		}
+		specificity++
+	}
+	return true, specificity
+}
+
+// Assess returns the dues owed for a period. Percentage schedules need the member's
+// earnings for the period.
+func (s *DuesSchedule) Assess(earnings float64) float64 {
+	amount := s.Amount
+	if s.Method == DuesMethodPercentage {
+		amount = earnings * s.Percentage / 100
+	}
+	if s.MinAmount > 0 && amount < s.MinAmount {
+		amount = s.MinAmount
+	}
+	if s.MaxAmount > 0 && amount > s.MaxAmount {
+		amount = s.MaxAmount
+	}
+	return RoundCents(amount)
+}
+
+// DuesScheduleInput creates or updates a dues schedule
+type DuesScheduleInput struct {
+	Name           *string  `json:"name,omitempty"`
+	MembershipType *string  `json:"membershipType,omitempty"`
+	EmploymentType *string  `json:"employmentType,omitempty"`
+	Method         *string  `json:"method,omitempty"`
+	Amount         *float64 `json:"amount,omitempty"`
+	Percentage     *float64 `json:"percentage,omitempty"`
+	MinAmount      *float64 `json:"minAmount,omitempty"`
+	MaxAmount      *float64 `json:"maxAmount,omitempty"`
+	GracePeriods   *int     `json:"gracePeriods,omitempty"`
+	EffectiveFrom  *string  `json:"effectiveFrom,omitempty"`
+	EffectiveTo    *string  `json:"effectiveTo,omitempty"`
+	Active         *bool    `json:"active,omitempty"`
+}
+
+// DuesLedgerEntry is one movement on a member's dues account
+type DuesLedgerEntry struct {
+	ID            primitive.ObjectID `json:"id,omitempty" bson:"_id,omitempty"`
+	UnionID       primitive.ObjectID `json:"unionID,omitempty" bson:"unionID"`
+	UserID        primitive.ObjectID `json:"userID,omitempty" bson:"userID"`
+	Type          string             `json:"type,omitempty" bson:"type"`
+	Period        string             `json:"period,omitempty" bson:"period"`
+	Amount        float64            `json:"amount" bson:"amount"`
+	Description   string             `json:"description,omitempty" bson:"description,omitempty"`
+	Reference     string             `json:"reference,omitempty" bson:"reference,omitempty"`
+	ScheduleID    primitive.ObjectID `json:"scheduleID,omitempty" bson:"scheduleID,omitempty"`
+	RecordedBy    primitive.ObjectID `json:"recordedBy,omitempty" bson:"recordedBy,omitempty"`
+	EffectiveDate time.Time          `json:"effectiveDate,omitempty" bson:"effectiveDate"`
+	CreatedOn     time.Time          `json:"createdOn,omitempty" bson:"createdOn"`
+}
+
+// DuesEntryInput records a payment or an adjustment. Payments are given as positive
+// amounts and booked as credits.
+type DuesEntryInput struct {
+	UserID        primitive.ObjectID `json:"userID"`
+	Type          string             `json:"type"`
+	Amount        float64            `json:"amount"`
+	Period        *string            `json:"period,omitempty"`
+	Description   *string            `json:"description,omitempty"`
+	Reference     *string            `json:"reference,omitempty"`
+	EffectiveDate *time.Time         `json:"effectiveDate,omitempty"`
+}
+
+// MemberEarningsInput gives the earnings percentage dues are assessed on
+type MemberEarningsInput struct {
+	UserID   primitive.ObjectID `json:"userID"`
+	Earnings float64            `json:"earnings"`
+}
+
+// DuesStanding summarises a member's dues account. It is stored on the user so other
+// features can read it without going through the ledger.
+type DuesStanding struct {
+	Balance            float64   `json:"balance" bson:"balance"`
+	ArrearsPeriods     int       `json:"arrearsPeriods" bson:"arrearsPeriods"`
+	OldestUnpaidPeriod string    `json:"oldestUnpaidPeriod,omitempty" bson:"oldestUnpaidPeriod,omitempty"`
+	GoodStanding       bool      `json:"goodStanding" bson:"goodStanding"`
+	UpdatedAt          time.Time `json:"updatedAt,omitempty" bson:"updatedAt"`
+}
+
+// DuesAssessmentReport is the outcome of assessing a period
+type DuesAssessmentReport struct {
+	Period   string      `json:"period"`
+	Assessed int         `json:"assessed"`
+	Amount   float64     `json:"amount"`
+	Skipped  []*DuesSkip `json:"skipped"`
+}
+
+// DuesSkip names a member left out of an assessment and why
+type DuesSkip struct {
+	UserID primitive.ObjectID `json:"userID"`
+	Reason string             `json:"reason"`
+}
+
+// DuesStatement lists a member's ledger between two dates
+type DuesStatement struct {
+	UnionID        primitive.ObjectID `json:"unionID"`
+	UserID         primitive.ObjectID `json:"userID"`
+	Name           string             `json:"name"`
+	EmployeeID     string             `json:"employeeID,omitempty"`
+	From           time.Time          `json:"from"`
+	To             time.Time          `json:"to"`
+	OpeningBalance float64            `json:"openingBalance"`
+	ClosingBalance float64            `json:"closingBalance"`
+	Entries        []*DuesLedgerEntry `json:"entries"`
+	Standing       *DuesStanding      `json:"standing,omitempty"`
+}
+
+// DuesArrears is one row of the arrears report
+type DuesArrears struct {
+	UserID             primitive.ObjectID `json:"userID"`
+	Name               string             `json:"name"`
+	EmployeeID         string             `json:"employeeID,omitempty"`
+	Balance            float64            `json:"balance"`
+	ArrearsPeriods     int                `json:"arrearsPeriods"`
+	OldestUnpaidPeriod string             `json:"oldestUnpaidPeriod,omitempty"`
+	GoodStanding       bool               `json:"goodStanding"`
+}
+
+// ComputeDuesStanding applies the member's credits to their oldest charges first.
+// Charges of periods before current that stay unpaid are arrears; a member stays in
+// good standing while no more than grace periods are in arrears.
+func ComputeDuesStanding(entries []*DuesLedgerEntry, current string, grace int, now time.Time) *DuesStanding {
+	standing := &DuesStanding{UpdatedAt: now}
+	credit := 0.0
+	charges := []*DuesLedgerEntry{}
+	for _, entry := range entries {
+		standing.Balance += entry.Amount
+		if entry.Amount < 0 {
+			credit -= entry.Amount
+		} else {
+			charges = append(charges, entry)
+		}
+	}
+	standing.Balance = RoundCents(standing.Balance)
+
+	// entries come ordered by period, so charges are oldest first
+	unpaid := map[string]bool{}
+	for _, charge := range charges {
+		if credit >= charge.Amount-0.005 {
+			credit -= charge.Amount
+			continue
+		}
+		credit = 0
+		if charge.Period >= current {
+			continue
+		}
+		if standing.OldestUnpaidPeriod == "" || charge.Period < standing.OldestUnpaidPeriod {
+			standing.OldestUnpaidPeriod = charge.Period
+		}
+		unpaid[charge.Period] = true
+	}
+	standing.ArrearsPeriods = len(unpaid)
+	standing.GoodStanding = standing.ArrearsPeriods <= grace
+	return standing
+}
+
+// DuesPeriod returns the dues period containing t
+func DuesPeriod(t time.Time) string {
+	return t.UTC().Format(DuesPeriodLayout)
+}
+
+// RoundCents rounds an amount of money to cents
+func RoundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Identity links the per-union user records of one person. It lives in the
+// unified_base database and is keyed by a verified email address.
+type Identity struct {
+	ID          primitive.ObjectID    `json:"id,omitempty" bson:"_id,omitempty"`
+	Email       string                `json:"email,omitempty" bson:"email"`
+	EmployeeIDs []string              `json:"employeeIDs" bson:"employeeIDs"`
+	Memberships []*IdentityMembership `json:"memberships" bson:"memberships"`
+	CreatedOn   time.Time             `json:"createdOn,omitempty" bson:"createdOn"`
+	UpdatedOn   time.Time             `json:"updatedOn,omitempty" bson:"updatedOn"`
+}
+
+// IdentityMembership points at one user record of an identity
+type IdentityMembership struct {
+	UnionID    primitive.ObjectID `json:"unionID" bson:"unionID"`
+	UserID     primitive.ObjectID `json:"userID" bson:"userID"`
+	Username   string             `json:"username,omitempty" bson:"username"`
+	EmployeeID string             `json:"employeeID,omitempty" bson:"employeeID,omitempty"`
+	LinkedOn   time.Time          `json:"linkedOn,omitempty" bson:"linkedOn"`
+}
+
+// UnionMembership is one entry of the union switcher
+type UnionMembership struct {
+	UnionID   primitive.ObjectID `json:"unionID"`
+	UnionName string             `json:"unionName,omitempty"`
+	UserID    primitive.ObjectID `json:"userID"`
+	Username  string             `json:"username,omitempty"`
+	Status    string             `json:"status,omitempty"`
+	Current   bool               `json:"current"`
+}
+
+// EmailVerification is the pending confirmation of a user's email address
+type EmailVerification struct {
+	Email     string    `json:"-" bson:"email"`
+	CodeHash  string    `json:"-" bson:"codeHash"`
+	ExpiresAt time.Time `json:"-" bson:"expiresAt"`
+	// Attempts counts the codes tried; the code is locked once it reaches the limit
+	Attempts int `json:"-" bson:"attempts"`
+	// SentAt is when the code was mailed, to throttle requests for new codes
+	SentAt time.Time `json:"-" bson:"sentAt,omitempty"`
+}
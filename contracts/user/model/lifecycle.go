@@ -0,0 +1,104 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Member lifecycle statuses
+const (
+	StatusApplicant = "applicant"
+	StatusActive    = "active"
+	StatusOnLeave   = "on-leave"
+	StatusSuspended = "suspended"
+	StatusRetired   = "retired"
+	StatusWithdrawn = "withdrawn"
+	StatusDeceased  = "deceased"
+)
+
+// legacyStatuses maps values written before the lifecycle existed
+var legacyStatuses = map[string]string{
+	"registered": StatusApplicant,
+	"":           StatusApplicant,
+}
+
+// StatusRule describes how a member may enter a status and where they can go next
+type StatusRule struct {
+	Next                  []string
+	ReasonRequired        bool
+	EffectiveDateRequired bool
+}
+
+// StatusRules is the member lifecycle. A status missing here cannot be entered
+// through a transition.
+var StatusRules = map[string]StatusRule{
+	StatusApplicant: {
+		Next: []string{StatusActive, StatusWithdrawn},
+	},
+	StatusActive: {
+		Next: []string{StatusOnLeave, StatusSuspended, StatusRetired, StatusWithdrawn, StatusDeceased},
+	},
+	StatusOnLeave: {
+		Next:                  []string{StatusActive, StatusSuspended, StatusRetired, StatusWithdrawn, StatusDeceased},
+		ReasonRequired:        true,
+		EffectiveDateRequired: true,
+	},
+	StatusSuspended: {
+		Next:           []string{StatusActive, StatusWithdrawn, StatusDeceased},
+		ReasonRequired: true,
+	},
+	StatusRetired: {
+		Next:                  []string{StatusActive, StatusDeceased},
+		EffectiveDateRequired: true,
+	},
+	StatusWithdrawn: {
+		Next:                  []string{StatusApplicant, StatusActive},
+		ReasonRequired:        true,
+		EffectiveDateRequired: true,
+	},
+	StatusDeceased: {
+		EffectiveDateRequired: true,
+	},
+}
+
+// NormalizeStatus returns the lifecycle status for a stored value
+func NormalizeStatus(status string) string {
+	if mapped, ok := legacyStatuses[status]; ok {
+		return mapped
+	}
+	return status
+}
+
+// CanTransition reports whether a member in status from may move to status to
+func CanTransition(from, to string) bool {
+	if _, ok := StatusRules[to]; !ok {
+		return false
+	}
+	for _, next := range StatusRules[NormalizeStatus(from)].Next {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// StatusChange is one entry of a member's status timeline
+type StatusChange struct {
+	ID            primitive.ObjectID `json:"id,omitempty" bson:"_id,omitempty"`
+	UnionID       primitive.ObjectID `json:"unionID,omitempty" bson:"unionID"`
+	UserID        primitive.ObjectID `json:"userID,omitempty" bson:"userID"`
+	From          string             `json:"from,omitempty" bson:"from"`
+	To            string             `json:"to,omitempty" bson:"to"`
+	Reason        string             `json:"reason,omitempty" bson:"reason,omitempty"`
+	EffectiveDate time.Time          `json:"effectiveDate,omitempty" bson:"effectiveDate"`
+	ChangedBy     primitive.ObjectID `json:"changedBy,omitempty" bson:"changedBy,omitempty"`
+	ChangedOn     time.Time          `json:"changedOn,omitempty" bson:"changedOn"`
+}
+
+// StatusTransitionInput requests a lifecycle transition
+type StatusTransitionInput struct {
+	Status        string     `json:"status"`
+	Reason        *string    `json:"reason,omitempty"`
+	EffectiveDate *time.Time `json:"effectiveDate,omitempty"`
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// How a member signed in
+const (
+	LoginMethodPassword = "password"
+	LoginMethodToken    = "token"
+)
+
+// DefaultInactiveDays is how long without a login makes a member inactive unless
+// the caller says otherwise
+const DefaultInactiveDays = 90
+
+// LoginEvent is one successful sign in
+type LoginEvent struct {
+	ID        primitive.ObjectID `json:"id,omitempty" bson:"_id,omitempty"`
+	UserID    primitive.ObjectID `json:"userID" bson:"userID"`
+	At        time.Time          `json:"at" bson:"at"`
+	Method    string             `json:"method" bson:"method"`
+	Device    string             `json:"device,omitempty" bson:"device,omitempty"`
+	IP        string             `json:"ip,omitempty" bson:"ip,omitempty"`
+	UserAgent string             `json:"userAgent,omitempty" bson:"userAgent,omitempty"`
+}
+
+// InactiveMember is a member who has not signed in since the report's cut-off
+type InactiveMember struct {
+	UserID     primitive.ObjectID `json:"userID"`
+	Name       string             `json:"name"`
+	EmployeeID string             `json:"employeeID,omitempty"`
+	Unit       string             `json:"unit,omitempty"`
+	Email      string             `json:"email,omitempty"`
+	// LastLoginDate is nil for members who never signed in
+	LastLoginDate *time.Time `json:"lastLoginDate,omitempty"`
+	DaysInactive  *int       `json:"daysInactive,omitempty"`
+	EmailOptOut   bool       `json:"emailOptOut"`
+}
+
+type InactiveMemberReport struct {
+	Days    int               `json:"days"`
+	Since   time.Time         `json:"since"`
+	Total   int               `json:"total"`
+	Members []*InactiveMember `json:"members"`
+}
+
+// Re-engagement campaign states
+const (
+	CampaignSending = "sending"
+	CampaignSent    = "sent"
+)
+
+// ReengagementCampaign is an email sent to the members inactive for Days days
+type ReengagementCampaign struct {
+	ID      primitive.ObjectID `json:"id,omitempty" bson:"_id,omitempty"`
+	UnionID primitive.ObjectID `json:"unionID" bson:"unionID"`
+	Days    int                `json:"days" bson:"days"`
+	Subject string             `json:"subject" bson:"subject"`
+	Content string             `json:"content" bson:"content"`
+	Status  string             `json:"status" bson:"status"`
+	// Recipients counts the inactive members with an email who did not opt out
+	Recipients  int        `json:"recipients" bson:"recipients"`
+	Sent        int        `json:"sent" bson:"sent"`
+	Failed      int        `json:"failed" bson:"failed"`
+	StartedBy   string     `json:"startedBy,omitempty" bson:"startedBy,omitempty"`
+	CreatedOn   time.Time  `json:"createdOn" bson:"createdOn"`
+	CompletedOn *time.Time `json:"completedOn,omitempty" bson:"completedOn,omitempty"`
+}
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MessageOverride is a union's own wording of a catalogue message or email template
+// in one locale
+type MessageOverride struct {
+	ID        primitive.ObjectID `json:"id,omitempty" bson:"_id,omitempty"`
+	Locale    string             `json:"locale" bson:"locale"`
+	Key       string             `json:"key" bson:"key"`
+	Text      string             `json:"text" bson:"text"`
+	UpdatedBy string             `json:"updatedBy,omitempty" bson:"updatedBy,omitempty"`
+	UpdatedOn time.Time          `json:"updatedOn,omitempty" bson:"updatedOn"`
+}
+
+// CatalogueMessage is a message as a union's members receive it in one locale
+type CatalogueMessage struct {
+	Key string `json:"key"`
+	// Text is the union's override when there is one, else the translation
+	Text       string `json:"text"`
+	Default    string `json:"default"`
+	Overridden bool   `json:"overridden"`
+}
@@ -0,0 +1,111 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MilestoneStatuses are the statuses reported on when a rule names none
+var MilestoneStatuses = []string{StatusActive, StatusOnLeave}
+
+// MilestoneRule is a date-based eligibility rule a union configures, e.g. age 55 and
+// 30 years of service for retirement, or 25 years of service for a pin. A member
+// qualifies on the day they first meet every condition of the rule.
+type MilestoneRule struct {
+	ID              primitive.ObjectID `json:"id,omitempty" bson:"_id,omitempty"`
+	UnionID         primitive.ObjectID `json:"unionID,omitempty" bson:"unionID"`
+	Name            string             `json:"name,omitempty" bson:"name"`
+	Description     string             `json:"description,omitempty" bson:"description,omitempty"`
+	MinAge          int                `json:"minAge" bson:"minAge"`
+	MinServiceYears int                `json:"minServiceYears" bson:"minServiceYears"`
+	Statuses        []string           `json:"statuses" bson:"statuses"`
+	// Recipients are the staff emailed each month about members qualifying within
+	// the next NotifyDaysAhead days
+	Recipients      []string   `json:"recipients" bson:"recipients"`
+	NotifyDaysAhead int        `json:"notifyDaysAhead" bson:"notifyDaysAhead"`
+	LastNotifiedOn  *time.Time `json:"lastNotifiedOn,omitempty" bson:"lastNotifiedOn,omitempty"`
+	CreatedOn       time.Time  `json:"createdOn,omitempty" bson:"createdOn"`
+	UpdatedOn       time.Time  `json:"updatedOn,omitempty" bson:"updatedOn,omitempty"`
+}
+
+// QualifiesOn returns the day a member meets every condition of the rule. Members
+// without the dates a condition needs never qualify.
+func (r *MilestoneRule) QualifiesOn(user *User) (time.Time, bool) {
+	var on time.Time
+	if r.MinAge > 0 {
+		if user.DateOfBirth.IsZero() {
+			return time.Time{}, false
+		}
+		on = user.DateOfBirth.AddDate(r.MinAge, 0, 0)
+	}
+	if r.MinServiceYears > 0 {
+		if user.StartDate.IsZero() {
+			return time.Time{}, false
+		}
+		if served := user.StartDate.AddDate(r.MinServiceYears, 0, 0); served.After(on) {
+			on = served
+		}
+	}
+	return on, !on.IsZero()
+}
+
+// ReportsOn reports whether the rule covers members in status
+func (r *MilestoneRule) ReportsOn(status string) bool {
+	statuses := r.Statuses
+	if len(statuses) == 0 {
+		statuses = MilestoneStatuses
+	}
+	for _, s := range statuses {
+		if s == NormalizeStatus(status) {
+			return true
+		}
+	}
+	return false
+}
+
+// MilestoneRuleInput creates or updates a milestone rule
+type MilestoneRuleInput struct {
+	Name            *string  `json:"name,omitempty"`
+	Description     *string  `json:"description,omitempty"`
+	MinAge          *int     `json:"minAge,omitempty"`
+	MinServiceYears *int     `json:"minServiceYears,omitempty"`
+	Statuses        []string `json:"statuses,omitempty"`
+	Recipients      []string `json:"recipients,omitempty"`
+	NotifyDaysAhead *int     `json:"notifyDaysAhead,omitempty"`
+}
+
+// MilestoneMatch is a member qualifying for a rule
+type MilestoneMatch struct {
+	UserID       primitive.ObjectID `json:"userID"`
+	Name         string             `json:"name"`
+	EmployeeID   string             `json:"employeeID,omitempty"`
+	Unit         string             `json:"unit,omitempty"`
+	Status       string             `json:"status,omitempty"`
+	DateOfBirth  *time.Time         `json:"dateOfBirth,omitempty"`
+	StartDate    *time.Time         `json:"startDate,omitempty"`
+	QualifiesOn  time.Time          `json:"qualifiesOn"`
+	Age          int                `json:"age"`
+	ServiceYears int                `json:"serviceYears"`
+}
+
+// MilestoneReport lists the members qualifying for a rule within a date window
+type MilestoneReport struct {
+	RuleID   primitive.ObjectID `json:"ruleID"`
+	RuleName string             `json:"ruleName"`
+	From     time.Time          `json:"from"`
+	To       time.Time          `json:"to"`
+	Matches  []*MilestoneMatch  `json:"matches"`
+}
+
+// YearsBetween counts the whole years from since to on
+func YearsBetween(since, on time.Time) int {
+	if since.IsZero() || on.Before(since) {
+		return 0
+	}
+	years := on.Year() - since.Year()
+	if on.Before(since.AddDate(years, 0, 0)) {
+		years--
+	}
+	return years
+}
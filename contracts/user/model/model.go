@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"time"
+	unionModel "younified-backend/contracts/union/model"
 
 	"github.com/99designs/gqlgen/graphql"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -45,7 +46,7 @@ type User struct {
 	Profile                 UserInfo           `json:"profile,omitempty" bson:"profile"`
 	CreatedOn               time.Time          `json:"createdOn,omitempty" bson:"createdOn"`
 	Deleted                 bool               `json:"deleted,omitempty" bson:"deleted"`
-	DeletedAt               time.Time          `json:"deletedAT,omitempty" bson:"deletedAT"`
+	DeletedAt               time.Time          `json:"deletedAT,omitempty" bson:"deletedAt,omitempty"`
 	LoggedIn                bool               `json:"loggedIn,omitempty" bson:"loggedIn"`
 	Status                  string             `json:"status,omitempty" bson:"status"`
 	Token                   string             `json:"token,omitempty" bson:"token"`
@@ -54,6 +55,7 @@ type User struct {
 	Location                string             `json:"location,omitempty" bson:"location"`
 	UnionPosition           string             `json:"unionPosition,omitempty" bson:"unionPosition"`
 	Unit                    string             `json:"unit,omitempty" bson:"unit"`
+	UnitID                  primitive.ObjectID `json:"unitID,omitempty" bson:"unitID,omitempty"`
 	JobTitle                string             `json:"jobTitle,omitempty" bson:"jobTitle"`
 	Commitee                string             `json:"commitee,omitempty" bson:"commitee"`
 	MembershipType          string             `json:"membershipType,omitempty" bson:"membershipType"`
@@ -111,22 +113,47 @@ type User struct {
 	TimeTypeDescription string `json:"timeTypeDescription,omitempty" bson:"timeTypeDescription,omitempty"`
 	SeniorityAsOf       string `json:"seniorityAsOf,omitempty" bson:"seniorityAsOf,omitempty"`
 	MemberID            string `json:"MemberID,omitempty" bson:"realMemberID,omitempty"`
+	//lifecycle fields, maintained by status transitions
+	StatusChangedAt   time.Time `json:"statusChangedAt,omitempty" bson:"statusChangedAt,omitempty"`
+	SessionsRevokedAt time.Time `json:"-" bson:"sessionsRevokedAt,omitempty"`
+	DuesStoppedAt     time.Time `json:"duesStoppedAt,omitempty" bson:"duesStoppedAt,omitempty"`
+	//identity fields, set once the profile email is confirmed
+	VerifiedEmail     string             `json:"verifiedEmail,omitempty" bson:"verifiedEmail,omitempty"`
+	EmailVerifiedAt   time.Time          `json:"emailVerifiedAt,omitempty" bson:"emailVerifiedAt,omitempty"`
+	EmailVerification *EmailVerification `json:"-" bson:"emailVerification,omitempty"`
+	//dues fields, recomputed whenever the member's ledger changes
+	DuesStanding *DuesStanding `json:"duesStanding,omitempty" bson:"duesStanding,omitempty"`
+	//steward role, held while the member has steward assignments
+	Steward bool `json:"steward,omitempty" bson:"steward,omitempty"`
+}
+
+// IsEntity marks User as a federation entity resolved by userService
+func (User) IsEntity() {}
+
+// BargainingUnit references the member's unit, resolved by unionService through the
+// gateway
+func (u *User) BargainingUnit() *unionModel.BargainingUnit {
+	if u.UnitID.IsZero() {
+		return nil
+	}
+	return &unionModel.BargainingUnit{ID: u.UnitID}
 }
 
 type UserInfo struct {
-	Email            string `json:"email,omitempty" bson:"email,omitempty"`
-	UnionMail        string `json:"unionMail,omitempty" bson:"unionMail,omitempty"`
-	ImageURL         string `json:"imageURL,omitempty" bson:"imageURL,omitempty"`
-	Address          string `json:"address,omitempty" bson:"address,omitempty"`
-	City             string `json:"city,omitempty" bson:"city,omitempty"`
-	Province         string `json:"province,omitempty" bson:"province,omitempty"`
-	PostalCode       string `json:"postalCode,omitempty" bson:"postalCode,omitempty"`
-	Phone            string `json:"phone,omitempty" bson:"phone,omitempty"`
-	Mobile           string `json:"mobile,omitempty" bson:"mobile,omitempty"`
-	Description      string `json:"description,omitempty" bson:"description,omitempty"`
-	BannerURL        string `json:"bannerURL,omitempty" bson:"bannerURL,omitempty"`
-	Fax              string `json:"fax,omitempty" bson:"fax,omitempty"`
-	PresidentMessage string `json:"president_message,omitempty" bson:"president_message,omitempty"`
+	Email            string        `json:"email,omitempty" bson:"email,omitempty"`
+	UnionMail        string        `json:"unionMail,omitempty" bson:"unionMail,omitempty"`
+	ImageURL         string        `json:"imageURL,omitempty" bson:"imageURL,omitempty"`
+	Address          string        `json:"address,omitempty" bson:"address,omitempty"`
+	City             string        `json:"city,omitempty" bson:"city,omitempty"`
+	Province         string        `json:"province,omitempty" bson:"province,omitempty"`
+	PostalCode       string        `json:"postalCode,omitempty" bson:"postalCode,omitempty"`
+	Phone            string        `json:"phone,omitempty" bson:"phone,omitempty"`
+	Mobile           string        `json:"mobile,omitempty" bson:"mobile,omitempty"`
+	Description      string        `json:"description,omitempty" bson:"description,omitempty"`
+	BannerURL        string        `json:"bannerURL,omitempty" bson:"bannerURL,omitempty"`
+	Fax              string        `json:"fax,omitempty" bson:"fax,omitempty"`
+	PresidentMessage string        `json:"president_message,omitempty" bson:"president_message,omitempty"`
+	Photo            *ProfilePhoto `json:"photo,omitempty" bson:"photo,omitempty"`
 	// ImportantLinks   []*ImportantLinks `json:"url,omitempty" bson:"url,omitempty"`
 	// WebsiteLinks     ImportantLinks    `json:"websiteUrl,omitempty" bson:"websiteUrl,omitempty"`
 }
@@ -153,8 +180,8 @@ type UserUploadReport struct {
 type UserUpdateInput struct {
 	FirstName string   `json:"firstName,omitempty" bson:"firstName"`
 	LastName  string   `json:"lastName,omitempty" bson:"lastName"`
-	Status    string   `json:"profile,omitempty" bson:"profile"`
-	Profile   UserInfo `json:"status,omitempty" bson:"status"`
+	Status    string   `json:"status,omitempty" bson:"status,omitempty"`
+	Profile   UserInfo `json:"profile,omitempty" bson:"profile"`
 }
 
 type UserFilterInput struct {
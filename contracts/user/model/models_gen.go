@@ -2,8 +2,64 @@
 
 package model
 
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
 type Mutation struct {
 }
 
 type Query struct {
 }
+
+type UserByIDsInput struct {
+	ID primitive.ObjectID `json:"ID"`
+}
+
+// the users unionService creates when it provisions a union
+type ProvisionedUser string
+
+const (
+	// the union's first admin
+	ProvisionedUserFirstAdmin ProvisionedUser = "FIRST_ADMIN"
+	// the admin the platform signs in as, hidden from member lists
+	ProvisionedUserDefaultUser ProvisionedUser = "DEFAULT_USER"
+)
+
+var AllProvisionedUser = []ProvisionedUser{
+	ProvisionedUserFirstAdmin,
+	ProvisionedUserDefaultUser,
+}
+
+func (e ProvisionedUser) IsValid() bool {
+	switch e {
+	case ProvisionedUserFirstAdmin, ProvisionedUserDefaultUser:
+		return true
+	}
+	return false
+}
+
+func (e ProvisionedUser) String() string {
+	return string(e)
+}
+
+func (e *ProvisionedUser) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ProvisionedUser(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ProvisionedUser", str)
+	}
+	return nil
+}
+
+func (e ProvisionedUser) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
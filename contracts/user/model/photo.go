@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// ProfilePhoto holds the stored renditions of a member's profile photo
+type ProfilePhoto struct {
+	UploadedAt time.Time       `json:"uploadedAt,omitempty" bson:"uploadedAt,omitempty"`
+	Variants   []*PhotoVariant `json:"variants,omitempty" bson:"variants,omitempty"`
+}
+
+// PhotoVariant is a single resized rendition stored in the bucket
+type PhotoVariant struct {
+	Name string `json:"name,omitempty" bson:"name,omitempty"`
+	Size int    `json:"size,omitempty" bson:"size,omitempty"`
+	URL  string `json:"url,omitempty" bson:"url,omitempty"`
+	Key  string `json:"-" bson:"key,omitempty"`
+}
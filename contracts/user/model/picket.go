@@ -0,0 +1,125 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Shift swap lifecycle
+const (
+	ShiftSwapPending  = "pending"
+	ShiftSwapAccepted = "accepted"
+	ShiftSwapDeclined = "declined"
+)
+
+// PicketSite is a location the union staffs during a job action
+type PicketSite struct {
+	ID        primitive.ObjectID `json:"id,omitempty" bson:"_id,omitempty"`
+	UnionID   primitive.ObjectID `json:"unionID,omitempty" bson:"unionID"`
+	StrikeID  primitive.ObjectID `json:"strikeID,omitempty" bson:"strikeID,omitempty"`
+	Name      string             `json:"name,omitempty" bson:"name"`
+	Address   string             `json:"address,omitempty" bson:"address,omitempty"`
+	Zone      string             `json:"zone,omitempty" bson:"zone,omitempty"`
+	Active    bool               `json:"active" bson:"active"`
+	CreatedOn time.Time          `json:"createdOn,omitempty" bson:"createdOn"`
+}
+
+// PicketSiteInput creates or updates a picket site
+type PicketSiteInput struct {
+	Name     *string             `json:"name,omitempty"`
+	Address  *string             `json:"address,omitempty"`
+	Zone     *string             `json:"zone,omitempty"`
+	StrikeID *primitive.ObjectID `json:"strikeID,omitempty"`
+	Active   *bool               `json:"active,omitempty"`
+}
+
+// PicketShift is a time slot at a site that members sign up for. When Shift is set
+// only members working that shift may sign up.
+type PicketShift struct {
+	ID             primitive.ObjectID   `json:"id,omitempty" bson:"_id,omitempty"`
+	UnionID        primitive.ObjectID   `json:"unionID,omitempty" bson:"unionID"`
+	SiteID         primitive.ObjectID   `json:"siteID,omitempty" bson:"siteID"`
+	StrikeID       primitive.ObjectID   `json:"strikeID,omitempty" bson:"strikeID,omitempty"`
+	Zone           string               `json:"zone,omitempty" bson:"zone,omitempty"`
+	Shift          string               `json:"shift,omitempty" bson:"shift,omitempty"`
+	Start          time.Time            `json:"start,omitempty" bson:"start"`
+	End            time.Time            `json:"end,omitempty" bson:"end"`
+	Capacity       int                  `json:"capacity" bson:"capacity"`
+	CaptainIDs     []primitive.ObjectID `json:"captainIDs" bson:"captainIDs"`
+	Signups        []*ShiftSignup       `json:"signups" bson:"signups"`
+	ReminderSentAt *time.Time           `json:"reminderSentAt,omitempty" bson:"reminderSentAt,omitempty"`
+	CreatedOn      time.Time            `json:"createdOn,omitempty" bson:"createdOn"`
+}
+
+// Signup returns the sign-up of userID, or nil
+func (s *PicketShift) Signup(userID primitive.ObjectID) *ShiftSignup {
+	for _, signup := range s.Signups {
+		if signup.UserID == userID {
+			return signup
+		}
+	}
+	return nil
+}
+
+// IsCaptain reports whether userID captains the shift
+func (s *PicketShift) IsCaptain(userID primitive.ObjectID) bool {
+	for _, id := range s.CaptainIDs {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// PicketShiftInput creates or updates a shift
+type PicketShiftInput struct {
+	SiteID     *primitive.ObjectID  `json:"siteID,omitempty"`
+	Start      *time.Time           `json:"start,omitempty"`
+	End        *time.Time           `json:"end,omitempty"`
+	Capacity   *int                 `json:"capacity,omitempty"`
+	CaptainIDs []primitive.ObjectID `json:"captainIDs,omitempty"`
+	Shift      *string              `json:"shift,omitempty"`
+}
+
+// ShiftSignup is a member booked on a shift
+type ShiftSignup struct {
+	UserID       primitive.ObjectID `json:"userID,omitempty" bson:"userID"`
+	SignedUpOn   time.Time          `json:"signedUpOn,omitempty" bson:"signedUpOn"`
+	CheckedInAt  *time.Time         `json:"checkedInAt,omitempty" bson:"checkedInAt,omitempty"`
+	CheckedOutAt *time.Time         `json:"checkedOutAt,omitempty" bson:"checkedOutAt,omitempty"`
+}
+
+// Hours returns the time between check-in and check-out
+func (s *ShiftSignup) Hours() float64 {
+	if s.CheckedInAt == nil || s.CheckedOutAt == nil {
+		return 0
+	}
+	return s.CheckedOutAt.Sub(*s.CheckedInAt).Hours()
+}
+
+// ShiftSwap asks another member to take over a sign-up
+type ShiftSwap struct {
+	ID          primitive.ObjectID `json:"id,omitempty" bson:"_id,omitempty"`
+	UnionID     primitive.ObjectID `json:"unionID,omitempty" bson:"unionID"`
+	ShiftID     primitive.ObjectID `json:"shiftID,omitempty" bson:"shiftID"`
+	FromUserID  primitive.ObjectID `json:"fromUserID,omitempty" bson:"fromUserID"`
+	ToUserID    primitive.ObjectID `json:"toUserID,omitempty" bson:"toUserID"`
+	Status      string             `json:"status,omitempty" bson:"status"`
+	CreatedOn   time.Time          `json:"createdOn,omitempty" bson:"createdOn"`
+	RespondedOn *time.Time         `json:"respondedOn,omitempty" bson:"respondedOn,omitempty"`
+}
+
+// ShiftCoverage compares the staffing of a shift with its capacity
+type ShiftCoverage struct {
+	ShiftID   primitive.ObjectID `json:"shiftID"`
+	SiteID    primitive.ObjectID `json:"siteID"`
+	SiteName  string             `json:"siteName"`
+	Start     time.Time          `json:"start"`
+	End       time.Time          `json:"end"`
+	Capacity  int                `json:"capacity"`
+	SignedUp  int                `json:"signedUp"`
+	CheckedIn int                `json:"checkedIn"`
+	Gap       int                `json:"gap"`
+	NoCaptain bool               `json:"noCaptain"`
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Privacy request types
+const (
+	PrivacyRequestExport  = "export"
+	PrivacyRequestErasure = "erasure"
+)
+
+// Privacy request lifecycle
+const (
+	PrivacyStatusReceived   = "received"
+	PrivacyStatusInProgress = "in_progress"
+	PrivacyStatusCompleted  = "completed"
+	PrivacyStatusFailed     = "failed"
+)
+
+// UserStatusErased marks a user whose personal data was removed on request
+const UserStatusErased = "erased"
+
+// PrivacyRequest tracks a data subject access export or an erasure for one member
+type PrivacyRequest struct {
+	ID          primitive.ObjectID     `json:"id,omitempty" bson:"_id,omitempty"`
+	UnionID     primitive.ObjectID     `json:"unionID,omitempty" bson:"unionID"`
+	UserID      primitive.ObjectID     `json:"userID,omitempty" bson:"userID"`
+	Type        string                 `json:"type,omitempty" bson:"type"`
+	Status      string                 `json:"status,omitempty" bson:"status"`
+	RequestedBy primitive.ObjectID     `json:"requestedBy,omitempty" bson:"requestedBy,omitempty"`
+	Reason      string                 `json:"reason,omitempty" bson:"reason,omitempty"`
+	ArchiveURL  string                 `json:"archiveURL,omitempty" bson:"archiveURL,omitempty"`
+	ArchiveKey  string                 `json:"-" bson:"archiveKey,omitempty"`
+	Error       string                 `json:"error,omitempty" bson:"error,omitempty"`
+	CreatedOn   time.Time              `json:"createdOn,omitempty" bson:"createdOn"`
+	CompletedOn time.Time              `json:"completedOn,omitempty" bson:"completedOn,omitempty"`
+	History     []*PrivacyRequestEvent `json:"history,omitempty" bson:"history"`
+}
+
+// PrivacyRequestEvent records one status change of a privacy request
+type PrivacyRequestEvent struct {
+	Status string    `json:"status,omitempty" bson:"status"`
+	At     time.Time `json:"at,omitempty" bson:"at"`
+	Note   string    `json:"note,omitempty" bson:"note,omitempty"`
+}
@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PurgeReport describes one retention purge run over a union
+type PurgeReport struct {
+	UnionID primitive.ObjectID `json:"unionID"`
+	DryRun  bool               `json:"dryRun"`
+	RanAt   time.Time          `json:"ranAt"`
+	Items   []*PurgeItem       `json:"items"`
+}
+
+// PurgeItem is the outcome of a purge for one collection
+type PurgeItem struct {
+	Collection    string    `json:"collection"`
+	RetentionDays int       `json:"retentionDays"`
+	Cutoff        time.Time `json:"cutoff"`
+	Matched       int       `json:"matched"`
+	Purged        int       `json:"purged"`
+	MediaDeleted  int       `json:"mediaDeleted"`
+}
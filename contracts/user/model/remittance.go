@@ -0,0 +1,125 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Remittance file layouts
+const (
+	RemittanceCSV        = "csv"
+	RemittanceFixedWidth = "fixed"
+)
+
+// Member fields a remittance line can be matched on
+const (
+	RemittanceMatchEmployeeID  = "employeeID"
+	RemittanceMatchBadgeNumber = "badgeNumber"
+	RemittanceMatchMemberID    = "memberID"
+)
+
+// RemittanceFormat describes the payroll deduction file of one employer
+type RemittanceFormat struct {
+	ID        primitive.ObjectID `json:"id,omitempty" bson:"_id,omitempty"`
+	UnionID   primitive.ObjectID `json:"unionID,omitempty" bson:"unionID"`
+	Name      string             `json:"name,omitempty" bson:"name"`
+	Employer  string             `json:"employer,omitempty" bson:"employer,omitempty"`
+	Kind      string             `json:"kind,omitempty" bson:"kind"`
+	Delimiter string             `json:"delimiter,omitempty" bson:"delimiter,omitempty"`
+	// HeaderRows are skipped; the last one names the columns of a CSV file
+	HeaderRows      int               `json:"headerRows" bson:"headerRows"`
+	MatchField      string            `json:"matchField,omitempty" bson:"matchField"`
+	Key             *RemittanceColumn `json:"key,omitempty" bson:"key"`
+	Amount          *RemittanceColumn `json:"amount,omitempty" bson:"amount"`
+	Earnings        *RemittanceColumn `json:"earnings,omitempty" bson:"earnings,omitempty"`
+	MemberName      *RemittanceColumn `json:"memberName,omitempty" bson:"memberName,omitempty"`
+	ImpliedDecimals int               `json:"impliedDecimals" bson:"impliedDecimals"`
+	// ScopeField and ScopeValue select the members the employer deducts for
+	ScopeField string    `json:"scopeField,omitempty" bson:"scopeField,omitempty"`
+	ScopeValue string    `json:"scopeValue,omitempty" bson:"scopeValue,omitempty"`
+	CreatedOn  time.Time `json:"createdOn,omitempty" bson:"createdOn"`
+	UpdatedOn  time.Time `json:"updatedOn,omitempty" bson:"updatedOn,omitempty"`
+}
+
+// RemittanceColumn locates a value on a line. CSV columns are found by Header, or by
+// their zero-based Index; fixed-width columns by their one-based Start and Length.
+type RemittanceColumn struct {
+	Header string `json:"header,omitempty" bson:"header,omitempty"`
+	Index  int    `json:"index" bson:"index"`
+	Start  int    `json:"start" bson:"start"`
+	Length int    `json:"length" bson:"length"`
+}
+
+// RemittanceFormatInput creates or updates a remittance format
+type RemittanceFormatInput struct {
+	Name            *string           `json:"name,omitempty"`
+	Employer        *string           `json:"employer,omitempty"`
+	Kind            *string           `json:"kind,omitempty"`
+	Delimiter       *string           `json:"delimiter,omitempty"`
+	HeaderRows      *int              `json:"headerRows,omitempty"`
+	MatchField      *string           `json:"matchField,omitempty"`
+	Key             *RemittanceColumn `json:"key,omitempty"`
+	Amount          *RemittanceColumn `json:"amount,omitempty"`
+	Earnings        *RemittanceColumn `json:"earnings,omitempty"`
+	MemberName      *RemittanceColumn `json:"memberName,omitempty"`
+	ImpliedDecimals *int              `json:"impliedDecimals,omitempty"`
+	ScopeField      *string           `json:"scopeField,omitempty"`
+	ScopeValue      *string           `json:"scopeValue,omitempty"`
+}
+
+// RemittanceImport records one imported file and its reconciliation
+type RemittanceImport struct {
+	ID           primitive.ObjectID     `json:"id,omitempty" bson:"_id,omitempty"`
+	UnionID      primitive.ObjectID     `json:"unionID,omitempty" bson:"unionID"`
+	FormatID     primitive.ObjectID     `json:"formatID,omitempty" bson:"formatID"`
+	Employer     string                 `json:"employer,omitempty" bson:"employer,omitempty"`
+	Period       string                 `json:"period,omitempty" bson:"period"`
+	FileName     string                 `json:"fileName,omitempty" bson:"fileName,omitempty"`
+	FileHash     string                 `json:"-" bson:"fileHash"`
+	DryRun       bool                   `json:"dryRun" bson:"dryRun"`
+	Lines        int                    `json:"lines" bson:"lines"`
+	Matched      int                    `json:"matched" bson:"matched"`
+	TotalAmount  float64                `json:"totalAmount" bson:"totalAmount"`
+	PostedAmount float64                `json:"postedAmount" bson:"postedAmount"`
+	Posted       int                    `json:"posted" bson:"posted"`
+	Unmatched    []*RemittanceLine      `json:"unmatched" bson:"unmatched"`
+	NoDeduction  []*RemittanceMember    `json:"noDeduction" bson:"noDeduction"`
+	Mismatches   []*RemittanceMismatch  `json:"mismatches" bson:"mismatches"`
+	Errors       []*RemittanceLineError `json:"errors" bson:"errors"`
+	ImportedBy   primitive.ObjectID     `json:"importedBy,omitempty" bson:"importedBy,omitempty"`
+	ImportedOn   time.Time              `json:"importedOn,omitempty" bson:"importedOn"`
+}
+
+// RemittanceLine is one deduction read from a remittance file
+type RemittanceLine struct {
+	Line     int     `json:"line" bson:"line"`
+	Key      string  `json:"key" bson:"key"`
+	Name     string  `json:"name,omitempty" bson:"name,omitempty"`
+	Amount   float64 `json:"amount" bson:"amount"`
+	Earnings float64 `json:"earnings" bson:"earnings"`
+}
+
+// RemittanceMember is a member expected on a remittance who had no deduction
+type RemittanceMember struct {
+	UserID     primitive.ObjectID `json:"userID" bson:"userID"`
+	Name       string             `json:"name" bson:"name"`
+	EmployeeID string             `json:"employeeID,omitempty" bson:"employeeID,omitempty"`
+	Expected   float64            `json:"expected" bson:"expected"`
+}
+
+// RemittanceMismatch is a member whose deduction differs from the dues assessed
+type RemittanceMismatch struct {
+	UserID     primitive.ObjectID `json:"userID" bson:"userID"`
+	Name       string             `json:"name" bson:"name"`
+	Key        string             `json:"key" bson:"key"`
+	Expected   float64            `json:"expected" bson:"expected"`
+	Deducted   float64            `json:"deducted" bson:"deducted"`
+	Difference float64            `json:"difference" bson:"difference"`
+}
+
+// RemittanceLineError is a line of the file that could not be read
+type RemittanceLineError struct {
+	Line  int    `json:"line" bson:"line"`
+	Error string `json:"error" bson:"error"`
+}
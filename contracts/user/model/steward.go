@@ -0,0 +1,106 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Member fields a steward's coverage can be scoped by
+const (
+	StewardCoverUnit       = "unit"
+	StewardCoverDepartment = "department"
+	StewardCoverZone       = "zone"
+	StewardCoverShift      = "shift"
+)
+
+// StewardAssignment gives a steward the members matching every coverage rule. An
+// assignment without rules covers the whole union. Backups are listed alongside the
+// steward of the members they cover.
+type StewardAssignment struct {
+	ID        primitive.ObjectID `json:"id,omitempty" bson:"_id,omitempty"`
+	UnionID   primitive.ObjectID `json:"unionID,omitempty" bson:"unionID"`
+	StewardID primitive.ObjectID `json:"stewardID,omitempty" bson:"stewardID"`
+	Coverage  []*StewardCoverage `json:"coverage" bson:"coverage"`
+	Backup    bool               `json:"backup" bson:"backup"`
+	Notes     string             `json:"notes,omitempty" bson:"notes,omitempty"`
+	CreatedOn time.Time          `json:"createdOn,omitempty" bson:"createdOn"`
+	UpdatedOn time.Time          `json:"updatedOn,omitempty" bson:"updatedOn,omitempty"`
+}
+
+// Covers reports whether the assignment covers user, and how specific it is: the
+// assignment matching more rules wins when several cover a member
+func (a *StewardAssignment) Covers(user *User) (bool, int) {
+	for _, rule := range a.Coverage {
+		if !rule.Matches(user) {
+			return false, 0
+		}
+	}
+	return true, len(a.Coverage)
+}
+
+// StewardCoverage matches the members whose Field equals Value
+type StewardCoverage struct {
+	Field string `json:"field" bson:"field"`
+	Value string `json:"value" bson:"value"`
+}
+
+// Matches compares the member field case-insensitively
+func (c *StewardCoverage) Matches(user *User) bool {
+	return strings.EqualFold(strings.TrimSpace(StewardCoverageValue(user, c.Field)), c.Value)
+}
+
+// StewardCoverageValue returns the member field a coverage rule is scoped by
+func StewardCoverageValue(user *User, field string) string {
+	switch field {
+	case StewardCoverUnit:
+		return user.Unit
+	case StewardCoverDepartment:
+		return user.Department
+	case StewardCoverZone:
+		return user.Zone
+	case StewardCoverShift:
+		return user.Shift
+	}
+	return ""
+}
+
+// StewardAssignmentInput creates or updates a steward assignment
+type StewardAssignmentInput struct {
+	StewardID *primitive.ObjectID `json:"stewardID,omitempty"`
+	Coverage  []*StewardCoverage  `json:"coverage,omitempty"`
+	Backup    *bool               `json:"backup,omitempty"`
+	Notes     *string             `json:"notes,omitempty"`
+}
+
+// StewardContact is how members reach a steward
+type StewardContact struct {
+	UserID      primitive.ObjectID   `json:"userID"`
+	Name        string               `json:"name"`
+	Email       string               `json:"email,omitempty"`
+	Phone       string               `json:"phone,omitempty"`
+	Location    string               `json:"location,omitempty"`
+	Assignments []*StewardAssignment `json:"assignments"`
+}
+
+// MyStewards are the stewards covering a member
+type MyStewards struct {
+	Steward *StewardContact   `json:"steward,omitempty"`
+	Backups []*StewardContact `json:"backups"`
+}
+
+// StewardWorkload counts the members a steward covers
+type StewardWorkload struct {
+	Steward *StewardContact `json:"steward"`
+	// Members are covered by the steward, BackupMembers only as a backup
+	Members       int `json:"members"`
+	BackupMembers int `json:"backupMembers"`
+}
+
+// StewardWorkloadReport is the workload of every steward of a union
+type StewardWorkloadReport struct {
+	Stewards []*StewardWorkload `json:"stewards"`
+	// Uncovered members have no steward
+	Uncovered int `json:"uncovered"`
+}
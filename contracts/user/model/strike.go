@@ -0,0 +1,129 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Strike payment lifecycle
+const (
+	StrikePaymentPending  = "pending"
+	StrikePaymentApproved = "approved"
+	StrikePaymentExported = "exported"
+)
+
+// StrikePeriod is a strike during which members earn strike pay for picketing
+type StrikePeriod struct {
+	ID        primitive.ObjectID `json:"id,omitempty" bson:"_id,omitempty"`
+	UnionID   primitive.ObjectID `json:"unionID,omitempty" bson:"unionID"`
+	Name      string             `json:"name,omitempty" bson:"name"`
+	StartDate time.Time          `json:"startDate,omitempty" bson:"startDate"`
+	EndDate   *time.Time         `json:"endDate,omitempty" bson:"endDate,omitempty"`
+	Rules     *StrikePayRules    `json:"rules,omitempty" bson:"rules"`
+	Closed    bool               `json:"closed" bson:"closed"`
+	CreatedBy primitive.ObjectID `json:"createdBy,omitempty" bson:"createdBy,omitempty"`
+	CreatedOn time.Time          `json:"createdOn,omitempty" bson:"createdOn"`
+	UpdatedOn time.Time          `json:"updatedOn,omitempty" bson:"updatedOn,omitempty"`
+}
+
+// StrikePayRules turn a week of picket attendance into an entitlement. Zero values
+// of the optional limits mean no limit.
+type StrikePayRules struct {
+	DailyRate          float64 `json:"dailyRate" bson:"dailyRate"`
+	MinHoursPerDay     float64 `json:"minHoursPerDay" bson:"minHoursPerDay"`
+	MinDaysPerWeek     int     `json:"minDaysPerWeek" bson:"minDaysPerWeek"`
+	MaxPaidDaysPerWeek int     `json:"maxPaidDaysPerWeek" bson:"maxPaidDaysPerWeek"`
+	WeeklyCap          float64 `json:"weeklyCap" bson:"weeklyCap"`
+}
+
+// Entitlement returns the paid days and the pay for a week with days qualifying picket days
+func (r *StrikePayRules) Entitlement(days int) (int, float64) {
+	if days < r.MinDaysPerWeek || days == 0 {
+		return 0, 0
+	}
+	if r.MaxPaidDaysPerWeek > 0 && days > r.MaxPaidDaysPerWeek {
+		days = r.MaxPaidDaysPerWeek
+	}
+	amount := float64(days) * r.DailyRate
+	if r.WeeklyCap > 0 && amount > r.WeeklyCap {
+		amount = r.WeeklyCap
+	}
+	return days, amount
+}
+
+// StrikePeriodInput creates or updates a strike period
+type StrikePeriodInput struct {
+	Name      *string         `json:"name,omitempty"`
+	StartDate *time.Time      `json:"startDate,omitempty"`
+	EndDate   *time.Time      `json:"endDate,omitempty"`
+	Rules     *StrikePayRules `json:"rules,omitempty"`
+	Closed    *bool           `json:"closed,omitempty"`
+}
+
+// PicketAttendance is one member's picket-line attendance on one day
+type PicketAttendance struct {
+	ID         primitive.ObjectID `json:"id,omitempty" bson:"_id,omitempty"`
+	UnionID    primitive.ObjectID `json:"unionID,omitempty" bson:"unionID"`
+	StrikeID   primitive.ObjectID `json:"strikeID,omitempty" bson:"strikeID"`
+	UserID     primitive.ObjectID `json:"userID,omitempty" bson:"userID"`
+	Date       time.Time          `json:"date,omitempty" bson:"date"`
+	Hours      float64            `json:"hours" bson:"hours"`
+	Location   string             `json:"location,omitempty" bson:"location,omitempty"`
+	CaptainID  primitive.ObjectID `json:"captainID,omitempty" bson:"captainID,omitempty"`
+	RecordedBy primitive.ObjectID `json:"recordedBy,omitempty" bson:"recordedBy,omitempty"`
+	RecordedOn time.Time          `json:"recordedOn,omitempty" bson:"recordedOn"`
+}
+
+// PicketAttendanceInput records attendance of a member on a day
+type PicketAttendanceInput struct {
+	UserID    primitive.ObjectID  `json:"userID"`
+	Date      time.Time           `json:"date"`
+	Hours     float64             `json:"hours"`
+	Location  *string             `json:"location,omitempty"`
+	CaptainID *primitive.ObjectID `json:"captainID,omitempty"`
+}
+
+// StrikePayment is the strike pay entitlement of a member for one week
+type StrikePayment struct {
+	ID           primitive.ObjectID `json:"id,omitempty" bson:"_id,omitempty"`
+	UnionID      primitive.ObjectID `json:"unionID,omitempty" bson:"unionID"`
+	StrikeID     primitive.ObjectID `json:"strikeID,omitempty" bson:"strikeID"`
+	UserID       primitive.ObjectID `json:"userID,omitempty" bson:"userID"`
+	CaptainID    primitive.ObjectID `json:"captainID,omitempty" bson:"captainID,omitempty"`
+	WeekStart    time.Time          `json:"weekStart,omitempty" bson:"weekStart"`
+	DaysAttended int                `json:"daysAttended" bson:"daysAttended"`
+	DaysPaid     int                `json:"daysPaid" bson:"daysPaid"`
+	Amount       float64            `json:"amount" bson:"amount"`
+	Status       string             `json:"status,omitempty" bson:"status"`
+	ComputedOn   time.Time          `json:"computedOn,omitempty" bson:"computedOn"`
+	ApprovedBy   primitive.ObjectID `json:"approvedBy,omitempty" bson:"approvedBy,omitempty"`
+	ApprovedOn   *time.Time         `json:"approvedOn,omitempty" bson:"approvedOn,omitempty"`
+	ExportedOn   *time.Time         `json:"exportedOn,omitempty" bson:"exportedOn,omitempty"`
+}
+
+// StrikePayReport sums strike pay for one member or for the members of one picket captain
+type StrikePayReport struct {
+	StrikeID       primitive.ObjectID `json:"strikeID,omitempty"`
+	UserID         primitive.ObjectID `json:"userID,omitempty"`
+	CaptainID      primitive.ObjectID `json:"captainID,omitempty"`
+	Members        int                `json:"members"`
+	DaysAttended   int                `json:"daysAttended"`
+	DaysPaid       int                `json:"daysPaid"`
+	Amount         float64            `json:"amount"`
+	ApprovedAmount float64            `json:"approvedAmount"`
+	Payments       []*StrikePayment   `json:"payments"`
+}
+
+// WeekStart returns the Monday starting the week of t, in UTC
+func WeekStart(t time.Time) time.Time {
+	day := StrikeDay(t)
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// StrikeDay truncates t to its calendar day in UTC
+func StrikeDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
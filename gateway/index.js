@@ -1,20 +1,72 @@
 const { ApolloServer } = require('apollo-server');
-const { ApolloGateway, IntrospectAndCompose } = require("@apollo/gateway");
+const { ApolloGateway, IntrospectAndCompose, RemoteGraphQLDataSource } = require("@apollo/gateway");
+
+const forwardedHeaders = ['authorization', 'accept-language', 'user-agent', 'x-forwarded-for', 'x-forwarded-host'];
+
+// TRUSTED_PROXY_HOPS is the number of proxies of our own in front of the gateway,
+// such as the load balancer; each appends the address it was called from
+const trustedProxyHops = parseInt(process.env.TRUSTED_PROXY_HOPS || '0', 10) || 0;
+
+// clientAddress is the caller's address: the one our first proxy saw, or the socket's.
+// Entries further left of X-Forwarded-For come from the caller and are not trusted.
+function clientAddress(req) {
+    if (trustedProxyHops > 0) {
+        const chain = (req.headers['x-forwarded-for'] || '').split(',').map((entry) => entry.trim()).filter(Boolean);
+        if (chain.length >= trustedProxyHops) {
+            return chain[chain.length - trustedProxyHops];
+        }
+    }
+    return req.socket.remoteAddress;
+}
+
+// originalHost is the host the caller asked for, which picks the union of a custom
+// domain in the subgraphs. Our proxies pass it in X-Forwarded-Host; without them
+// the Host header is the caller's own.
+function originalHost(req) {
+    if (trustedProxyHops > 0 && req.headers['x-forwarded-host']) {
+        return req.headers['x-forwarded-host'].split(',')[0].trim();
+    }
+    return req.headers.host;
+}
 
 const gateway = new ApolloGateway({
     supergraphSdl: new IntrospectAndCompose({
         subgraphs: [
             { name: 'union', url: 'http://localhost:4001/graphql' },
             { name: 'user', url: 'http://localhost:4002/graphql' },
-            {name: 'comms', url: 'http://localhost:4003/graphql'}
+            {name: 'comms', url: 'http://localhost:4003/graphql'},
+            { name: 'cms', url: 'http://localhost:4004/graphql' }
         ]
-    })
+    }),
+    // userService resolves User references in the caller's union, so the
+    // subgraphs need the caller's token; the caller's language and address are
+    // passed on for localised messages and the login history, and the host for
+    // unions on their own domain
+    buildService({ url }) {
+        return new RemoteGraphQLDataSource({
+            url,
+            willSendRequest({ request, context }) {
+                for (const header of forwardedHeaders) {
+                    if (context[header]) {
+                        request.http.headers.set(header, context[header]);
+                    }
+                }
+            }
+        });
+    }
 });
 
 const server = new ApolloServer({
     gateway,
 
     subscriptions: false,
+    context: ({ req }) => ({
+        authorization: req.headers.authorization,
+        'accept-language': req.headers['accept-language'],
+        'user-agent': req.headers['user-agent'],
+        'x-forwarded-for': clientAddress(req),
+        'x-forwarded-host': originalHost(req),
+    }),
 });
 
 const PORT = 4000; 
@@ -5,11 +5,13 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/sirupsen/logrus"
 )
 
@@ -61,6 +63,34 @@ func (p *AWSProvider) UploadToS3(ctx context.Context, bucketName, region string,
 	return &url, nil
 }
 
+// UploadPrivate uploads data to a private object encrypted at rest. Unlike UploadToS3
+// it returns no URL; the object is shared through PresignGet.
+func (p *AWSProvider) UploadPrivate(ctx context.Context, bucketName, key string, data []byte) error {
+	_, err := p.s3Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:               aws.String(bucketName),
+		Key:                  aws.String(key),
+		Body:                 bytes.NewReader(data),
+		ServerSideEncryption: types.ServerSideEncryptionAes256,
+	})
+	if err != nil {
+		logrus.Tracef("file upload failed %v", err)
+		return fmt.Errorf("could not upload file %v", err)
+	}
+	return nil
+}
+
+// PresignGet returns a link that downloads a private object until ttl has passed
+func (p *AWSProvider) PresignGet(ctx context.Context, bucketName, key string, ttl time.Duration) (string, error) {
+	request, err := s3.NewPresignClient(p.s3Client).PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+	}, s3.WithPresignExpires(ttl))
+	if err != nil {
+		return "", fmt.Errorf("could not sign download link %v", err)
+	}
+	return request.URL, nil
+}
+
 func (p *AWSProvider) GetS3Object(ctx context.Context, bucketName, key string) ([]byte, error) {
 	result, err := p.s3Client.GetObject(ctx, &s3.GetObjectInput{
 		Bucket: aws.String(bucketName),
@@ -81,3 +111,82 @@ func (p *AWSProvider) DeleteS3Object(ctx context.Context, bucketName, key string
 	})
 	return err
 }
+
+// streamPartSize is the size of the parts UploadStream sends; S3 wants at least 5 MiB
+// for every part but the last
+const streamPartSize = 8 << 20
+
+// UploadStream uploads body to a private object encrypted at rest, one part at a time,
+// so only a part is ever held in memory. It returns the size of the object.
+func (p *AWSProvider) UploadStream(ctx context.Context, bucketName, key string, body io.Reader) (int64, error) {
+	upload, err := p.s3Client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:               aws.String(bucketName),
+		Key:                  aws.String(key),
+		ServerSideEncryption: types.ServerSideEncryptionAes256,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("could not start upload %v", err)
+	}
+	abort := func(err error) (int64, error) {
+		_, abortErr := p.s3Client.AbortMultipartUpload(context.Background(), &s3.AbortMultipartUploadInput{
+			Bucket:   aws.String(bucketName),
+			Key:      aws.String(key),
+			UploadId: upload.UploadId,
+		})
+		if abortErr != nil {
+			logrus.Warnf("could not abort upload of %s: %v", key, abortErr)
+		}
+		return 0, err
+	}
+
+	var size int64
+	parts := []types.CompletedPart{}
+	buf := make([]byte, streamPartSize)
+	for number := int32(1); ; number++ {
+		n, readErr := io.ReadFull(body, buf)
+		if readErr != nil && readErr != io.EOF && readErr != io.ErrUnexpectedEOF {
+			return abort(readErr)
+		}
+		// an empty body is still one part
+		if n > 0 || number == 1 {
+			part, err := p.s3Client.UploadPart(ctx, &s3.UploadPartInput{
+				Bucket:     aws.String(bucketName),
+				Key:        aws.String(key),
+				UploadId:   upload.UploadId,
+				PartNumber: aws.Int32(number),
+				Body:       bytes.NewReader(buf[:n]),
+			})
+			if err != nil {
+				return abort(fmt.Errorf("could not upload part %d %v", number, err))
+			}
+			parts = append(parts, types.CompletedPart{ETag: part.ETag, PartNumber: aws.Int32(number)})
+			size += int64(n)
+		}
+		if readErr != nil {
+			break
+		}
+	}
+
+	_, err = p.s3Client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(bucketName),
+		Key:             aws.String(key),
+		UploadId:        upload.UploadId,
+		MultipartUpload: &types.CompletedMultipartUpload{Parts: parts},
+	})
+	if err != nil {
+		return abort(fmt.Errorf("could not complete upload %v", err))
+	}
+	return size, nil
+}
+
+// OpenS3Object streams an object; the caller closes it
+func (p *AWSProvider) OpenS3Object(ctx context.Context, bucketName, key string) (io.ReadCloser, error) {
+	result, err := p.s3Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Body, nil
+}
@@ -0,0 +1,112 @@
+package database
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// domainTTL is how long a host's union is cached; unionService drops the entry when a
+// union's domain changes
+const domainTTL = 10 * time.Minute
+
+// noUnion is cached for hosts that belong to no union, so unknown hosts cost one lookup
+const noUnion = "-"
+
+type tenantContextKey string
+
+const domainTenantKey tenantContextKey = "domain-tenant"
+
+// DomainIndex maps the custom domain of a union to the union. The answer is cached in
+// redis, shared by every service.
+type DomainIndex struct {
+	dbManager   *DBManager
+	redisClient *RedisClient
+}
+
+func NewDomainIndex(dbManager *DBManager, redisClient *RedisClient) *DomainIndex {
+	return &DomainIndex{dbManager: dbManager, redisClient: redisClient}
+}
+
+// DomainKey is the cache key of a host's union
+func DomainKey(domain string) string {
+	return "domain:" + domain
+}
+
+// NormalizeDomain lowercases a host and drops its port and trailing dot
+func NormalizeDomain(host string) string {
+	host = strings.ToLower(strings.TrimSpace(host))
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.TrimSuffix(host, ".")
+}
+
+// Resolve returns the union serving host, or the nil id when none does
+func (d *DomainIndex) Resolve(ctx context.Context, host string) (primitive.ObjectID, error) {
+	domain := NormalizeDomain(host)
+	if domain == "" {
+		return primitive.NilObjectID, nil
+	}
+	key := DomainKey(domain)
+	if d.redisClient != nil {
+		if cached, err := d.redisClient.Get(ctx, key); err == nil {
+			if cached == noUnion {
+				return primitive.NilObjectID, nil
+			}
+			if id, err := primitive.ObjectIDFromHex(cached); err == nil {
+				return id, nil
+			}
+		}
+	}
+
+	id, err := d.dbManager.FindUnionByDomain(ctx, domain)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	if d.redisClient != nil {
+		value := noUnion
+		if !id.IsZero() {
+			value = id.Hex()
+		}
+		d.redisClient.Set(ctx, key, value, domainTTL)
+	}
+	return id, nil
+}
+
+// Invalidate drops the cached union of a domain
+func (d *DomainIndex) Invalidate(ctx context.Context, domain string) error {
+	d.dbManager.forgetDomain(NormalizeDomain(domain))
+	if d.redisClient == nil {
+		return nil
+	}
+	return d.redisClient.Delete(ctx, DomainKey(NormalizeDomain(domain)))
+}
+
+// Middleware puts the union of the requested host into the request context. The
+// gateway passes the original host in X-Forwarded-Host. Hosts of no union pass through.
+func (d *DomainIndex) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host := r.Host
+		if forwarded := r.Header.Get("X-Forwarded-Host"); forwarded != "" {
+			host, _, _ = strings.Cut(forwarded, ",")
+		}
+		id, err := d.Resolve(r.Context(), host)
+		if err != nil || id.IsZero() {
+			next.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), domainTenantKey, id)))
+	})
+}
+
+// TenantFromContext returns the union of the requested host, or the nil id when the
+// request did not come through a union's domain
+func TenantFromContext(ctx context.Context) primitive.ObjectID {
+	id, _ := ctx.Value(domainTenantKey).(primitive.ObjectID)
+	return id
+}
@@ -0,0 +1,114 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ModuleNotEnabledCode is the error code services return when a union uses a module
+// it is not entitled to
+const ModuleNotEnabledCode = "MODULE_NOT_ENABLED"
+
+// entitlementsTTL is how long a union's modules are cached; unionService drops the
+// entry when it changes them
+const entitlementsTTL = 10 * time.Minute
+
+// ModuleNotEnabledError is returned when a union does not have a module
+type ModuleNotEnabledError struct {
+	UnionID string
+	Module  string
+}
+
+func (e *ModuleNotEnabledError) Error() string {
+	return fmt.Sprintf("the %s module is not enabled for this union", e.Module)
+}
+
+// Code is the error code the API reports
+func (e *ModuleNotEnabledError) Code() string {
+	return ModuleNotEnabledCode
+}
+
+// Entitlements looks up the modules of a union. The answer is cached in redis, shared
+// by every service.
+type Entitlements struct {
+	dbManager   *DBManager
+	redisClient *RedisClient
+}
+
+func NewEntitlements(dbManager *DBManager, redisClient *RedisClient) *Entitlements {
+	return &Entitlements{dbManager: dbManager, redisClient: redisClient}
+}
+
+// EntitlementsKey is the cache key of a union's modules
+func EntitlementsKey(unionID string) string {
+	return "entitlements:" + unionID
+}
+
+// Modules returns the modules enabled for a union
+func (e *Entitlements) Modules(ctx context.Context, unionID primitive.ObjectID) ([]string, error) {
+	key := EntitlementsKey(unionID.Hex())
+	if e.redisClient != nil {
+		if cached, err := e.redisClient.Get(ctx, key); err == nil {
+			var modules []string
+			if err := json.Unmarshal([]byte(cached), &modules); err == nil {
+				return modules, nil
+			}
+		}
+	}
+
+	var union struct {
+		Modules []string `bson:"modules"`
+	}
+	err := e.dbManager.GetBaseDatabase(ctx).Collection("unions").FindOne(ctx, bson.M{"_id": unionID}).Decode(&union)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find union: %v", err)
+	}
+	if union.Modules == nil {
+		union.Modules = []string{}
+	}
+	if e.redisClient != nil {
+		if data, err := json.Marshal(union.Modules); err == nil {
+			e.redisClient.Set(ctx, key, data, entitlementsTTL)
+		}
+	}
+	return union.Modules, nil
+}
+
+// Enabled reports whether a union has a module
+func (e *Entitlements) Enabled(ctx context.Context, unionID primitive.ObjectID, module string) (bool, error) {
+	modules, err := e.Modules(ctx, unionID)
+	if err != nil {
+		return false, err
+	}
+	for _, m := range modules {
+		if m == module {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Require returns a *ModuleNotEnabledError unless the union has the module
+func (e *Entitlements) Require(ctx context.Context, unionID primitive.ObjectID, module string) error {
+	enabled, err := e.Enabled(ctx, unionID, module)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return &ModuleNotEnabledError{UnionID: unionID.Hex(), Module: module}
+	}
+	return nil
+}
+
+// Invalidate drops the cached modules of a union
+func (e *Entitlements) Invalidate(ctx context.Context, unionID primitive.ObjectID) error {
+	if e.redisClient == nil {
+		return nil
+	}
+	return e.redisClient.Delete(ctx, EntitlementsKey(unionID.Hex()))
+}
@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -14,14 +15,39 @@ import (
 )
 
 type Union struct {
-	ID      primitive.ObjectID `bson:"_id"`
-	UnionID string             `bson:"unionID"`
+	ID       primitive.ObjectID `bson:"_id"`
+	UnionID  string             `bson:"unionID"`
+	Database string             `bson:"database,omitempty"`
+	Deleted  bool               `bson:"deleted"`
+}
+
+// DatabaseName is the name of the union's tenant database. It is the slug the union
+// was created with; a union whose slug changed keeps its database in Database.
+func (u Union) DatabaseName() string {
+	if u.Database != "" {
+		return u.Database
+	}
+	return u.UnionID
+}
+
+// ErrUnionArchived is returned for the database of a deleted union; its data is being
+// archived or has been, and stays out of reach until the union is restored
+var ErrUnionArchived = errors.New("union is archived")
+
+// tenantRecheck is how long a union's database name is trusted before the union is
+// looked up again, so a deleted union is locked out within that time
+const tenantRecheck = 30 * time.Second
+
+type tenantName struct {
+	name      string
+	checkedAt time.Time
 }
 
 type DBManager struct {
 	client         *mongo.Client
 	databases      map[string]*mongo.Database
-	dbNameMap      map[string]string // Maps ObjectID to actual database name
+	dbNameMap      map[string]tenantName // Maps ObjectID to actual database name
+	domainMap      map[string]tenantName // Maps a custom domain to the union's ObjectID
 	mu             sync.RWMutex
 	baseDBName     string
 	serviceDBNames map[string]string
@@ -42,7 +68,8 @@ func NewDBManager(ctx context.Context, uri string, baseDBName string) (*DBManage
 	return &DBManager{
 		client:         client,
 		databases:      make(map[string]*mongo.Database),
-		dbNameMap:      make(map[string]string),
+		dbNameMap:      make(map[string]tenantName),
+		domainMap:      make(map[string]tenantName),
 		baseDBName:     baseDBName,
 		serviceDBNames: make(map[string]string),
 	}, nil
@@ -51,9 +78,9 @@ func NewDBManager(ctx context.Context, uri string, baseDBName string) (*DBManage
 func (m *DBManager) FindDBName(ctx context.Context, key string) (string, error) {
 	// First check the cache
 	m.mu.RLock()
-	if dbName, exists := m.dbNameMap[key]; exists {
+	if tenant, exists := m.dbNameMap[key]; exists && time.Since(tenant.checkedAt) < tenantRecheck {
 		m.mu.RUnlock()
-		return dbName, nil
+		return tenant.name, nil
 	}
 	m.mu.RUnlock()
 
@@ -74,25 +101,30 @@ func (m *DBManager) FindDBName(ctx context.Context, key string) (string, error)
 		return "", fmt.Errorf("failed to find union: %v", err)
 	}
 	m.mu.Lock()
-	m.dbNameMap[key] = union.UnionID
-	m.mu.Unlock()
+	defer m.mu.Unlock()
+	if union.Deleted {
+		delete(m.dbNameMap, key)
+		delete(m.databases, key)
+		return "", ErrUnionArchived
+	}
+	m.dbNameMap[key] = tenantName{name: union.DatabaseName(), checkedAt: time.Now()}
 
-	return union.UnionID, nil
+	return union.DatabaseName(), nil
 }
 
 func (m *DBManager) GetDatabase(ctx context.Context, key string) (*mongo.Database, error) {
+	// resolve the name first, it is what locks out archived unions
+	dbName, err := m.FindDBName(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
 	m.mu.RLock()
 	if db, exists := m.databases[key]; exists {
 		m.mu.RUnlock()
 		return db, nil
 	}
-
 	m.mu.RUnlock()
-
-	dbName, err := m.FindDBName(ctx, key)
-	if err != nil {
-		return nil, err
-	}
 	fmt.Println("print db: ", dbName)
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -136,3 +168,53 @@ func (m *DBManager) GetServiceDBName(key string) (string, bool) {
 	dbName, exists := m.serviceDBNames[key]
 	return dbName, exists
 }
+
+// ListUnions returns every union that still owns a tenant database, for jobs that
+// have to run across all tenants
+func (m *DBManager) ListUnions(ctx context.Context) ([]Union, error) {
+	unifiedDB := m.client.Database("unified_base")
+	cursor, err := unifiedDB.Collection("unions").Find(ctx, bson.M{"deleted": bson.M{"$ne": true}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unions: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	var unions []Union
+	if err := cursor.All(ctx, &unions); err != nil {
+		return nil, fmt.Errorf("failed to decode unions: %v", err)
+	}
+	return unions, nil
+}
+
+// FindUnionByDomain returns the union whose custom domain is domain, or the nil id when
+// no union has it. Deleted unions do not resolve.
+func (m *DBManager) FindUnionByDomain(ctx context.Context, domain string) (primitive.ObjectID, error) {
+	m.mu.RLock()
+	if tenant, exists := m.domainMap[domain]; exists && time.Since(tenant.checkedAt) < tenantRecheck {
+		m.mu.RUnlock()
+		id, _ := primitive.ObjectIDFromHex(tenant.name)
+		return id, nil
+	}
+	m.mu.RUnlock()
+
+	unifiedDB := m.client.Database("unified_base")
+	var union Union
+	err := unifiedDB.Collection("unions").FindOne(ctx, bson.M{"domain": domain, "deleted": bson.M{"$ne": true}}).Decode(&union)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return primitive.NilObjectID, fmt.Errorf("failed to find union by domain: %v", err)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if union.ID.IsZero() {
+		m.domainMap[domain] = tenantName{checkedAt: time.Now()}
+		return primitive.NilObjectID, nil
+	}
+	m.domainMap[domain] = tenantName{name: union.ID.Hex(), checkedAt: time.Now()}
+	return union.ID, nil
+}
+
+func (m *DBManager) forgetDomain(domain string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.domainMap, domain)
+}
@@ -100,3 +100,29 @@ func (r *RedisClient) Delete(ctx context.Context, key string) error {
 func (r *RedisClient) Expire(ctx context.Context, key string, expiration time.Duration) error {
 	return r.client.Expire(ctx, key, expiration).Err()
 }
+
+// Publish sends message to the subscribers of channel
+func (r *RedisClient) Publish(ctx context.Context, channel string, message interface{}) error {
+	return r.client.Publish(ctx, channel, message).Err()
+}
+
+// Subscribe calls handle with every message published on channel until ctx is done
+func (r *RedisClient) Subscribe(ctx context.Context, channel string, handle func(payload string)) error {
+	pubsub := r.client.Subscribe(ctx, channel)
+	defer pubsub.Close()
+	if _, err := pubsub.Receive(ctx); err != nil {
+		return err
+	}
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case message, ok := <-messages:
+			if !ok {
+				return fmt.Errorf("subscription to %s closed", channel)
+			}
+			handle(message.Payload)
+		}
+	}
+}
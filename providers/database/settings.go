@@ -0,0 +1,355 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Types of settings
+const (
+	SettingBoolean = "boolean"
+	SettingInt     = "int"
+	SettingString  = "string"
+)
+
+// Settings every service can read
+const (
+	// SettingResetPasswordEmail picks the body of the password reset email
+	SettingResetPasswordEmail = "email.resetPasswordTemplate"
+	// SettingPasswordResetURL is where the reset email links to
+	SettingPasswordResetURL = "auth.passwordResetURL"
+	// SettingMembershipCardTTLHours is how long a membership card is valid
+	SettingMembershipCardTTLHours = "membership.cardTTLHours"
+)
+
+// SettingsChannel is where unionService announces that a union's settings changed;
+// the message is the union id
+const SettingsChannel = "settings:changed"
+
+// SettingsCollection holds the overrides of every union in the base database
+const SettingsCollection = "union_settings"
+
+// settingsTTL is how long a union's overrides stay in redis; unionService drops the
+// entry when they change
+const settingsTTL = 10 * time.Minute
+
+// settingsLocalTTL is how long a service trusts its own copy when it hears no change
+const settingsLocalTTL = time.Minute
+
+// SettingDefinition is one setting of the schema. Default applies to unions that do not
+// override it.
+type SettingDefinition struct {
+	Key         string      `json:"key"`
+	Type        string      `json:"type"`
+	Description string      `json:"description"`
+	Default     interface{} `json:"default"`
+	Options     []string    `json:"options,omitempty"`
+	Min         *int64      `json:"min,omitempty"`
+	Max         *int64      `json:"max,omitempty"`
+	MaxLength   int         `json:"maxLength,omitempty"`
+	// Validate checks a string value further than the schema can describe
+	Validate func(value string) error `json:"-"`
+}
+
+func limit(n int64) *int64 {
+	return &n
+}
+
+// settingsSchema is every setting a union can have. Add a setting here before reading it.
+var settingsSchema = []SettingDefinition{
+	{
+		Key:         SettingResetPasswordEmail,
+		Type:        SettingString,
+		Description: "the body of the password reset email",
+		Default:     "standard",
+		Options:     []string{"standard", "downsyndrome"},
+	},
+	{
+		Key:         SettingPasswordResetURL,
+		Type:        SettingString,
+		Description: "the page the password reset email links to; empty uses the platform's",
+		Default:     "",
+		MaxLength:   2048,
+		Validate:    validatePasswordResetURL,
+	},
+	{
+		Key:         SettingMembershipCardTTLHours,
+		Type:        SettingInt,
+		Description: "hours a membership card is valid; 0 uses the platform's",
+		Default:     int64(0),
+		Min:         limit(0),
+		Max:         limit(24 * 365),
+	},
+}
+
+// validatePasswordResetURL accepts https links to the hosts in PASSWORD_RESET_HOSTS, a
+// comma separated list, so a union's admin can't send members' reset links elsewhere
+func validatePasswordResetURL(value string) error {
+	if value == "" {
+		return nil
+	}
+	link, err := url.Parse(value)
+	if err != nil || link.Scheme != "https" || link.Hostname() == "" || link.User != nil {
+		return fmt.Errorf("%s is an https link", SettingPasswordResetURL)
+	}
+	for _, host := range strings.Split(os.Getenv("PASSWORD_RESET_HOSTS"), ",") {
+		if host = strings.TrimSpace(host); host != "" && strings.EqualFold(host, link.Hostname()) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s must link to one of the platform's hosts", SettingPasswordResetURL)
+}
+
+// SettingsSchema returns every setting a union can have
+func SettingsSchema() []SettingDefinition {
+	return settingsSchema
+}
+
+// SettingDefinitionOf returns the definition of key
+func SettingDefinitionOf(key string) (SettingDefinition, bool) {
+	for _, definition := range settingsSchema {
+		if definition.Key == key {
+			return definition, true
+		}
+	}
+	return SettingDefinition{}, false
+}
+
+// ParseSetting checks value against the schema of key and returns it typed: a bool, an
+// int64 or a string
+func ParseSetting(key string, value string) (interface{}, error) {
+	definition, ok := SettingDefinitionOf(key)
+	if !ok {
+		return nil, fmt.Errorf("there is no setting %s", key)
+	}
+	switch definition.Type {
+	case SettingBoolean:
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s is true or false", key)
+		}
+		return parsed, nil
+	case SettingInt:
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s is a whole number", key)
+		}
+		if definition.Min != nil && parsed < *definition.Min {
+			return nil, fmt.Errorf("%s is at least %d", key, *definition.Min)
+		}
+		if definition.Max != nil && parsed > *definition.Max {
+			return nil, fmt.Errorf("%s is at most %d", key, *definition.Max)
+		}
+		return parsed, nil
+	default:
+		if definition.MaxLength > 0 && len(value) > definition.MaxLength {
+			return nil, fmt.Errorf("%s has at most %d characters", key, definition.MaxLength)
+		}
+		if len(definition.Options) > 0 {
+			for _, option := range definition.Options {
+				if option == value {
+					return value, nil
+				}
+			}
+			return nil, fmt.Errorf("%s is one of %v", key, definition.Options)
+		}
+		if definition.Validate != nil {
+			if err := definition.Validate(value); err != nil {
+				return nil, err
+			}
+		}
+		return value, nil
+	}
+}
+
+// FormatSetting writes a typed value the way ParseSetting reads it
+func FormatSetting(value interface{}) string {
+	switch v := value.(type) {
+	case bool:
+		return strconv.FormatBool(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case int32:
+		return strconv.FormatInt(int64(v), 10)
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatInt(int64(v), 10)
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+type localSettings struct {
+	values    map[string]string
+	fetchedAt time.Time
+}
+
+// Settings reads the settings of a union: its overrides, then the schema's defaults.
+// Overrides are cached in redis, shared by every service, and briefly in the service
+// itself; Listen drops the service's copy as soon as unionService changes them.
+type Settings struct {
+	dbManager   *DBManager
+	redisClient *RedisClient
+	mu          sync.RWMutex
+	local       map[primitive.ObjectID]localSettings
+}
+
+func NewSettings(dbManager *DBManager, redisClient *RedisClient) *Settings {
+	return &Settings{dbManager: dbManager, redisClient: redisClient, local: make(map[primitive.ObjectID]localSettings)}
+}
+
+// SettingsKey is the cache key of a union's overrides
+func SettingsKey(unionID string) string {
+	return "settings:" + unionID
+}
+
+// Overrides returns the settings a union overrides, formatted as ParseSetting reads them
+func (s *Settings) Overrides(ctx context.Context, unionID primitive.ObjectID) (map[string]string, error) {
+	s.mu.RLock()
+	if cached, ok := s.local[unionID]; ok && time.Since(cached.fetchedAt) < settingsLocalTTL {
+		s.mu.RUnlock()
+		return cached.values, nil
+	}
+	s.mu.RUnlock()
+
+	values, err := s.sharedOverrides(ctx, unionID)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	s.local[unionID] = localSettings{values: values, fetchedAt: time.Now()}
+	s.mu.Unlock()
+	return values, nil
+}
+
+func (s *Settings) sharedOverrides(ctx context.Context, unionID primitive.ObjectID) (map[string]string, error) {
+	key := SettingsKey(unionID.Hex())
+	if s.redisClient != nil {
+		if cached, err := s.redisClient.Get(ctx, key); err == nil {
+			var values map[string]string
+			if err := json.Unmarshal([]byte(cached), &values); err == nil {
+				return values, nil
+			}
+		}
+	}
+
+	var stored struct {
+		Overrides []struct {
+			Key   string      `bson:"key"`
+			Value interface{} `bson:"value"`
+		} `bson:"overrides"`
+	}
+	err := s.dbManager.GetBaseDatabase(ctx).Collection(SettingsCollection).FindOne(ctx, bson.M{"_id": unionID}).Decode(&stored)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("failed to find union settings: %v", err)
+	}
+	values := map[string]string{}
+	for _, override := range stored.Overrides {
+		values[override.Key] = FormatSetting(override.Value)
+	}
+	if s.redisClient != nil {
+		if data, err := json.Marshal(values); err == nil {
+			s.redisClient.Set(ctx, key, data, settingsTTL)
+		}
+	}
+	return values, nil
+}
+
+// Value returns the typed setting of a union: its override when valid, else the default
+func (s *Settings) Value(ctx context.Context, unionID primitive.ObjectID, key string) (interface{}, error) {
+	definition, ok := SettingDefinitionOf(key)
+	if !ok {
+		return nil, fmt.Errorf("there is no setting %s", key)
+	}
+	overrides, err := s.Overrides(ctx, unionID)
+	if err != nil {
+		return definition.Default, err
+	}
+	if raw, ok := overrides[key]; ok {
+		// an override the schema no longer accepts falls back to the default
+		if value, err := ParseSetting(key, raw); err == nil {
+			return value, nil
+		}
+	}
+	return definition.Default, nil
+}
+
+// String returns a string setting of a union; on error it is the default
+func (s *Settings) String(ctx context.Context, unionID primitive.ObjectID, key string) (string, error) {
+	value, err := s.Value(ctx, unionID, key)
+	text, _ := value.(string)
+	return text, err
+}
+
+// Int returns a whole number setting of a union; on error it is the default
+func (s *Settings) Int(ctx context.Context, unionID primitive.ObjectID, key string) (int64, error) {
+	value, err := s.Value(ctx, unionID, key)
+	number, _ := value.(int64)
+	return number, err
+}
+
+// Bool returns a boolean setting of a union; on error it is the default
+func (s *Settings) Bool(ctx context.Context, unionID primitive.ObjectID, key string) (bool, error) {
+	value, err := s.Value(ctx, unionID, key)
+	flag, _ := value.(bool)
+	return flag, err
+}
+
+// Forget drops this service's copy of a union's overrides
+func (s *Settings) Forget(unionID primitive.ObjectID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.local, unionID)
+}
+
+// Invalidate drops the cached overrides of a union and tells every service to reload
+// them
+func (s *Settings) Invalidate(ctx context.Context, unionID primitive.ObjectID) error {
+	s.Forget(unionID)
+	if s.redisClient == nil {
+		return nil
+	}
+	if err := s.redisClient.Delete(ctx, SettingsKey(unionID.Hex())); err != nil {
+		return err
+	}
+	return s.redisClient.Publish(ctx, SettingsChannel, unionID.Hex())
+}
+
+// Listen forgets a union's overrides whenever unionService announces a change, until
+// ctx is done. Services run it in the background; it resubscribes when redis drops.
+func (s *Settings) Listen(ctx context.Context) {
+	if s.redisClient == nil {
+		return
+	}
+	for ctx.Err() == nil {
+		err := s.redisClient.Subscribe(ctx, SettingsChannel, func(payload string) {
+			if unionID, err := primitive.ObjectIDFromHex(payload); err == nil {
+				s.Forget(unionID)
+			}
+		})
+		if err != nil && ctx.Err() == nil {
+			log.Printf("settings subscription lost, resubscribing: %v", err)
+			// whatever changed meanwhile was missed
+			s.mu.Lock()
+			s.local = make(map[primitive.ObjectID]localSettings)
+			s.mu.Unlock()
+			time.Sleep(5 * time.Second)
+		}
+	}
+}
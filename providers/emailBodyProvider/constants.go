@@ -18,3 +18,31 @@ const DSRequestPasswordReset = `
 			</html>`
 
 const younifiedPasswordReset = `<p> Hello </p> <p>We've received a request to reset the password for the username: <b>%s</b></p><p>If you didn't make this request, please disregard this email.</p><p> You can reset your password by clicking the link below: </p> <p><i> Expires in one hour! </i></p><p>%s</p>`
+
+const younifiedEmailVerification = `<p> Hello </p> <p>Please confirm the email address for the username: <b>%s</b></p><p>Your verification code is:</p><p><b>%s</b></p><p><i> Expires in one hour! </i></p><p>If you didn't make this request, please disregard this email.</p>`
+
+const younifiedShiftReminder = `<p> Hello %s </p> <p>This is a reminder of your picket shift at <b>%s</b></p><p>%s</p><p>From <b>%s</b> to <b>%s</b></p><p>Please check in with your picket captain when you arrive. If you can no longer make it, withdraw or ask another member to swap so the line stays covered.</p>`
+
+const younifiedDuesStatement = `<p> Hello %s </p> <p>This is your dues statement from <b>%s</b> to <b>%s</b></p><table><tr><td colspan="2">Opening balance</td><td>%s</td></tr>%s<tr><td colspan="2"><b>Closing balance</b></td><td><b>%s</b></td></tr></table><p>Please contact your union office if anything looks wrong.</p>`
+
+const younifiedDuesStatementRow = `<tr><td>%s</td><td>%s</td><td>%s</td></tr>`
+
+const younifiedMilestoneReport = `<p> Hello </p> <p>These members reach <b>%s</b> between <b>%s</b> and <b>%s</b></p><table><tr><th>Name</th><th>Employee ID</th><th>Unit</th><th>Date</th></tr>%s</table><p>The full report can be exported from the admin portal.</p>`
+
+const younifiedMilestoneReportRow = `<tr><td>%s</td><td>%s</td><td>%s</td><td>%s</td></tr>`
+
+const frenchPasswordReset = `<p> Bonjour </p> <p>Nous avons reçu une demande de réinitialisation du mot de passe pour le nom d'utilisateur : <b>%s</b></p><p>Si vous n'êtes pas à l'origine de cette demande, veuillez ignorer ce courriel.</p><p> Vous pouvez réinitialiser votre mot de passe en cliquant sur le lien ci-dessous : </p> <p><i> Expire dans une heure! </i></p><p>%s</p>`
+
+const frenchEmailVerification = `<p> Bonjour </p> <p>Veuillez confirmer l'adresse courriel du nom d'utilisateur : <b>%s</b></p><p>Votre code de vérification est :</p><p><b>%s</b></p><p><i> Expire dans une heure! </i></p><p>Si vous n'êtes pas à l'origine de cette demande, veuillez ignorer ce courriel.</p>`
+
+const frenchShiftReminder = `<p> Bonjour %s </p> <p>Ceci est un rappel de votre quart de piquetage à <b>%s</b></p><p>%s</p><p>De <b>%s</b> à <b>%s</b></p><p>Veuillez vous présenter à votre capitaine de piquetage à votre arrivée. Si vous ne pouvez plus venir, retirez-vous ou demandez à un autre membre d'échanger afin que la ligne reste couverte.</p>`
+
+const frenchDuesStatement = `<p> Bonjour %s </p> <p>Voici votre relevé de cotisations du <b>%s</b> au <b>%s</b></p><table><tr><td colspan="2">Solde d'ouverture</td><td>%s</td></tr>%s<tr><td colspan="2"><b>Solde de clôture</b></td><td><b>%s</b></td></tr></table><p>Veuillez communiquer avec le bureau de votre syndicat si quelque chose semble incorrect.</p>`
+
+const frenchMilestoneReport = `<p> Bonjour </p> <p>Ces membres atteignent <b>%s</b> entre le <b>%s</b> et le <b>%s</b></p><table><tr><th>Nom</th><th>Numéro d'employé</th><th>Unité</th><th>Date</th></tr>%s</table><p>Le rapport complet peut être exporté depuis le portail d'administration.</p>`
+
+const younifiedWelcome = `<p> Hello %s </p> <p>Your union <b>%s</b> is ready on Younified.</p><p>You can sign in with the username <b>%s</b> and the password you chose when registering.</p><p>%s</p>`
+
+const frenchWelcome = `<p> Bonjour %s </p> <p>Votre syndicat <b>%s</b> est prêt sur Younified.</p><p>Vous pouvez vous connecter avec le nom d'utilisateur <b>%s</b> et le mot de passe choisi lors de l'inscription.</p><p>%s</p>`
+
+const younifiedManagerAssigned = `<p> Hello %s </p> <p>You are now the <b>%s</b> of <b>%s</b> on Younified.</p><p>The union and its contacts are listed in the staff portal under your unions.</p>`
@@ -1,14 +1,183 @@
 package emailbodyprovider
 
-import "fmt"
+import (
+	"fmt"
+	"html"
+)
 
-func GetResetPasswordBody(unionID string, username string, link string) string {
-	var contentBody string
-	if unionID == "downsyndrome" {
-		contentBody = DSRequestPasswordReset
-	} else {
-		contentBody = younifiedPasswordReset
+// Template names, also the keys a union overrides a template with, prefixed by "email."
+const (
+	ResetPassword      = "resetPassword"
+	EmailVerification  = "emailVerification"
+	ShiftReminder      = "shiftReminder"
+	DuesStatement      = "duesStatement"
+	DuesStatementRow   = "duesStatementRow"
+	MilestoneReport    = "milestoneReport"
+	MilestoneReportRow = "milestoneReportRow"
+	Welcome            = "welcome"
+	ManagerAssigned    = "managerAssigned"
+)
+
+const defaultLocale = "en"
+
+// templates are the email bodies by locale; English has them all
+var templates = map[string]map[string]string{
+	"en": {
+		ResetPassword:      younifiedPasswordReset,
+		EmailVerification:  younifiedEmailVerification,
+		ShiftReminder:      younifiedShiftReminder,
+		DuesStatement:      younifiedDuesStatement,
+		DuesStatementRow:   younifiedDuesStatementRow,
+		MilestoneReport:    younifiedMilestoneReport,
+		MilestoneReportRow: younifiedMilestoneReportRow,
+		Welcome:            younifiedWelcome,
+		ManagerAssigned:    younifiedManagerAssigned,
+	},
+	"fr": {
+		ResetPassword:     frenchPasswordReset,
+		EmailVerification: frenchEmailVerification,
+		ShiftReminder:     frenchShiftReminder,
+		DuesStatement:     frenchDuesStatement,
+		MilestoneReport:   frenchMilestoneReport,
+		Welcome:           frenchWelcome,
+	},
+}
+
+// Templates returns the names of every template
+func Templates() []string {
+	names := make([]string, 0, len(templates[defaultLocale]))
+	for name := range templates[defaultLocale] {
+		names = append(names, name)
+	}
+	return names
+}
+
+// Template returns the template name in locale, falling back to English
+func Template(locale string, name string) (string, bool) {
+	if body, ok := templates[locale][name]; ok {
+		return body, true
+	}
+	body, ok := templates[defaultLocale][name]
+	return body, ok
+}
+
+// Bodies builds the email bodies of one locale, with a union's overrides
+type Bodies struct {
+	locale    string
+	overrides map[string]string
+}
+
+// For returns the bodies of locale; overrides are keyed "email.<template name>"
+func For(locale string, overrides map[string]string) Bodies {
+	return Bodies{locale: locale, overrides: overrides}
+}
+
+// rendered marks an argument that already is HTML, such as the rows of a statement
+// built with the row template, so format does not escape it again
+type rendered string
+
+// format fills template name with args, HTML-escaping all but rendered ones
+func (b Bodies) format(name string, args ...interface{}) string {
+	body, ok := b.overrides["email."+name]
+	if !ok || body == "" {
+		body, _ = Template(b.locale, name)
+	}
+	return fmt.Sprintf(body, escape(args...)...)
+}
+
+func escape(args ...interface{}) []interface{} {
+	escaped := make([]interface{}, len(args))
+	for i, arg := range args {
+		if r, ok := arg.(rendered); ok {
+			escaped[i] = string(r)
+			continue
+		}
+		escaped[i] = html.EscapeString(fmt.Sprint(arg))
+	}
+	return escaped
+}
+
+// Password reset emails a union picks from with its email.resetPasswordTemplate setting
+const (
+	ResetPasswordStandard     = "standard"
+	ResetPasswordDownSyndrome = "downsyndrome"
+)
+
+// ResetPassword builds the password reset email of template, one of the ResetPassword
+// templates; a union's override of the standard one applies to it alone
+func (b Bodies) ResetPassword(template string, username string, link string) string {
+	if template == ResetPasswordDownSyndrome {
+		return fmt.Sprintf(DSRequestPasswordReset, escape(username, link)...)
 	}
-	return fmt.Sprintf(contentBody, username, link)
+	return b.format(ResetPassword, username, link)
+}
+
+func (b Bodies) EmailVerification(username string, code string) string {
+	return b.format(EmailVerification, username, code)
+}
+
+func (b Bodies) ShiftReminder(name string, site string, address string, start string, end string) string {
+	return b.format(ShiftReminder, name, site, address, start, end)
+}
+
+// DuesStatement builds a statement around rows, the bodies built with DuesStatementRow
+func (b Bodies) DuesStatement(name string, from string, to string, opening string, rows string, closing string) string {
+	return b.format(DuesStatement, name, from, to, opening, rendered(rows), closing)
+}
+
+func (b Bodies) DuesStatementRow(date string, description string, amount string) string {
+	return b.format(DuesStatementRow, date, description, amount)
+}
+
+// MilestoneReport builds a report around rows, the bodies built with MilestoneReportRow
+func (b Bodies) MilestoneReport(rule string, from string, to string, rows string) string {
+	return b.format(MilestoneReport, rule, from, to, rendered(rows))
+}
+
+func (b Bodies) MilestoneReportRow(name string, employeeID string, unit string, date string) string {
+	return b.format(MilestoneReportRow, name, employeeID, unit, date)
+}
+
+func (b Bodies) Welcome(name string, union string, username string, link string) string {
+	return b.format(Welcome, name, union, username, link)
+}
+
+func (b Bodies) ManagerAssigned(name string, role string, union string) string {
+	return b.format(ManagerAssigned, name, role, union)
+}
+
+func GetResetPasswordBody(template string, username string, link string) string {
+	return For(defaultLocale, nil).ResetPassword(template, username, link)
+}
+
+func GetEmailVerificationBody(username string, code string) string {
+	return For(defaultLocale, nil).EmailVerification(username, code)
+}
+
+func GetShiftReminderBody(name string, site string, address string, start string, end string) string {
+	return For(defaultLocale, nil).ShiftReminder(name, site, address, start, end)
+}
+
+func GetDuesStatementBody(name string, from string, to string, opening string, rows string, closing string) string {
+	return For(defaultLocale, nil).DuesStatement(name, from, to, opening, rows, closing)
+}
+
+func GetDuesStatementRow(date string, description string, amount string) string {
+	return For(defaultLocale, nil).DuesStatementRow(date, description, amount)
+}
+
+func GetMilestoneReportBody(rule string, from string, to string, rows string) string {
+	return For(defaultLocale, nil).MilestoneReport(rule, from, to, rows)
+}
+
+func GetMilestoneReportRow(name string, employeeID string, unit string, date string) string {
+	return For(defaultLocale, nil).MilestoneReportRow(name, employeeID, unit, date)
+}
+
+func GetWelcomeBody(name string, union string, username string, link string) string {
+	return For(defaultLocale, nil).Welcome(name, union, username, link)
+}
 
+func GetManagerAssignedBody(name string, role string, union string) string {
+	return For(defaultLocale, nil).ManagerAssigned(name, role, union)
 }
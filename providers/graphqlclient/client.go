@@ -65,6 +65,63 @@ func (c *Graph) Execute(ctx context.Context, query string, variables map[string]
 		return json.Unmarshal(cachedData, result)
 	}
 
+	c.gqlClient.mu.RLock()
+	endpoint := c.gqlClient.endpoint
+	c.gqlClient.mu.RUnlock()
+	data, err := post(ctx, c.gqlClient.httpClient, endpoint, c.gqlClient.provider, nil, query, variables)
+	if err != nil {
+		return err
+	}
+
+	// Cache the result
+	c.gqlClient.cache.Set(cacheKey, data)
+
+	// Unmarshal result
+	return json.Unmarshal(data, result)
+}
+
+// Call is a request to one endpoint. It has its own endpoint, so concurrent callers
+// of the shared Graph cannot point each other at another service, and it is never
+// cached, so callers always see current data.
+type Call struct {
+	endpoint   string
+	httpClient *http.Client
+	provider   Provider
+	headers    map[string]string
+}
+
+// Endpoint returns a Call to endpoint that shares the client's HTTP connections and
+// authentication
+func (g *Graph) Endpoint(endpoint string) *Call {
+	return &Call{
+		endpoint:   endpoint,
+		httpClient: g.gqlClient.httpClient,
+		provider:   g.gqlClient.provider,
+		headers:    map[string]string{},
+	}
+}
+
+// WithHeader sends header with the call
+func (c *Call) WithHeader(key, value string) *Call {
+	c.headers[key] = value
+	return c
+}
+
+// AsService authenticates the call as a backend service, see ServiceHeader
+func (c *Call) AsService() *Call {
+	return c.WithHeader(ServiceHeader, ServiceToken())
+}
+
+// Execute performs the GraphQL request
+func (c *Call) Execute(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
+	data, err := post(ctx, c.httpClient, c.endpoint, c.provider, c.headers, query, variables)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, result)
+}
+
+func post(ctx context.Context, httpClient *http.Client, endpoint string, provider Provider, headers map[string]string, query string, variables map[string]interface{}) (json.RawMessage, error) {
 	// Prepare request
 	requestBody := map[string]interface{}{
 		"query":     query,
@@ -73,24 +130,26 @@ func (c *Graph) Execute(ctx context.Context, query string, variables map[string]
 
 	jsonBody, err := json.Marshal(requestBody)
 	if err != nil {
-		return fmt.Errorf("failed to marshal request: %v", err)
+		return nil, fmt.Errorf("failed to marshal request: %v", err)
 	}
-	// Add authentication if provider exists
-	req, err := http.NewRequestWithContext(ctx, "POST", c.gqlClient.endpoint, bytes.NewBuffer(jsonBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewBuffer(jsonBody))
 	if err != nil {
-		return fmt.Errorf("failed to create request: %v", err)
+		return nil, fmt.Errorf("failed to create request: %v", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
 
 	// Add authentication
-	if c.gqlClient.provider != nil {
-		c.gqlClient.provider.Authenticate(req)
+	if provider != nil {
+		provider.Authenticate(req)
 	}
 
 	// Send request
-	resp, err := c.gqlClient.httpClient.Do(req)
+	resp, err := httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("request failed: %v", err)
+		return nil, fmt.Errorf("request failed: %v", err)
 	}
 	defer resp.Body.Close()
 
@@ -100,17 +159,12 @@ func (c *Graph) Execute(ctx context.Context, query string, variables map[string]
 		Errors []GraphQLError  `json:"errors"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return fmt.Errorf("failed to decode response: %v", err)
+		return nil, fmt.Errorf("failed to decode response: %v", err)
 	}
 
 	// Check for GraphQL errors
 	if len(response.Errors) > 0 {
-		return fmt.Errorf("graphql errors: %v", response.Errors)
+		return nil, fmt.Errorf("graphql errors: %v", response.Errors)
 	}
-
-	// Cache the result
-	c.gqlClient.cache.Set(cacheKey, response.Data)
-
-	// Unmarshal result
-	return json.Unmarshal(response.Data, result)
+	return response.Data, nil
 }
@@ -1,9 +1,52 @@
 package graphqlclient
 
 import (
+	"context"
+	"crypto/subtle"
 	"net/http"
+	"os"
 )
 
+// ServiceHeader carries the token backend services call each other with. The gateway
+// never forwards it, so only the services themselves can send it.
+const ServiceHeader = "X-Service-Token"
+
+// ServiceToken is the shared secret of the backend services
+func ServiceToken() string {
+	return os.Getenv("SERVICE_TOKEN")
+}
+
+// IsServiceRequest reports whether req carries the service token. Without a
+// SERVICE_TOKEN configured no request is a service's.
+func IsServiceRequest(req *http.Request) bool {
+	token := ServiceToken()
+	if token == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(req.Header.Get(ServiceHeader)), []byte(token)) == 1
+}
+
+type contextKey string
+
+const serviceContextKey contextKey = "service-call"
+
+// ServiceMiddleware marks the context of requests that carry the service token, see
+// IsService
+func ServiceMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if IsServiceRequest(r) {
+			r = r.WithContext(context.WithValue(r.Context(), serviceContextKey, true))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// IsService reports whether the request of ctx came from another backend service
+func IsService(ctx context.Context) bool {
+	service, _ := ctx.Value(serviceContextKey).(bool)
+	return service
+}
+
 // Provider handles authentication for GraphQL requests
 type Provider interface {
 	Authenticate(req *http.Request)
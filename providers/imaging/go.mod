@@ -0,0 +1,5 @@
+module imaging
+
+go 1.23.2
+
+require golang.org/x/image v0.23.0
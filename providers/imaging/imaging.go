@@ -0,0 +1,122 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	// DefaultMaxBytes is the largest upload accepted when no limit is given
+	DefaultMaxBytes int64 = 10 << 20
+	// MaxPixels guards against decompression bombs
+	MaxPixels = 40_000_000
+	// jpegQuality is used for every re-encoded image
+	jpegQuality = 85
+)
+
+var (
+	// ErrUnsupportedType is returned when the upload is not an accepted image format
+	ErrUnsupportedType = errors.New("unsupported image type, allowed types are jpeg, png, gif and webp")
+
+	// ErrTooLarge is returned when the upload exceeds the byte or pixel limit
+	ErrTooLarge = errors.New("image is too large")
+)
+
+// AllowedContentTypes lists the sniffed content types accepted for upload
+var AllowedContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// Variant describes a square thumbnail rendition
+type Variant struct {
+	Name string
+	Size int
+}
+
+// StandardVariants are the thumbnail sizes generated for every profile photo
+var StandardVariants = []Variant{
+	{Name: "small", Size: 64},
+	{Name: "medium", Size: 256},
+	{Name: "large", Size: 512},
+}
+
+// Read loads at most maxBytes from r and fails when the limit is exceeded
+func Read(r io.Reader, maxBytes int64) ([]byte, error) {
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxBytes
+	}
+	data, err := io.ReadAll(io.LimitReader(r, maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("could not read image: %v", err)
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, fmt.Errorf("%w: limit is %d bytes", ErrTooLarge, maxBytes)
+	}
+	return data, nil
+}
+
+// Decode validates the content type and dimensions of data and returns the
+// decoded image with its EXIF orientation applied
+func Decode(data []byte) (image.Image, string, error) {
+	contentType := http.DetectContentType(data)
+	if !AllowedContentTypes[contentType] {
+		return nil, contentType, ErrUnsupportedType
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, contentType, fmt.Errorf("could not read image header: %v", err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > MaxPixels {
+		return nil, contentType, fmt.Errorf("%w: %dx%d pixels", ErrTooLarge, cfg.Width, cfg.Height)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, contentType, fmt.Errorf("could not decode image: %v", err)
+	}
+
+	if contentType == "image/jpeg" {
+		img = applyOrientation(img, jpegOrientation(data))
+	}
+	return img, contentType, nil
+}
+
+// EncodeJPEG flattens img on a white background and encodes it as JPEG.
+// Re-encoding drops EXIF and every other metadata segment of the source.
+func EncodeJPEG(img image.Image) ([]byte, error) {
+	bounds := img.Bounds()
+	canvas := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(canvas, canvas.Bounds(), &image.Uniform{C: color.White}, image.Point{}, draw.Src)
+	draw.Draw(canvas, canvas.Bounds(), img, bounds.Min, draw.Over)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, canvas, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, fmt.Errorf("could not encode image: %v", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodePNG encodes img as PNG, keeping its transparency. Like EncodeJPEG it drops
+// every metadata chunk of the source.
+func EncodePNG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("could not encode image: %v", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,40 @@
+package imaging
+
+import (
+	"image"
+
+	"golang.org/x/image/draw"
+)
+
+// Thumbnail centre-crops img to a square and scales it to size x size
+func Thumbnail(img image.Image, size int) image.Image {
+	bounds := img.Bounds()
+	side := min(bounds.Dx(), bounds.Dy())
+	x0 := bounds.Min.X + (bounds.Dx()-side)/2
+	y0 := bounds.Min.Y + (bounds.Dy()-side)/2
+	crop := image.Rect(x0, y0, x0+side, y0+side)
+
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, crop, draw.Over, nil)
+	return dst
+}
+
+// Fit scales img down so that neither side exceeds maxSide, keeping the aspect ratio
+func Fit(img image.Image, maxSide int) image.Image {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	if w <= maxSide && h <= maxSide {
+		return img
+	}
+	if w >= h {
+		h = h * maxSide / w
+		w = maxSide
+	} else {
+		w = w * maxSide / h
+		h = maxSide
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, max(w, 1), max(h, 1)))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Over, nil)
+	return dst
+}
@@ -2,6 +2,7 @@ module younified-backend/services/cmsService
 
 require (
 	github.com/99designs/gqlgen v0.17.60
+	github.com/dgrijalva/jwt-go v3.2.0+incompatible
 	github.com/google/uuid v1.6.0
 	github.com/joho/godotenv v1.5.1
 	github.com/sirupsen/logrus v1.9.3
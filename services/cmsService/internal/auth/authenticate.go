@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"errors"
+	"os"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	// ErrInvalidToken is returned when the token is invalid
+	ErrInvalidToken = errors.New("invalid token")
+
+	// ErrExpiredToken is returned when the token has expired
+	ErrExpiredToken = errors.New("token has expired")
+)
+
+// TokenClaim is the JWT claim userService issues at login
+type TokenClaim struct {
+	Username string             `json:"username"`
+	UserID   primitive.ObjectID `json:"user_id"`
+	UnionID  primitive.ObjectID `json:"union_id"`
+	jwt.StandardClaims
+}
+
+// ValidateJWTToken validates and parses a JWT token
+func ValidateJWTToken(tokenString string) (*TokenClaim, error) {
+	jwtSecret := os.Getenv("JWT_SECRET")
+	if jwtSecret == "" {
+		return nil, errors.New("JWT_SECRET is not set")
+	}
+
+	token, err := jwt.ParseWithClaims(tokenString, &TokenClaim{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("invalid token signing method")
+		}
+		return []byte(jwtSecret), nil
+	})
+	if err != nil {
+		if ve, ok := err.(*jwt.ValidationError); ok {
+			if ve.Errors&jwt.ValidationErrorExpired != 0 {
+				return nil, ErrExpiredToken
+			}
+		}
+		return nil, ErrInvalidToken
+	}
+
+	if claims, ok := token.Claims.(*TokenClaim); ok && token.Valid {
+		return claims, nil
+	}
+	return nil, ErrInvalidToken
+}
@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+type contextKey string
+
+const claimContextKey contextKey = "auth-claims"
+
+// Middleware puts the claims of a valid bearer token into the request context.
+// Requests without a token pass through; resolvers decide what needs one.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		token := strings.TrimSpace(strings.TrimPrefix(header, "Bearer "))
+		if header == "" || token == header {
+			next.ServeHTTP(w, r)
+			return
+		}
+		claims, err := ValidateJWTToken(token)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ctx := context.WithValue(r.Context(), claimContextKey, claims)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// ForContext returns the claims of the caller, or nil when the request was not authenticated
+func ForContext(ctx context.Context) *TokenClaim {
+	claims, _ := ctx.Value(claimContextKey).(*TokenClaim)
+	return claims
+}
@@ -5,12 +5,20 @@ import (
 	"fmt"
 	"time"
 	"younified-backend/contracts/cms/model"
+	unionModel "younified-backend/contracts/union/model"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
-func (c *CmsController) GetBlogPosts(ctx context.Context) ([]*model.Blog, error) {
+func (c *CmsController) GetBlogPosts(ctx context.Context, unionID primitive.ObjectID) ([]*model.Blog, error) {
+	if unionID.IsZero() {
+		err := fmt.Errorf("unionID is required")
+		return nil, err
+	}
+	if err := c.requireModule(ctx, unionID, unionModel.ModuleBlogs); err != nil {
+		return nil, err
+	}
 	filter := bson.M{"deleted": false}
 	blogs, err := c.CMSRepository.GetBlogs(ctx, filter)
 	if err != nil {
@@ -19,7 +27,14 @@ func (c *CmsController) GetBlogPosts(ctx context.Context) ([]*model.Blog, error)
 	return blogs, nil
 }
 
-func (c *CmsController) GetOneBlogPost(ctx context.Context, blogID primitive.ObjectID) (*model.Blog, error) {
+func (c *CmsController) GetOneBlogPost(ctx context.Context, unionID primitive.ObjectID, blogID primitive.ObjectID) (*model.Blog, error) {
+	if unionID.IsZero() {
+		err := fmt.Errorf("unionID is required")
+		return nil, err
+	}
+	if err := c.requireModule(ctx, unionID, unionModel.ModuleBlogs); err != nil {
+		return nil, err
+	}
 	filter := bson.M{"_id": blogID, "deleted": false}
 	blog, err := c.CMSRepository.GetOneBlog(ctx, filter)
 	if err != nil {
@@ -28,6 +43,9 @@ func (c *CmsController) GetOneBlogPost(ctx context.Context, blogID primitive.Obj
 	return blog, nil
 }
 func (c *CmsController) CreateBlogPost(ctx context.Context, unionID primitive.ObjectID, input model.Blog, image []*string) (*model.Blog, error) {
+	if err := c.requireModule(ctx, unionID, unionModel.ModuleBlogs); err != nil {
+		return nil, err
+	}
 	if input.CreatedOn.IsZero() {
 		input.CreatedOn = time.Now()
 	}
@@ -40,6 +58,9 @@ func (c *CmsController) CreateBlogPost(ctx context.Context, unionID primitive.Ob
 }
 
 func (c *CmsController) UpdateBlogPost(ctx context.Context, unionID primitive.ObjectID, blogID primitive.ObjectID, input model.Blog) (*model.Blog, error) {
+	if err := c.requireModule(ctx, unionID, unionModel.ModuleBlogs); err != nil {
+		return nil, err
+	}
 	filter := bson.M{"_id": blogID, "deleted": false}
 	updateDoc := bson.M{
 		"$set": input,
@@ -52,11 +73,11 @@ func (c *CmsController) UpdateBlogPost(ctx context.Context, unionID primitive.Ob
 }
 
 func (c *CmsController) DeleteBlogPost(ctx context.Context, unionID primitive.ObjectID, blogID primitive.ObjectID) (*string, error) {
-	filter := bson.M{"_id": blogID, "deleted": false}
-	updateDoc := bson.M{
-		"$set": bson.M{"deleted": true},
+	if err := c.requireModule(ctx, unionID, unionModel.ModuleBlogs); err != nil {
+		return nil, err
 	}
-	_, err := c.CMSRepository.UpdateBlog(ctx, filter, updateDoc)
+	filter := bson.M{"_id": blogID, "deleted": false}
+	_, err := c.CMSRepository.DeleteBlog(ctx, filter)
 	if err != nil {
 		return nil, fmt.Errorf("could not find blog post to delete%v", err)
 	}
@@ -64,6 +85,9 @@ func (c *CmsController) DeleteBlogPost(ctx context.Context, unionID primitive.Ob
 }
 
 func (c *CmsController) FeatureBlogPost(ctx context.Context, unionID primitive.ObjectID, blogID primitive.ObjectID, featured bool) (*string, error) {
+	if err := c.requireModule(ctx, unionID, unionModel.ModuleBlogs); err != nil {
+		return nil, err
+	}
 	filter := bson.M{"_id": blogID, "deleted": false}
 	updateDoc := bson.M{
 		"$set": bson.M{"featured": featured},
@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	userModel "younified-backend/contracts/user/model"
+	"younified-backend/providers/graphqlclient"
+	"younified-backend/services/cmsService/internal/auth"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// adminBlocked are the member statuses that cannot act as admins
+var adminBlocked = map[string]bool{
+	userModel.StatusSuspended:  true,
+	userModel.StatusWithdrawn:  true,
+	userModel.StatusDeceased:   true,
+	userModel.UserStatusErased: true,
+}
+
+// requireModule rejects the operation with a MODULE_NOT_ENABLED error unless the
+// union is entitled to module. A missing union id is left to the operation's own
+// validation.
+func (c *CmsController) requireModule(ctx context.Context, unionID primitive.ObjectID, module string) error {
+	if unionID.IsZero() {
+		return nil
+	}
+	return c.entitlements.Require(ctx, unionID, module)
+}
+
+// requireAdminOrService lets backend services and the admins of unionID through
+func (c *CmsController) requireAdminOrService(ctx context.Context, unionID primitive.ObjectID) error {
+	if graphqlclient.IsService(ctx) {
+		return nil
+	}
+	claims := auth.ForContext(ctx)
+	if claims == nil {
+		err := fmt.Errorf("authentication required")
+		return err
+	}
+	if claims.UnionID != unionID {
+		return fmt.Errorf("only the union's admins can do this")
+	}
+	user, err := c.CMSRepository.FindUser(ctx, unionID.Hex(), claims.UserID)
+	if err != nil || user == nil || !user.IsAdmin || user.Deleted || adminBlocked[userModel.NormalizeStatus(user.Status)] {
+		return fmt.Errorf("only the union's admins can do this")
+	}
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"slices"
 	"time"
 	"younified-backend/contracts/cms/model"
+	unionModel "younified-backend/contracts/union/model"
 	"younified-backend/providers/aws"
 	"younified-backend/providers/database"
 	"younified-backend/providers/graphqlclient"
@@ -28,6 +29,7 @@ type CmsController struct {
 	graphqlManager      *graphqlclient.Graph
 	NewsRedisRepository *repository.RedisUserRepository
 	awsProvider         *aws.AWSProvider
+	entitlements        *database.Entitlements
 }
 
 var Response string = "Operation Successful"
@@ -43,10 +45,14 @@ func NewCMSController(dbManager *database.DBManager, graphqlManager *graphqlclie
 		graphqlManager:      graphqlManager,
 		NewsRedisRepository: repository.NewRedisUserRepository(redisClient),
 		awsProvider:         awsProvider,
+		entitlements:        database.NewEntitlements(dbManager, redisClient),
 	}
 }
 
 func (c *CmsController) GetAllNewsPosts(ctx context.Context, unionID primitive.ObjectID, page int, limit int) (*model.Report, error) {
+	if err := c.requireModule(ctx, unionID, unionModel.ModuleNews); err != nil {
+		return nil, err
+	}
 	if unionID.IsZero() {
 		err := fmt.Errorf("unionID is required to process")
 		log.Tracef("Invalid unionID %v", unionID)
@@ -78,13 +84,19 @@ func (c *CmsController) GetAllNewsPosts(ctx context.Context, unionID primitive.O
 }
 
 func (c *CmsController) GetComments(ctx context.Context, unionID primitive.ObjectID, newsID primitive.ObjectID, page int, limit int) ([]*model.Comment, error) {
+	if err := c.requireModule(ctx, unionID, unionModel.ModuleNews); err != nil {
+		return nil, err
+	}
 	filter := bson.M{"deleted": false}
 	return c.CMSRepository.RetrieveComments(ctx, filter, unionID.Hex(), newsID.Hex(), page, limit)
 }
 
 func (c *CmsController) DeleteNews(ctx context.Context, unionID primitive.ObjectID, newsID primitive.ObjectID) (*string, error) {
+	if err := c.requireModule(ctx, unionID, unionModel.ModuleNews); err != nil {
+		return nil, err
+	}
 	filter := bson.M{"_id": newsID}
-	update := bson.M{"deleted": true}
+	update := bson.M{"$set": bson.M{"deleted": true, "deletedAt": time.Now()}}
 	_, err := c.CMSRepository.UpdateNews(ctx, unionID.Hex(), filter, update)
 	if err != nil {
 		return nil, err
@@ -93,6 +105,9 @@ func (c *CmsController) DeleteNews(ctx context.Context, unionID primitive.Object
 }
 
 func (c *CmsController) LikeNewsItem(ctx context.Context, unionID primitive.ObjectID, newsID primitive.ObjectID, userID primitive.ObjectID) (*model.News, error) {
+	if err := c.requireModule(ctx, unionID, unionModel.ModuleNews); err != nil {
+		return nil, err
+	}
 	filter := bson.M{"_id": newsID}
 	news, err := c.CMSRepository.GetOneNews(ctx, unionID.Hex(), newsID)
 	if err != nil {
@@ -118,6 +133,9 @@ func (c *CmsController) LikeNewsItem(ctx context.Context, unionID primitive.Obje
 }
 
 func (c *CmsController) AddComment(ctx context.Context, unionID primitive.ObjectID, newsID primitive.ObjectID, input model.Comment) (*model.Comment, error) {
+	if err := c.requireModule(ctx, unionID, unionModel.ModuleNews); err != nil {
+		return nil, err
+	}
 	if unionID.IsZero() {
 		err := fmt.Errorf("unionID is required to process")
 		return nil, err
@@ -128,6 +146,9 @@ func (c *CmsController) AddComment(ctx context.Context, unionID primitive.Object
 }
 
 func (c *CmsController) LikeButtonToggle(ctx context.Context, unionID primitive.ObjectID, newsID primitive.ObjectID, input bool) (*model.News, error) {
+	if err := c.requireModule(ctx, unionID, unionModel.ModuleNews); err != nil {
+		return nil, err
+	}
 	if unionID.IsZero() || newsID.IsZero() {
 		err := fmt.Errorf("unionID and newsId are required")
 		return nil, err
@@ -138,6 +159,9 @@ func (c *CmsController) LikeButtonToggle(ctx context.Context, unionID primitive.
 }
 
 func (c *CmsController) CommentButtonToggle(ctx context.Context, unionID primitive.ObjectID, newsID primitive.ObjectID, input bool) (*model.News, error) {
+	if err := c.requireModule(ctx, unionID, unionModel.ModuleNews); err != nil {
+		return nil, err
+	}
 	if unionID.IsZero() || newsID.IsZero() {
 		err := fmt.Errorf("unionID and newsId are required")
 		return nil, err
@@ -148,6 +172,9 @@ func (c *CmsController) CommentButtonToggle(ctx context.Context, unionID primiti
 }
 
 func (c *CmsController) MakeNewsPrivate(ctx context.Context, unionID primitive.ObjectID, newsID primitive.ObjectID, input bool) (*model.News, error) {
+	if err := c.requireModule(ctx, unionID, unionModel.ModuleNews); err != nil {
+		return nil, err
+	}
 	if unionID.IsZero() || newsID.IsZero() {
 		err := fmt.Errorf("unionID and newsId are required")
 		return nil, err
@@ -158,6 +185,9 @@ func (c *CmsController) MakeNewsPrivate(ctx context.Context, unionID primitive.O
 }
 
 func (c *CmsController) ShowPinOption(ctx context.Context, unionID primitive.ObjectID, newsID primitive.ObjectID, input bool) (*model.News, error) {
+	if err := c.requireModule(ctx, unionID, unionModel.ModuleNews); err != nil {
+		return nil, err
+	}
 	if unionID.IsZero() || newsID.IsZero() {
 		err := fmt.Errorf("unionID and newsId are required")
 		return nil, err
@@ -168,6 +198,9 @@ func (c *CmsController) ShowPinOption(ctx context.Context, unionID primitive.Obj
 }
 
 func (c *CmsController) PinNewsPost(ctx context.Context, unionID primitive.ObjectID, newsID primitive.ObjectID) (*model.News, error) {
+	if err := c.requireModule(ctx, unionID, unionModel.ModuleNews); err != nil {
+		return nil, err
+	}
 	alreadyPinned := false
 
 	items, _, err := c.CMSRepository.GetAllNewsPosts(ctx, unionID.Hex(), bson.M{"pinned": true, "deleted": false}, 1, 20, bson.M{"createdOn": -1})
@@ -211,6 +244,9 @@ func (c *CmsController) PinNewsPost(ctx context.Context, unionID primitive.Objec
 }
 
 func (c *CmsController) LikeComment(ctx context.Context, unionID primitive.ObjectID, newsID primitive.ObjectID, commentID primitive.ObjectID, userID primitive.ObjectID) (*model.Comment, error) {
+	if err := c.requireModule(ctx, unionID, unionModel.ModuleNews); err != nil {
+		return nil, err
+	}
 	filter := bson.M{"_id": commentID}
 	comment, err := c.CMSRepository.GetOneComment(ctx, unionID.Hex(), newsID, commentID)
 	if err != nil {
@@ -236,8 +272,11 @@ func (c *CmsController) LikeComment(ctx context.Context, unionID primitive.Objec
 }
 
 func (c *CmsController) DeleteComment(ctx context.Context, unionID primitive.ObjectID, newsID primitive.ObjectID, commentID primitive.ObjectID) (*string, error) {
+	if err := c.requireModule(ctx, unionID, unionModel.ModuleNews); err != nil {
+		return nil, err
+	}
 	filter := bson.M{"_id": commentID}
-	update := bson.M{"$set": bson.M{"deleted": true}}
+	update := bson.M{"$set": bson.M{"deleted": true, "deletedAt": time.Now()}}
 	_, err := c.CMSRepository.UpdateOneComment(ctx, unionID.Hex(), newsID, filter, update)
 	if err != nil {
 		err = fmt.Errorf("could not delete the comment")
@@ -248,6 +287,14 @@ func (c *CmsController) DeleteComment(ctx context.Context, unionID primitive.Obj
 }
 
 func (c *CmsController) CreateNews(ctx context.Context, unionID primitive.ObjectID, input model.News, images []*string, documents []*model.Document, category string) (*model.News, error) {
+	if err := c.requireModule(ctx, unionID, unionModel.ModuleNews); err != nil {
+		return nil, err
+	}
+	units, err := c.newsUnits(ctx, unionID, input.Unit)
+	if err != nil {
+		return nil, err
+	}
+	input.Unit = units
 	if input.CreatedOn.IsZero() {
 		input.CreatedOn = time.Now()
 	}
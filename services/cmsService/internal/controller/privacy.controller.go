@@ -0,0 +1,134 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"younified-backend/contracts/cms/model"
+	"younified-backend/providers/graphqlclient"
+	"younified-backend/services/cmsService/internal/repository"
+
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const redactedComment = "[removed at the member's request]"
+
+// MemberContent gathers the news a member authored, their comments and everything they liked
+func (c *CmsController) MemberContent(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID) (*model.MemberContent, error) {
+	if unionID.IsZero() || userID.IsZero() {
+		err := fmt.Errorf("unionID and userID are required")
+		return nil, err
+	}
+	// only userService asks, while it handles a privacy request
+	if !graphqlclient.IsService(ctx) {
+		err := fmt.Errorf("only backend services can do this")
+		return nil, err
+	}
+	union := unionID.Hex()
+	content := &model.MemberContent{
+		News:          []*model.News{},
+		Comments:      []*model.Comment{},
+		LikedNews:     []primitive.ObjectID{},
+		LikedComments: []primitive.ObjectID{},
+	}
+
+	authored, err := c.CMSRepository.FindNews(ctx, union, bson.M{"userID": userID})
+	if err != nil {
+		return nil, fmt.Errorf("could not read member news: %v", err)
+	}
+	content.News = append(content.News, authored...)
+
+	liked, err := c.CMSRepository.FindNews(ctx, union, bson.M{"likes": userID})
+	if err != nil {
+		return nil, fmt.Errorf("could not read liked news: %v", err)
+	}
+	for _, news := range liked {
+		content.LikedNews = append(content.LikedNews, news.ID)
+	}
+
+	collections, err := c.CMSRepository.CommentCollections(ctx, union)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range collections {
+		newsID := repository.NewsIDFromCommentCollection(name)
+		comments, err := c.CMSRepository.FindCommentsIn(ctx, union, name, bson.M{"$or": []bson.M{{"userID": userID}, {"likes": userID}}})
+		if err != nil {
+			return nil, fmt.Errorf("could not read member comments: %v", err)
+		}
+		for _, comment := range comments {
+			if comment.NewsID.IsZero() {
+				comment.NewsID = newsID
+			}
+			if comment.UserID == userID {
+				content.Comments = append(content.Comments, comment)
+			}
+			for _, like := range comment.Likes {
+				if like == userID {
+					content.LikedComments = append(content.LikedComments, comment.ID)
+					break
+				}
+			}
+		}
+	}
+	return content, nil
+}
+
+// AnonymiseMemberContent swaps the member's id for a pseudonymous one everywhere and
+// redacts their comment text. Documents are kept so like and comment counts stay correct.
+func (c *CmsController) AnonymiseMemberContent(ctx context.Context, input model.AnonymiseMemberInput) (*model.AnonymiseReport, error) {
+	if input.UnionID.IsZero() || input.UserID.IsZero() || input.ReplacementID.IsZero() {
+		err := fmt.Errorf("unionID, userID and replacementID are required")
+		return nil, err
+	}
+	if !graphqlclient.IsService(ctx) {
+		err := fmt.Errorf("only backend services can do this")
+		return nil, err
+	}
+	if input.UserID == input.ReplacementID {
+		return nil, fmt.Errorf("replacementID must differ from userID")
+	}
+	union := input.UnionID.Hex()
+	report := &model.AnonymiseReport{}
+	replaceLike := options.Update().SetArrayFilters(options.ArrayFilters{
+		Filters: []interface{}{bson.M{"elem": input.UserID}},
+	})
+
+	news, err := c.CMSRepository.UpdateManyNews(ctx, union, bson.M{"userID": input.UserID}, bson.M{"$set": bson.M{"userID": input.ReplacementID}})
+	if err != nil {
+		return nil, fmt.Errorf("could not anonymise news: %v", err)
+	}
+	report.News = int(news)
+
+	likes, err := c.CMSRepository.UpdateManyNews(ctx, union, bson.M{"likes": input.UserID}, bson.M{"$set": bson.M{"likes.$[elem]": input.ReplacementID}}, replaceLike)
+	if err != nil {
+		return nil, fmt.Errorf("could not anonymise news likes: %v", err)
+	}
+	report.Likes = int(likes)
+
+	collections, err := c.CMSRepository.CommentCollections(ctx, union)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range collections {
+		comments, err := c.CMSRepository.UpdateManyCommentsIn(ctx, union, name, bson.M{"userID": input.UserID}, bson.M{"$set": bson.M{
+			"userID":  input.ReplacementID,
+			"content": redactedComment,
+		}})
+		if err != nil {
+			return nil, fmt.Errorf("could not anonymise comments: %v", err)
+		}
+		report.Comments += int(comments)
+
+		commentLikes, err := c.CMSRepository.UpdateManyCommentsIn(ctx, union, name, bson.M{"likes": input.UserID}, bson.M{"$set": bson.M{"likes.$[elem]": input.ReplacementID}}, replaceLike)
+		if err != nil {
+			return nil, fmt.Errorf("could not anonymise comment likes: %v", err)
+		}
+		report.Likes += int(commentLikes)
+	}
+
+	log.Infof("anonymised member content for union %s: %d news, %d comments, %d likes", union, report.News, report.Comments, report.Likes)
+	return report, nil
+}
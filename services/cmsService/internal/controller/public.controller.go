@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"younified-backend/contracts/cms/model"
+	unionModel "younified-backend/contracts/union/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	defaultPublicNews  = 10
+	defaultPublicBlogs = 3
+	maxPublicItems     = 50
+)
+
+// publicNewsFilter matches the posts anyone may see: not deleted, not private and not
+// targeted at a bargaining unit
+var publicNewsFilter = bson.M{
+	"deleted": false,
+	"private": bson.M{"$ne": true},
+	"unit.0":  bson.M{"$exists": false},
+}
+
+// PublicContent returns the posts of a union's public page. Modules the union lacks
+// give empty lists rather than an error, so the page still renders.
+func (c *CmsController) PublicContent(ctx context.Context, unionID primitive.ObjectID, newsLimit *int, blogLimit *int) (*model.PublicContent, error) {
+	if unionID.IsZero() {
+		err := fmt.Errorf("unionID is required to process")
+		return nil, err
+	}
+	content := &model.PublicContent{
+		Pinned: []*model.PublicNews{},
+		News:   []*model.PublicNews{},
+		Blogs:  []*model.PublicBlog{},
+	}
+
+	newsEnabled, err := c.entitlements.Enabled(ctx, unionID, unionModel.ModuleNews)
+	if err != nil {
+		return nil, err
+	}
+	if newsEnabled {
+		limit := publicLimit(newsLimit, defaultPublicNews)
+		pinned, err := c.publicNews(ctx, unionID, true, limit)
+		if err != nil {
+			return nil, err
+		}
+		latest, err := c.publicNews(ctx, unionID, false, limit)
+		if err != nil {
+			return nil, err
+		}
+		content.Pinned, content.News = pinned, latest
+	}
+
+	blogsEnabled, err := c.entitlements.Enabled(ctx, unionID, unionModel.ModuleBlogs)
+	if err != nil {
+		return nil, err
+	}
+	if blogsEnabled {
+		blogs, err := c.CMSRepository.GetBlogs(ctx, bson.M{"deleted": false, "featured": true})
+		if err != nil {
+			return nil, fmt.Errorf("could not load featured blog posts")
+		}
+		sort.Slice(blogs, func(i, j int) bool { return blogs[i].CreatedOn.After(blogs[j].CreatedOn) })
+		for _, blog := range blogs {
+			if len(content.Blogs) == publicLimit(blogLimit, defaultPublicBlogs) {
+				break
+			}
+			content.Blogs = append(content.Blogs, model.PublicBlogOf(blog))
+		}
+	}
+	return content, nil
+}
+
+// publicNews returns the latest public posts, pinned or not
+func (c *CmsController) publicNews(ctx context.Context, unionID primitive.ObjectID, pinned bool, limit int) ([]*model.PublicNews, error) {
+	filter := bson.M{}
+	for key, value := range publicNewsFilter {
+		filter[key] = value
+	}
+	if pinned {
+		filter["pinned"] = true
+	} else {
+		filter["pinned"] = bson.M{"$ne": true}
+	}
+	news, _, err := c.CMSRepository.GetAllNewsPosts(ctx, unionID.Hex(), filter, 1, limit, bson.M{"createdOn": -1})
+	if err != nil {
+		return nil, fmt.Errorf("could not find newsfeed")
+	}
+	public := make([]*model.PublicNews, 0, len(news))
+	for _, post := range news {
+		public = append(public, model.PublicNewsOf(post))
+	}
+	return public, nil
+}
+
+func publicLimit(limit *int, fallback int) int {
+	if limit == nil || *limit <= 0 {
+		return fallback
+	}
+	if *limit > maxPublicItems {
+		return maxPublicItems
+	}
+	return *limit
+}
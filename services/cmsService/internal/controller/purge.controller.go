@@ -0,0 +1,215 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"younified-backend/contracts/cms/model"
+	unionModel "younified-backend/contracts/union/model"
+	"younified-backend/services/cmsService/internal/repository"
+
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PurgeDeletedContent hard-deletes news and comments that have been soft-deleted for
+// longer than the union's retention policy allows, together with the media of the news.
+// A dry run only reports what would go. Only the union's admins and backend services
+// can run it.
+func (c *CmsController) PurgeDeletedContent(ctx context.Context, unionID primitive.ObjectID, dryRun bool) (*model.PurgeReport, error) {
+	if unionID.IsZero() {
+		err := fmt.Errorf("unionID is required")
+		return nil, err
+	}
+	if err := c.requireAdminOrService(ctx, unionID); err != nil {
+		return nil, err
+	}
+	return c.purgeDeletedContent(ctx, unionID, dryRun)
+}
+
+func (c *CmsController) purgeDeletedContent(ctx context.Context, unionID primitive.ObjectID, dryRun bool) (*model.PurgeReport, error) {
+	union, err := c.CMSRepository.GetUnion(ctx, unionID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	report := &model.PurgeReport{UnionID: unionID, DryRun: dryRun, RanAt: now, Items: []*model.PurgeItem{}}
+	if union.RetentionPolicy == nil {
+		return report, nil
+	}
+	if cutoff, ok := unionModel.Cutoff(union.RetentionPolicy.DeletedNewsDays, now); ok {
+		item, err := c.purgeNews(ctx, unionID.Hex(), cutoff, now, dryRun)
+		if err != nil {
+			return nil, err
+		}
+		item.RetentionDays = union.RetentionPolicy.DeletedNewsDays
+		report.Items = append(report.Items, item)
+	}
+	if cutoff, ok := unionModel.Cutoff(union.RetentionPolicy.DeletedCommentsDays, now); ok {
+		item, err := c.purgeComments(ctx, unionID.Hex(), cutoff, now, dryRun)
+		if err != nil {
+			return nil, err
+		}
+		item.RetentionDays = union.RetentionPolicy.DeletedCommentsDays
+		report.Items = append(report.Items, item)
+	}
+	return report, nil
+}
+
+func (c *CmsController) purgeNews(ctx context.Context, union string, cutoff time.Time, now time.Time, dryRun bool) (*model.PurgeItem, error) {
+	if !dryRun {
+		if _, err := c.CMSRepository.StampDeletedNews(ctx, union, now); err != nil {
+			return nil, fmt.Errorf("could not stamp deleted news: %v", err)
+		}
+	}
+	news, err := c.CMSRepository.FindNews(ctx, union, repository.DeletedBefore(cutoff))
+	if err != nil {
+		return nil, fmt.Errorf("could not find deleted news: %v", err)
+	}
+	item := &model.PurgeItem{Collection: "news", Cutoff: cutoff, Matched: len(news)}
+	if dryRun || len(news) == 0 {
+		return item, nil
+	}
+
+	ids := make([]primitive.ObjectID, 0, len(news))
+	for _, n := range news {
+		ids = append(ids, n.ID)
+	}
+	purged, err := c.CMSRepository.PurgeNews(ctx, union, ids)
+	item.Purged = int(purged)
+	if err != nil {
+		return nil, fmt.Errorf("could not purge deleted news: %v", err)
+	}
+
+	for _, n := range news {
+		urls := append([]string{}, n.Images...)
+		for _, doc := range n.Documents {
+			if doc != nil {
+				urls = append(urls, doc.Url)
+			}
+		}
+		item.MediaDeleted += c.deleteMedia(ctx, union, urls)
+		log.Infof("purged news %s of union %s, deleted at %s", n.ID.Hex(), union, n.DeletedAt.Format(time.RFC3339))
+	}
+	return item, nil
+}
+
+// purgeBlogs hard-deletes blog posts soft-deleted before cutoff. Blog posts are shared
+// by all unions, so they follow RETENTION_DELETED_BLOGS_DAYS rather than a union's policy.
+func (c *CmsController) purgeBlogs(ctx context.Context, cutoff time.Time, now time.Time, dryRun bool) (*model.PurgeItem, error) {
+	if !dryRun {
+		if _, err := c.CMSRepository.StampDeletedBlogs(ctx, now); err != nil {
+			return nil, fmt.Errorf("could not stamp deleted blog posts: %v", err)
+		}
+	}
+	blogs, err := c.CMSRepository.GetBlogs(ctx, repository.DeletedBefore(cutoff))
+	if err != nil {
+		return nil, fmt.Errorf("could not find deleted blog posts: %v", err)
+	}
+	item := &model.PurgeItem{Collection: "blogs", Cutoff: cutoff, Matched: len(blogs)}
+	if dryRun || len(blogs) == 0 {
+		return item, nil
+	}
+
+	ids := make([]primitive.ObjectID, 0, len(blogs))
+	for _, blog := range blogs {
+		ids = append(ids, blog.ID)
+	}
+	purged, err := c.CMSRepository.PurgeBlogs(ctx, ids)
+	item.Purged = int(purged)
+	if err != nil {
+		return nil, fmt.Errorf("could not purge deleted blog posts: %v", err)
+	}
+	return item, nil
+}
+
+func (c *CmsController) purgeComments(ctx context.Context, union string, cutoff time.Time, now time.Time, dryRun bool) (*model.PurgeItem, error) {
+	collections, err := c.CMSRepository.CommentCollections(ctx, union)
+	if err != nil {
+		return nil, err
+	}
+	item := &model.PurgeItem{Collection: "comments", Cutoff: cutoff}
+	filter := repository.DeletedBefore(cutoff)
+	for _, name := range collections {
+		if dryRun {
+			matched, err := c.CMSRepository.CountCommentsIn(ctx, union, name, filter)
+			if err != nil {
+				return nil, fmt.Errorf("could not count deleted comments: %v", err)
+			}
+			item.Matched += int(matched)
+			continue
+		}
+		if _, err := c.CMSRepository.StampDeletedCommentsIn(ctx, union, name, now); err != nil {
+			return nil, fmt.Errorf("could not stamp deleted comments: %v", err)
+		}
+		purged, err := c.CMSRepository.PurgeCommentsIn(ctx, union, name, filter)
+		if err != nil {
+			return nil, fmt.Errorf("could not purge deleted comments: %v", err)
+		}
+		if purged > 0 {
+			log.Infof("purged %d comments from %s of union %s", purged, name, union)
+		}
+		item.Matched += int(purged)
+		item.Purged += int(purged)
+	}
+	return item, nil
+}
+
+// deleteMedia removes the bucket objects behind media urls of a union and returns how
+// many were deleted. Urls outside the union's prefix in our bucket are left alone.
+func (c *CmsController) deleteMedia(ctx context.Context, union string, urls []string) int {
+	if c.awsProvider == nil {
+		return 0
+	}
+	bucket := os.Getenv("AWS_S3_BUCKET")
+	prefix := fmt.Sprintf("https://%s.s3.%s.amazonaws.com/", bucket, os.Getenv("AWS_REGION"))
+	deleted := 0
+	for _, url := range urls {
+		key := strings.TrimPrefix(url, prefix)
+		if key == url || !strings.HasPrefix(key, union+"/") {
+			continue
+		}
+		if err := c.awsProvider.DeleteS3Object(ctx, bucket, key); err != nil {
+			log.Warnf("could not delete media %s: %v", key, err)
+			continue
+		}
+		deleted++
+	}
+	return deleted
+}
+
+// RunRetentionPurge applies every union's retention policy, then the blog posts'
+// retention period; it is what the scheduled job calls
+func (c *CmsController) RunRetentionPurge(ctx context.Context, dryRun bool) {
+	unions, err := c.dbManager.ListUnions(ctx)
+	if err != nil {
+		log.Errorf("retention purge: %v", err)
+		return
+	}
+	for _, union := range unions {
+		report, err := c.purgeDeletedContent(ctx, union.ID, dryRun)
+		if err != nil {
+			log.Errorf("retention purge of union %s failed: %v", union.UnionID, err)
+			continue
+		}
+		for _, item := range report.Items {
+			log.Infof("retention purge of union %s: %s matched %d, purged %d, media deleted %d (dry run %t)",
+				union.UnionID, item.Collection, item.Matched, item.Purged, item.MediaDeleted, dryRun)
+		}
+	}
+
+	days, _ := strconv.Atoi(os.Getenv("RETENTION_DELETED_BLOGS_DAYS"))
+	now := time.Now()
+	if cutoff, ok := unionModel.Cutoff(days, now); ok {
+		item, err := c.purgeBlogs(ctx, cutoff, now, dryRun)
+		if err != nil {
+			log.Errorf("retention purge of blog posts failed: %v", err)
+			return
+		}
+		log.Infof("retention purge of blog posts: matched %d, purged %d (dry run %t)", item.Matched, item.Purged, dryRun)
+	}
+}
@@ -0,0 +1,37 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// newsUnits resolves the units a news item is targeted at to unit ids. A target can
+// be a unit id or, for older clients, a unit name.
+func (c *CmsController) newsUnits(ctx context.Context, unionID primitive.ObjectID, targets []string) ([]string, error) {
+	if len(targets) == 0 {
+		return targets, nil
+	}
+	units, err := c.CMSRepository.BargainingUnits(ctx, unionID)
+	if err != nil {
+		return nil, err
+	}
+	ids := map[string]string{}
+	for _, unit := range units {
+		ids[unit.ID.Hex()] = unit.ID.Hex()
+		ids[strings.ToLower(unit.Name)] = unit.ID.Hex()
+	}
+
+	resolved := make([]string, 0, len(targets))
+	for _, target := range targets {
+		id, ok := ids[strings.ToLower(strings.TrimSpace(target))]
+		if !ok {
+			err := fmt.Errorf("unknown bargaining unit %s", target)
+			return nil, err
+		}
+		resolved = append(resolved, id)
+	}
+	return resolved, nil
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"younified-backend/contracts/cms/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const newsCollection = "news"
+const commentCollectionPrefix = "newscomments_"
+
+// CommentCollections lists the per-news comment collections of a union
+func (r *MongoCommsRepository) CommentCollections(ctx context.Context, unionID string) ([]string, error) {
+	db, err := r.dbManager.GetDatabase(ctx, unionID)
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{"name": primitive.Regex{Pattern: "^" + commentCollectionPrefix}}
+	names, err := db.ListCollectionNames(ctx, filter)
+	if err != nil {
+		err = fmt.Errorf("could not list comment collections: %v", err)
+		return nil, err
+	}
+	return names, nil
+}
+
+// NewsIDFromCommentCollection recovers the news id encoded in a comment collection name
+func NewsIDFromCommentCollection(name string) primitive.ObjectID {
+	id, err := primitive.ObjectIDFromHex(strings.TrimPrefix(name, commentCollectionPrefix))
+	if err != nil {
+		return primitive.NilObjectID
+	}
+	return id
+}
+
+// FindNews returns raw news documents without the user lookups of the feed
+func (r *MongoCommsRepository) FindNews(ctx context.Context, unionID string, filter interface{}) ([]*model.News, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, newsCollection)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var news []*model.News
+	if err = cursor.All(ctx, &news); err != nil {
+		return nil, err
+	}
+	return news, nil
+}
+
+// FindCommentsIn returns raw comments from a single comment collection
+func (r *MongoCommsRepository) FindCommentsIn(ctx context.Context, unionID string, collectionName string, filter interface{}) ([]*model.Comment, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var comments []*model.Comment
+	if err = cursor.All(ctx, &comments); err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+// UpdateManyNews applies update to every matching news document
+func (r *MongoCommsRepository) UpdateManyNews(ctx context.Context, unionID string, filter interface{}, update interface{}, opts ...*options.UpdateOptions) (int64, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, newsCollection)
+	if err != nil {
+		return 0, err
+	}
+	result, err := collection.UpdateMany(ctx, filter, update, opts...)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+// UpdateManyCommentsIn applies update to every matching comment of one collection
+func (r *MongoCommsRepository) UpdateManyCommentsIn(ctx context.Context, unionID string, collectionName string, filter interface{}, update interface{}, opts ...*options.UpdateOptions) (int64, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, collectionName)
+	if err != nil {
+		return 0, err
+	}
+	result, err := collection.UpdateMany(ctx, filter, update, opts...)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	unionModel "younified-backend/contracts/union/model"
+	userModel "younified-backend/contracts/user/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// unstamped matches soft-deleted documents that were deleted before deletedAt was recorded
+var unstamped = bson.M{"deleted": true, "deletedAt": bson.M{"$in": bson.A{nil, time.Time{}}}}
+
+// GetUnion reads the union document, which carries the retention policy
+func (r *MongoCommsRepository) GetUnion(ctx context.Context, unionID primitive.ObjectID) (*unionModel.Union, error) {
+	collection := r.dbManager.GetBaseDatabase(ctx).Collection("unions")
+	var union unionModel.Union
+	if err := collection.FindOne(ctx, bson.M{"_id": unionID}).Decode(&union); err != nil {
+		return nil, fmt.Errorf("could not find union: %v", err)
+	}
+	return &union, nil
+}
+
+// FindUser returns a member of a union, or nil when there is none
+func (r *MongoCommsRepository) FindUser(ctx context.Context, unionID string, id primitive.ObjectID) (*userModel.User, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, "users")
+	if err != nil {
+		return nil, err
+	}
+	var user userModel.User
+	err = collection.FindOne(ctx, bson.M{"_id": id}).Decode(&user)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// StampDeletedBlogs starts the retention period of legacy soft-deleted blog posts now
+func (r *MongoCommsRepository) StampDeletedBlogs(ctx context.Context, now time.Time) (int64, error) {
+	collection, err := r.dbManager.GetCollection(ctx, BlogServiceDBKey, BlogServiceCollection)
+	if err != nil {
+		return 0, err
+	}
+	result, err := collection.UpdateMany(ctx, unstamped, bson.M{"$set": bson.M{"deletedAt": now}})
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+// PurgeBlogs permanently removes soft-deleted blog posts
+func (r *MongoCommsRepository) PurgeBlogs(ctx context.Context, ids []primitive.ObjectID) (int64, error) {
+	collection, err := r.dbManager.GetCollection(ctx, BlogServiceDBKey, BlogServiceCollection)
+	if err != nil {
+		return 0, err
+	}
+	result, err := collection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}, "deleted": true})
+	if err != nil {
+		return 0, err
+	}
+	return result.DeletedCount, nil
+}
+
+// StampDeletedNews starts the retention period of legacy soft-deleted news now
+func (r *MongoCommsRepository) StampDeletedNews(ctx context.Context, unionID string, now time.Time) (int64, error) {
+	return r.UpdateManyNews(ctx, unionID, unstamped, bson.M{"$set": bson.M{"deletedAt": now}})
+}
+
+// StampDeletedCommentsIn starts the retention period of legacy soft-deleted comments now
+func (r *MongoCommsRepository) StampDeletedCommentsIn(ctx context.Context, unionID string, collectionName string, now time.Time) (int64, error) {
+	return r.UpdateManyCommentsIn(ctx, unionID, collectionName, unstamped, bson.M{"$set": bson.M{"deletedAt": now}})
+}
+
+// DeletedBefore matches documents soft-deleted before cutoff
+func DeletedBefore(cutoff time.Time) bson.M {
+	return bson.M{"deleted": true, "deletedAt": bson.M{"$lt": cutoff}}
+}
+
+// PurgeNews permanently removes soft-deleted news and drops their comment collections
+func (r *MongoCommsRepository) PurgeNews(ctx context.Context, unionID string, ids []primitive.ObjectID) (int64, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, newsCollection)
+	if err != nil {
+		return 0, err
+	}
+	result, err := collection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}, "deleted": true})
+	if err != nil {
+		return 0, err
+	}
+	for _, id := range ids {
+		comments, err := r.dbManager.GetCollection(ctx, unionID, commentCollectionPrefix+id.Hex())
+		if err != nil {
+			return result.DeletedCount, err
+		}
+		if err := comments.Drop(ctx); err != nil {
+			return result.DeletedCount, fmt.Errorf("could not drop comments of news %s: %v", id.Hex(), err)
+		}
+	}
+	return result.DeletedCount, nil
+}
+
+// PurgeCommentsIn permanently removes the matching comments of one comment collection
+func (r *MongoCommsRepository) PurgeCommentsIn(ctx context.Context, unionID string, collectionName string, filter interface{}) (int64, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, collectionName)
+	if err != nil {
+		return 0, err
+	}
+	result, err := collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	return result.DeletedCount, nil
+}
+
+// CountCommentsIn counts the matching comments of one comment collection
+func (r *MongoCommsRepository) CountCommentsIn(ctx context.Context, unionID string, collectionName string, filter interface{}) (int64, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, collectionName)
+	if err != nil {
+		return 0, err
+	}
+	return collection.CountDocuments(ctx, filter)
+}
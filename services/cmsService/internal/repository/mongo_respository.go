@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"time"
 
 	"younified-backend/contracts/cms/model"
 	"younified-backend/providers/database"
@@ -70,28 +71,8 @@ func (r *MongoCommsRepository) GetAllNewsPosts(ctx context.Context, unionID stri
 	}
 	skip := (page - 1) * limit
 	// Find the unions
-	pipeline := []bson.M{{
-		"$lookup": bson.M{
-			"from":         "users",
-			"localField":   "userID",
-			"foreignField": "_id",
-			"as":           "creator",
-		}},
-		{
-			"$unwind": bson.M{
-				"path":                       "$creator",
-				"preserveNullAndEmptyArrays": true,
-			},
-		},
-
-		{
-			"$lookup": bson.M{
-				"from":         "users",
-				"localField":   "likes",
-				"foreignField": "_id",
-				"as":           "likedBy",
-			},
-		},
+	// creators and likers are User references resolved by userService
+	pipeline := []bson.M{
 		{"$match": filter},
 		{"$sort": sort},
 		{"$skip": skip},
@@ -189,19 +170,7 @@ func (r *MongoCommsRepository) RetrieveComments(ctx context.Context, filter inte
 	commentCollection, _ := r.dbManager.GetCollection(ctx, unionID, "newscomments_"+newsID)
 	skip := (page - 1) * limit
 	sort := bson.M{"createdOn": -1}
-	pipeline := []bson.M{{
-		"$lookup": bson.M{
-			"from":         "users",
-			"localField":   "userID",
-			"foreignField": "_id",
-			"as":           "creator",
-		}},
-		{
-			"$unwind": bson.M{
-				"path":                       "$creator",
-				"preserveNullAndEmptyArrays": true,
-			},
-		},
+	pipeline := []bson.M{
 		{"$sort": bson.M{
 			"createdOn": -1,
 		}},
@@ -311,10 +280,11 @@ func (r *MongoCommsRepository) UpdateBlog(ctx context.Context, filter interface{
 	return &updatedBlog, nil
 }
 
+// DeleteBlog soft-deletes a blog post; deletedAt starts its retention period
 func (r *MongoCommsRepository) DeleteBlog(ctx context.Context, filter interface{}) (*model.Blog, error) {
 	blogsCollection, _ := r.dbManager.GetCollection(ctx, BlogServiceDBKey, BlogServiceCollection)
 	updateDoc := bson.M{
-		"$set": bson.M{"deleted": true},
+		"$set": bson.M{"deleted": true, "deletedAt": time.Now()},
 	}
 	result := blogsCollection.FindOneAndUpdate(
 		ctx,
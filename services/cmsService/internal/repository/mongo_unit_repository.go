@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	unionModel "younified-backend/contracts/union/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// bargainingUnitCollection is where unionService keeps the units of every union
+const bargainingUnitCollection = "bargaining_units"
+
+// BargainingUnits returns the units of a union, which news is targeted at
+func (r *MongoCommsRepository) BargainingUnits(ctx context.Context, unionID primitive.ObjectID) ([]*unionModel.BargainingUnit, error) {
+	collection := r.dbManager.GetBaseDatabase(ctx).Collection(bargainingUnitCollection)
+	cursor, err := collection.Find(ctx, bson.M{"unionID": unionID})
+	if err != nil {
+		return nil, fmt.Errorf("could not load bargaining units: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	var units []*unionModel.BargainingUnit
+	if err := cursor.All(ctx, &units); err != nil {
+		return nil, fmt.Errorf("could not load bargaining units: %v", err)
+	}
+	return units, nil
+}
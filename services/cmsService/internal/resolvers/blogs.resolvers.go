@@ -32,11 +32,11 @@ func (r *mutationResolver) UpdateBlogPost(ctx context.Context, unionID primitive
 }
 
 // GetBlogPosts is the resolver for the getBlogPosts field.
-func (r *queryResolver) GetBlogPosts(ctx context.Context) ([]*model.Blog, error) {
-	return r.CMSController.GetBlogPosts(ctx)
+func (r *queryResolver) GetBlogPosts(ctx context.Context, unionID primitive.ObjectID) ([]*model.Blog, error) {
+	return r.CMSController.GetBlogPosts(ctx, unionID)
 }
 
 // GetOneBlogPost is the resolver for the getOneBlogPost field.
-func (r *queryResolver) GetOneBlogPost(ctx context.Context, blogID primitive.ObjectID) (*model.Blog, error) {
-	return r.CMSController.GetOneBlogPost(ctx, blogID)
+func (r *queryResolver) GetOneBlogPost(ctx context.Context, unionID primitive.ObjectID, blogID primitive.ObjectID) (*model.Blog, error) {
+	return r.CMSController.GetOneBlogPost(ctx, unionID, blogID)
 }
@@ -12,8 +12,9 @@ import (
 	"sync/atomic"
 	"time"
 	"younified-backend/contracts/cms/model"
-	model2 "younified-backend/contracts/common/model"
-	model1 "younified-backend/contracts/user/model"
+	model3 "younified-backend/contracts/common/model"
+	model1 "younified-backend/contracts/union/model"
+	model2 "younified-backend/contracts/user/model"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/introspection"
@@ -51,6 +52,16 @@ type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	AnonymiseReport struct {
+		Comments func(childComplexity int) int
+		Likes    func(childComplexity int) int
+		News     func(childComplexity int) int
+	}
+
+	BargainingUnit struct {
+		ID func(childComplexity int) int
+	}
+
 	Blog struct {
 		Content   func(childComplexity int) int
 		CreatedBy func(childComplexity int) int
@@ -69,25 +80,35 @@ type ComplexityRoot struct {
 		Dislikes  func(childComplexity int) int
 		ID        func(childComplexity int) int
 		Likes     func(childComplexity int) int
+		NewsID    func(childComplexity int) int
 		UserID    func(childComplexity int) int
 	}
 
+	MemberContent struct {
+		Comments      func(childComplexity int) int
+		LikedComments func(childComplexity int) int
+		LikedNews     func(childComplexity int) int
+		News          func(childComplexity int) int
+	}
+
 	Mutation struct {
-		AddComment          func(childComplexity int, unionID primitive.ObjectID, newsID primitive.ObjectID, comment model.Comment) int
-		CommentButtonToggle func(childComplexity int, unionID primitive.ObjectID, newsID primitive.ObjectID, commentDisplay bool) int
-		CreateBlogPost      func(childComplexity int, unionID primitive.ObjectID, input model.Blog, images []*string) int
-		CreateNews          func(childComplexity int, unionID primitive.ObjectID, input model.News, images []*string, documents []*model.Document, category string) int
-		DeleteBlogPost      func(childComplexity int, unionID primitive.ObjectID, blogID primitive.ObjectID) int
-		DeleteComment       func(childComplexity int, unionID primitive.ObjectID, newsID primitive.ObjectID, commentID primitive.ObjectID) int
-		DeleteNews          func(childComplexity int, unionID primitive.ObjectID, newsID primitive.ObjectID) int
-		LikeButtonToggle    func(childComplexity int, unionID primitive.ObjectID, newsID primitive.ObjectID, likeDisplay bool) int
-		LikeComment         func(childComplexity int, unionID primitive.ObjectID, newsID primitive.ObjectID, commentID primitive.ObjectID, userID primitive.ObjectID) int
-		LikeNewsItem        func(childComplexity int, unionID primitive.ObjectID, newsID primitive.ObjectID, userID primitive.ObjectID) int
-		MakeFeaturedBlog    func(childComplexity int, unionID primitive.ObjectID, blogID primitive.ObjectID, featured bool) int
-		MakePrivate         func(childComplexity int, unionID primitive.ObjectID, newsID primitive.ObjectID, private bool) int
-		PinNewsPost         func(childComplexity int, unionID primitive.ObjectID, newsID primitive.ObjectID) int
-		ShowPin             func(childComplexity int, unionID primitive.ObjectID, newsID primitive.ObjectID, show bool) int
-		UpdateBlogPost      func(childComplexity int, unionID primitive.ObjectID, blogID primitive.ObjectID, input model.Blog) int
+		AddComment             func(childComplexity int, unionID primitive.ObjectID, newsID primitive.ObjectID, comment model.Comment) int
+		AnonymiseMemberContent func(childComplexity int, input model.AnonymiseMemberInput) int
+		CommentButtonToggle    func(childComplexity int, unionID primitive.ObjectID, newsID primitive.ObjectID, commentDisplay bool) int
+		CreateBlogPost         func(childComplexity int, unionID primitive.ObjectID, input model.Blog, images []*string) int
+		CreateNews             func(childComplexity int, unionID primitive.ObjectID, input model.News, images []*string, documents []*model.Document, category string) int
+		DeleteBlogPost         func(childComplexity int, unionID primitive.ObjectID, blogID primitive.ObjectID) int
+		DeleteComment          func(childComplexity int, unionID primitive.ObjectID, newsID primitive.ObjectID, commentID primitive.ObjectID) int
+		DeleteNews             func(childComplexity int, unionID primitive.ObjectID, newsID primitive.ObjectID) int
+		LikeButtonToggle       func(childComplexity int, unionID primitive.ObjectID, newsID primitive.ObjectID, likeDisplay bool) int
+		LikeComment            func(childComplexity int, unionID primitive.ObjectID, newsID primitive.ObjectID, commentID primitive.ObjectID, userID primitive.ObjectID) int
+		LikeNewsItem           func(childComplexity int, unionID primitive.ObjectID, newsID primitive.ObjectID, userID primitive.ObjectID) int
+		MakeFeaturedBlog       func(childComplexity int, unionID primitive.ObjectID, blogID primitive.ObjectID, featured bool) int
+		MakePrivate            func(childComplexity int, unionID primitive.ObjectID, newsID primitive.ObjectID, private bool) int
+		PinNewsPost            func(childComplexity int, unionID primitive.ObjectID, newsID primitive.ObjectID) int
+		PurgeDeletedContent    func(childComplexity int, unionID primitive.ObjectID, dryRun bool) int
+		ShowPin                func(childComplexity int, unionID primitive.ObjectID, newsID primitive.ObjectID, show bool) int
+		UpdateBlogPost         func(childComplexity int, unionID primitive.ObjectID, blogID primitive.ObjectID, input model.Blog) int
 	}
 
 	News struct {
@@ -96,6 +117,7 @@ type ComplexityRoot struct {
 		Creator   func(childComplexity int) int
 		ID        func(childComplexity int) int
 		Unit      func(childComplexity int) int
+		Units     func(childComplexity int) int
 		UserID    func(childComplexity int) int
 	}
 
@@ -123,6 +145,7 @@ type ComplexityRoot struct {
 		ShowComments func(childComplexity int) int
 		ShowLikes    func(childComplexity int) int
 		Unit         func(childComplexity int) int
+		Units        func(childComplexity int) int
 		UserID       func(childComplexity int) int
 	}
 
@@ -131,40 +154,60 @@ type ComplexityRoot struct {
 		Total func(childComplexity int) int
 	}
 
+	PublicBlog struct {
+		Content   func(childComplexity int) int
+		CreatedOn func(childComplexity int) int
+		Header    func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Images    func(childComplexity int) int
+		SubHeader func(childComplexity int) int
+	}
+
+	PublicContent struct {
+		Blogs  func(childComplexity int) int
+		News   func(childComplexity int) int
+		Pinned func(childComplexity int) int
+	}
+
+	PublicNews struct {
+		Category  func(childComplexity int) int
+		Content   func(childComplexity int) int
+		CreatedOn func(childComplexity int) int
+		Documents func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Images    func(childComplexity int) int
+		Pinned    func(childComplexity int) int
+	}
+
+	PurgeItem struct {
+		Collection    func(childComplexity int) int
+		Cutoff        func(childComplexity int) int
+		Matched       func(childComplexity int) int
+		MediaDeleted  func(childComplexity int) int
+		Purged        func(childComplexity int) int
+		RetentionDays func(childComplexity int) int
+	}
+
+	PurgeReport struct {
+		DryRun  func(childComplexity int) int
+		Items   func(childComplexity int) int
+		RanAt   func(childComplexity int) int
+		UnionID func(childComplexity int) int
+	}
+
 	Query struct {
 		GetAllNewsPosts    func(childComplexity int, unionID primitive.ObjectID, page int, limit int) int
-		GetBlogPosts       func(childComplexity int) int
+		GetBlogPosts       func(childComplexity int, unionID primitive.ObjectID) int
 		GetComments        func(childComplexity int, unionID primitive.ObjectID, newsID primitive.ObjectID, page int, limit int) int
-		GetOneBlogPost     func(childComplexity int, blogID primitive.ObjectID) int
+		GetOneBlogPost     func(childComplexity int, unionID primitive.ObjectID, blogID primitive.ObjectID) int
+		MemberContent      func(childComplexity int, unionID primitive.ObjectID, userID primitive.ObjectID) int
+		PublicContent      func(childComplexity int, unionID primitive.ObjectID, newsLimit *int, blogLimit *int) int
 		__resolve__service func(childComplexity int) int
+		__resolve_entities func(childComplexity int, representations []map[string]interface{}) int
 	}
 
 	User struct {
-		CommonName func(childComplexity int) int
-		Deleted    func(childComplexity int) int
-		FirstName  func(childComplexity int) int
-		Gender     func(childComplexity int) int
-		ID         func(childComplexity int) int
-		LastName   func(childComplexity int) int
-		MaidenName func(childComplexity int) int
-		MiddleName func(childComplexity int) int
-		Password   func(childComplexity int) int
-		Profile    func(childComplexity int) int
-		UnionID    func(childComplexity int) int
-		Username   func(childComplexity int) int
-	}
-
-	UserInfo struct {
-		Address     func(childComplexity int) int
-		City        func(childComplexity int) int
-		Description func(childComplexity int) int
-		Email       func(childComplexity int) int
-		ImageURL    func(childComplexity int) int
-		Mobile      func(childComplexity int) int
-		Phone       func(childComplexity int) int
-		PostalCode  func(childComplexity int) int
-		Province    func(childComplexity int) int
-		UnionMail   func(childComplexity int) int
+		ID func(childComplexity int) int
 	}
 
 	_Service struct {
@@ -188,12 +231,16 @@ type MutationResolver interface {
 	DeleteBlogPost(ctx context.Context, unionID primitive.ObjectID, blogID primitive.ObjectID) (*string, error)
 	MakeFeaturedBlog(ctx context.Context, unionID primitive.ObjectID, blogID primitive.ObjectID, featured bool) (*string, error)
 	UpdateBlogPost(ctx context.Context, unionID primitive.ObjectID, blogID primitive.ObjectID, input model.Blog) (*model.Blog, error)
+	AnonymiseMemberContent(ctx context.Context, input model.AnonymiseMemberInput) (*model.AnonymiseReport, error)
+	PurgeDeletedContent(ctx context.Context, unionID primitive.ObjectID, dryRun bool) (*model.PurgeReport, error)
 }
 type QueryResolver interface {
 	GetAllNewsPosts(ctx context.Context, unionID primitive.ObjectID, page int, limit int) (*model.Report, error)
 	GetComments(ctx context.Context, unionID primitive.ObjectID, newsID primitive.ObjectID, page int, limit int) ([]*model.Comment, error)
-	GetBlogPosts(ctx context.Context) ([]*model.Blog, error)
-	GetOneBlogPost(ctx context.Context, blogID primitive.ObjectID) (*model.Blog, error)
+	GetBlogPosts(ctx context.Context, unionID primitive.ObjectID) ([]*model.Blog, error)
+	GetOneBlogPost(ctx context.Context, unionID primitive.ObjectID, blogID primitive.ObjectID) (*model.Blog, error)
+	MemberContent(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID) (*model.MemberContent, error)
+	PublicContent(ctx context.Context, unionID primitive.ObjectID, newsLimit *int, blogLimit *int) (*model.PublicContent, error)
 }
 
 type executableSchema struct {
@@ -215,6 +262,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
+	case "AnonymiseReport.comments":
+		if e.complexity.AnonymiseReport.Comments == nil {
+			break
+		}
+
+		return e.complexity.AnonymiseReport.Comments(childComplexity), true
+
+	case "AnonymiseReport.likes":
+		if e.complexity.AnonymiseReport.Likes == nil {
+			break
+		}
+
+		return e.complexity.AnonymiseReport.Likes(childComplexity), true
+
+	case "AnonymiseReport.news":
+		if e.complexity.AnonymiseReport.News == nil {
+			break
+		}
+
+		return e.complexity.AnonymiseReport.News(childComplexity), true
+
+	case "BargainingUnit.id":
+		if e.complexity.BargainingUnit.ID == nil {
+			break
+		}
+
+		return e.complexity.BargainingUnit.ID(childComplexity), true
+
 	case "Blog.content":
 		if e.complexity.Blog.Content == nil {
 			break
@@ -313,6 +388,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Comment.Likes(childComplexity), true
 
+	case "Comment.newsID":
+		if e.complexity.Comment.NewsID == nil {
+			break
+		}
+
+		return e.complexity.Comment.NewsID(childComplexity), true
+
 	case "Comment.userID":
 		if e.complexity.Comment.UserID == nil {
 			break
@@ -320,6 +402,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Comment.UserID(childComplexity), true
 
+	case "MemberContent.comments":
+		if e.complexity.MemberContent.Comments == nil {
+			break
+		}
+
+		return e.complexity.MemberContent.Comments(childComplexity), true
+
+	case "MemberContent.likedComments":
+		if e.complexity.MemberContent.LikedComments == nil {
+			break
+		}
+
+		return e.complexity.MemberContent.LikedComments(childComplexity), true
+
+	case "MemberContent.likedNews":
+		if e.complexity.MemberContent.LikedNews == nil {
+			break
+		}
+
+		return e.complexity.MemberContent.LikedNews(childComplexity), true
+
+	case "MemberContent.news":
+		if e.complexity.MemberContent.News == nil {
+			break
+		}
+
+		return e.complexity.MemberContent.News(childComplexity), true
+
 	case "Mutation.addComment":
 		if e.complexity.Mutation.AddComment == nil {
 			break
@@ -332,6 +442,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.AddComment(childComplexity, args["unionID"].(primitive.ObjectID), args["newsID"].(primitive.ObjectID), args["comment"].(model.Comment)), true
 
+	case "Mutation.anonymiseMemberContent":
+		if e.complexity.Mutation.AnonymiseMemberContent == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_anonymiseMemberContent_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AnonymiseMemberContent(childComplexity, args["input"].(model.AnonymiseMemberInput)), true
+
 	case "Mutation.commentButtonToggle":
 		if e.complexity.Mutation.CommentButtonToggle == nil {
 			break
@@ -476,6 +598,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.PinNewsPost(childComplexity, args["unionID"].(primitive.ObjectID), args["newsID"].(primitive.ObjectID)), true
 
+	case "Mutation.purgeDeletedContent":
+		if e.complexity.Mutation.PurgeDeletedContent == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_purgeDeletedContent_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.PurgeDeletedContent(childComplexity, args["unionID"].(primitive.ObjectID), args["dryRun"].(bool)), true
+
 	case "Mutation.showPin":
 		if e.complexity.Mutation.ShowPin == nil {
 			break
@@ -535,6 +669,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.News.Unit(childComplexity), true
 
+	case "News.units":
+		if e.complexity.News.Units == nil {
+			break
+		}
+
+		return e.complexity.News.Units(childComplexity), true
+
 	case "News.userID":
 		if e.complexity.News.UserID == nil {
 			break
@@ -682,6 +823,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.NewsItem.Unit(childComplexity), true
 
+	case "NewsItem.units":
+		if e.complexity.NewsItem.Units == nil {
+			break
+		}
+
+		return e.complexity.NewsItem.Units(childComplexity), true
+
 	case "NewsItem.userID":
 		if e.complexity.NewsItem.UserID == nil {
 			break
@@ -703,209 +851,285 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.NewsReport.Total(childComplexity), true
 
-	case "Query.getAllNewsPosts":
-		if e.complexity.Query.GetAllNewsPosts == nil {
+	case "PublicBlog.content":
+		if e.complexity.PublicBlog.Content == nil {
 			break
 		}
 
-		args, err := ec.field_Query_getAllNewsPosts_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.PublicBlog.Content(childComplexity), true
+
+	case "PublicBlog.createdOn":
+		if e.complexity.PublicBlog.CreatedOn == nil {
+			break
 		}
 
-		return e.complexity.Query.GetAllNewsPosts(childComplexity, args["unionID"].(primitive.ObjectID), args["page"].(int), args["limit"].(int)), true
+		return e.complexity.PublicBlog.CreatedOn(childComplexity), true
 
-	case "Query.getBlogPosts":
-		if e.complexity.Query.GetBlogPosts == nil {
+	case "PublicBlog.header":
+		if e.complexity.PublicBlog.Header == nil {
 			break
 		}
 
-		return e.complexity.Query.GetBlogPosts(childComplexity), true
+		return e.complexity.PublicBlog.Header(childComplexity), true
 
-	case "Query.getComments":
-		if e.complexity.Query.GetComments == nil {
+	case "PublicBlog.id":
+		if e.complexity.PublicBlog.ID == nil {
 			break
 		}
 
-		args, err := ec.field_Query_getComments_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.PublicBlog.ID(childComplexity), true
+
+	case "PublicBlog.images":
+		if e.complexity.PublicBlog.Images == nil {
+			break
 		}
 
-		return e.complexity.Query.GetComments(childComplexity, args["unionID"].(primitive.ObjectID), args["newsID"].(primitive.ObjectID), args["page"].(int), args["limit"].(int)), true
+		return e.complexity.PublicBlog.Images(childComplexity), true
 
-	case "Query.getOneBlogPost":
-		if e.complexity.Query.GetOneBlogPost == nil {
+	case "PublicBlog.subHeader":
+		if e.complexity.PublicBlog.SubHeader == nil {
 			break
 		}
 
-		args, err := ec.field_Query_getOneBlogPost_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.PublicBlog.SubHeader(childComplexity), true
+
+	case "PublicContent.blogs":
+		if e.complexity.PublicContent.Blogs == nil {
+			break
 		}
 
-		return e.complexity.Query.GetOneBlogPost(childComplexity, args["blogID"].(primitive.ObjectID)), true
+		return e.complexity.PublicContent.Blogs(childComplexity), true
 
-	case "Query._service":
-		if e.complexity.Query.__resolve__service == nil {
+	case "PublicContent.news":
+		if e.complexity.PublicContent.News == nil {
 			break
 		}
 
-		return e.complexity.Query.__resolve__service(childComplexity), true
+		return e.complexity.PublicContent.News(childComplexity), true
 
-	case "User.commonName":
-		if e.complexity.User.CommonName == nil {
+	case "PublicContent.pinned":
+		if e.complexity.PublicContent.Pinned == nil {
 			break
 		}
 
-		return e.complexity.User.CommonName(childComplexity), true
+		return e.complexity.PublicContent.Pinned(childComplexity), true
 
-	case "User.deleted":
-		if e.complexity.User.Deleted == nil {
+	case "PublicNews.category":
+		if e.complexity.PublicNews.Category == nil {
 			break
 		}
 
-		return e.complexity.User.Deleted(childComplexity), true
+		return e.complexity.PublicNews.Category(childComplexity), true
 
-	case "User.firstName":
-		if e.complexity.User.FirstName == nil {
+	case "PublicNews.content":
+		if e.complexity.PublicNews.Content == nil {
 			break
 		}
 
-		return e.complexity.User.FirstName(childComplexity), true
+		return e.complexity.PublicNews.Content(childComplexity), true
 
-	case "User.gender":
-		if e.complexity.User.Gender == nil {
+	case "PublicNews.createdOn":
+		if e.complexity.PublicNews.CreatedOn == nil {
 			break
 		}
 
-		return e.complexity.User.Gender(childComplexity), true
+		return e.complexity.PublicNews.CreatedOn(childComplexity), true
 
-	case "User.id":
-		if e.complexity.User.ID == nil {
+	case "PublicNews.documents":
+		if e.complexity.PublicNews.Documents == nil {
 			break
 		}
 
-		return e.complexity.User.ID(childComplexity), true
+		return e.complexity.PublicNews.Documents(childComplexity), true
+
+	case "PublicNews.id":
+		if e.complexity.PublicNews.ID == nil {
+			break
+		}
+
+		return e.complexity.PublicNews.ID(childComplexity), true
+
+	case "PublicNews.images":
+		if e.complexity.PublicNews.Images == nil {
+			break
+		}
+
+		return e.complexity.PublicNews.Images(childComplexity), true
+
+	case "PublicNews.pinned":
+		if e.complexity.PublicNews.Pinned == nil {
+			break
+		}
 
-	case "User.lastName":
-		if e.complexity.User.LastName == nil {
+		return e.complexity.PublicNews.Pinned(childComplexity), true
+
+	case "PurgeItem.collection":
+		if e.complexity.PurgeItem.Collection == nil {
+			break
+		}
+
+		return e.complexity.PurgeItem.Collection(childComplexity), true
+
+	case "PurgeItem.cutoff":
+		if e.complexity.PurgeItem.Cutoff == nil {
+			break
+		}
+
+		return e.complexity.PurgeItem.Cutoff(childComplexity), true
+
+	case "PurgeItem.matched":
+		if e.complexity.PurgeItem.Matched == nil {
 			break
 		}
 
-		return e.complexity.User.LastName(childComplexity), true
+		return e.complexity.PurgeItem.Matched(childComplexity), true
 
-	case "User.maidenName":
-		if e.complexity.User.MaidenName == nil {
+	case "PurgeItem.mediaDeleted":
+		if e.complexity.PurgeItem.MediaDeleted == nil {
 			break
 		}
 
-		return e.complexity.User.MaidenName(childComplexity), true
+		return e.complexity.PurgeItem.MediaDeleted(childComplexity), true
 
-	case "User.middleName":
-		if e.complexity.User.MiddleName == nil {
+	case "PurgeItem.purged":
+		if e.complexity.PurgeItem.Purged == nil {
 			break
 		}
 
-		return e.complexity.User.MiddleName(childComplexity), true
+		return e.complexity.PurgeItem.Purged(childComplexity), true
 
-	case "User.password":
-		if e.complexity.User.Password == nil {
+	case "PurgeItem.retentionDays":
+		if e.complexity.PurgeItem.RetentionDays == nil {
 			break
 		}
 
-		return e.complexity.User.Password(childComplexity), true
+		return e.complexity.PurgeItem.RetentionDays(childComplexity), true
 
-	case "User.profile":
-		if e.complexity.User.Profile == nil {
+	case "PurgeReport.dryRun":
+		if e.complexity.PurgeReport.DryRun == nil {
 			break
 		}
 
-		return e.complexity.User.Profile(childComplexity), true
+		return e.complexity.PurgeReport.DryRun(childComplexity), true
 
-	case "User.unionID":
-		if e.complexity.User.UnionID == nil {
+	case "PurgeReport.items":
+		if e.complexity.PurgeReport.Items == nil {
 			break
 		}
 
-		return e.complexity.User.UnionID(childComplexity), true
+		return e.complexity.PurgeReport.Items(childComplexity), true
 
-	case "User.username":
-		if e.complexity.User.Username == nil {
+	case "PurgeReport.ranAt":
+		if e.complexity.PurgeReport.RanAt == nil {
 			break
 		}
 
-		return e.complexity.User.Username(childComplexity), true
+		return e.complexity.PurgeReport.RanAt(childComplexity), true
 
-	case "UserInfo.address":
-		if e.complexity.UserInfo.Address == nil {
+	case "PurgeReport.unionID":
+		if e.complexity.PurgeReport.UnionID == nil {
 			break
 		}
 
-		return e.complexity.UserInfo.Address(childComplexity), true
+		return e.complexity.PurgeReport.UnionID(childComplexity), true
 
-	case "UserInfo.city":
-		if e.complexity.UserInfo.City == nil {
+	case "Query.getAllNewsPosts":
+		if e.complexity.Query.GetAllNewsPosts == nil {
 			break
 		}
 
-		return e.complexity.UserInfo.City(childComplexity), true
+		args, err := ec.field_Query_getAllNewsPosts_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetAllNewsPosts(childComplexity, args["unionID"].(primitive.ObjectID), args["page"].(int), args["limit"].(int)), true
 
-	case "UserInfo.description":
-		if e.complexity.UserInfo.Description == nil {
+	case "Query.getBlogPosts":
+		if e.complexity.Query.GetBlogPosts == nil {
 			break
 		}
 
-		return e.complexity.UserInfo.Description(childComplexity), true
+		args, err := ec.field_Query_getBlogPosts_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetBlogPosts(childComplexity, args["unionID"].(primitive.ObjectID)), true
 
-	case "UserInfo.email":
-		if e.complexity.UserInfo.Email == nil {
+	case "Query.getComments":
+		if e.complexity.Query.GetComments == nil {
 			break
 		}
 
-		return e.complexity.UserInfo.Email(childComplexity), true
+		args, err := ec.field_Query_getComments_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetComments(childComplexity, args["unionID"].(primitive.ObjectID), args["newsID"].(primitive.ObjectID), args["page"].(int), args["limit"].(int)), true
 
-	case "UserInfo.imageURL":
-		if e.complexity.UserInfo.ImageURL == nil {
+	case "Query.getOneBlogPost":
+		if e.complexity.Query.GetOneBlogPost == nil {
 			break
 		}
 
-		return e.complexity.UserInfo.ImageURL(childComplexity), true
+		args, err := ec.field_Query_getOneBlogPost_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetOneBlogPost(childComplexity, args["unionID"].(primitive.ObjectID), args["blogID"].(primitive.ObjectID)), true
 
-	case "UserInfo.mobile":
-		if e.complexity.UserInfo.Mobile == nil {
+	case "Query.memberContent":
+		if e.complexity.Query.MemberContent == nil {
 			break
 		}
 
-		return e.complexity.UserInfo.Mobile(childComplexity), true
+		args, err := ec.field_Query_memberContent_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.MemberContent(childComplexity, args["unionID"].(primitive.ObjectID), args["userID"].(primitive.ObjectID)), true
 
-	case "UserInfo.phone":
-		if e.complexity.UserInfo.Phone == nil {
+	case "Query.publicContent":
+		if e.complexity.Query.PublicContent == nil {
 			break
 		}
 
-		return e.complexity.UserInfo.Phone(childComplexity), true
+		args, err := ec.field_Query_publicContent_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PublicContent(childComplexity, args["unionID"].(primitive.ObjectID), args["newsLimit"].(*int), args["blogLimit"].(*int)), true
 
-	case "UserInfo.postalCode":
-		if e.complexity.UserInfo.PostalCode == nil {
+	case "Query._service":
+		if e.complexity.Query.__resolve__service == nil {
 			break
 		}
 
-		return e.complexity.UserInfo.PostalCode(childComplexity), true
+		return e.complexity.Query.__resolve__service(childComplexity), true
 
-	case "UserInfo.province":
-		if e.complexity.UserInfo.Province == nil {
+	case "Query._entities":
+		if e.complexity.Query.__resolve_entities == nil {
 			break
 		}
 
-		return e.complexity.UserInfo.Province(childComplexity), true
+		args, err := ec.field_Query__entities_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.__resolve_entities(childComplexity, args["representations"].([]map[string]interface{})), true
 
-	case "UserInfo.unionMail":
-		if e.complexity.UserInfo.UnionMail == nil {
+	case "User.id":
+		if e.complexity.User.ID == nil {
 			break
 		}
 
-		return e.complexity.UserInfo.UnionMail(childComplexity), true
+		return e.complexity.User.ID(childComplexity), true
 
 	case "_Service.sdl":
 		if e.complexity._Service.SDL == nil {
@@ -922,6 +1146,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	opCtx := graphql.GetOperationContext(ctx)
 	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputAnonymiseMemberInput,
 		ec.unmarshalInputBlogInput,
 		ec.unmarshalInputCommentInput,
 		ec.unmarshalInputNewsDocumentInput,
@@ -1028,39 +1253,23 @@ scalar ObjectID
 scalar Time
 
 
-type User {
-  id: ObjectID
-  unionID: ObjectID
-  deleted:Boolean
-  username: String
-  password: String
-  firstName: String
-  lastName: String
-  middleName: String
-  maidenName: String
-  commonName: String
-  gender: String
-  profile: UserInfo
-}
-
-type UserInfo {
-  email: String
-  unionMail: String
-  imageURL: String
-  address: String
-  city: String
-  province: String
-  postalCode: String
-  phone: String
-  mobile: String
-  description: String
+# owned by userService, which resolves the members referenced here
+extend type User @key(fields: "id") {
+  id: ObjectID! @external
+}
+
+# owned by unionService, which resolves the units news is targeted at
+extend type BargainingUnit @key(fields: "id") {
+  id: ObjectID! @external
 }
 
 type News {
   id: ObjectID
   content: String
   createdOn: Time
+  "bargaining unit ids"
   unit: [String]
+  units: [BargainingUnit]
   creator: User
   userID: ObjectID
 }
@@ -1069,6 +1278,7 @@ input NewsInput {
   content: String!
   userID: ObjectID
   createdOn: Time
+  "bargaining unit ids, or unit names from older clients"
   Unit: [String]
   private: Boolean
   showLikes: Boolean = true
@@ -1080,7 +1290,9 @@ type NewsItem {
   id: ObjectID
   content: String
   createdOn: Time
+  "bargaining unit ids"
   unit: [String]
+  units: [BargainingUnit]
   creator: User
   userID: ObjectID
   likes: [ObjectID]
@@ -1101,6 +1313,7 @@ type NewsItem {
 
 type Comment {
   id: ObjectID
+  newsID: ObjectID
   content: String
   createdOn: Time
   userID: ObjectID
@@ -1148,14 +1361,84 @@ input BlogInput {
     featured: Boolean
 }
 
+type MemberContent {
+  news: [NewsItem]
+  comments: [Comment]
+  likedNews: [ObjectID]
+  likedComments: [ObjectID]
+}
+
+input AnonymiseMemberInput {
+  unionID: ObjectID!
+  userID: ObjectID!
+  replacementID: ObjectID!
+}
+
+type AnonymiseReport {
+  news: Int
+  comments: Int
+  likes: Int
+}
+
+type PurgeReport {
+  unionID: ObjectID!
+  dryRun: Boolean!
+  ranAt: Time
+  items: [PurgeItem!]!
+}
+
+type PurgeItem {
+  collection: String!
+  retentionDays: Int!
+  cutoff: Time
+  matched: Int!
+  purged: Int!
+  mediaDeleted: Int!
+}
+
+"a union's public page: no private or unit-targeted posts, nothing about members"
+type PublicContent {
+  pinned: [PublicNews!]!
+  news: [PublicNews!]!
+  "featured blog posts"
+  blogs: [PublicBlog!]!
+}
+
+type PublicNews {
+  id: ObjectID!
+  content: String
+  images: [String]
+  documents: [NewsDocument]
+  category: String
+  pinned: Boolean!
+  createdOn: Time
+}
+
+type PublicBlog {
+  id: ObjectID!
+  header: String
+  subHeader: String
+  content: String
+  images: [String]
+  createdOn: Time
+}
+
 type Query{
   #-----------------NEWS-------------------#
     getAllNewsPosts(unionID: ObjectID!, page: Int!,limit: Int!): NewsReport
     getComments(unionID:ObjectID!,newsID:ObjectID!, page: Int!, limit:Int!):[Comment]
 
     #-----------------BLOG-------------------#
-    getBlogPosts: [Blog]
-    getOneBlogPost(blogID: ObjectID!): Blog
+    getBlogPosts(unionID: ObjectID!): [Blog]
+    getOneBlogPost(unionID: ObjectID!, blogID: ObjectID!): Blog
+
+    #-----------------PRIVACY-------------------#
+    "everything a member authored or liked, used for data subject access exports"
+    memberContent(unionID: ObjectID!, userID: ObjectID!): MemberContent
+
+    #-----------------PUBLIC-------------------#
+    "unauthenticated: the posts of a union's public page, empty for modules it lacks"
+    publicContent(unionID: ObjectID!, newsLimit: Int, blogLimit: Int): PublicContent!
 }
 
 type Mutation{
@@ -1243,6 +1526,16 @@ type Mutation{
     blogID: ObjectID!
     input: BlogInput!
   ): Blog
+
+#-----------------PRIVACY-------------------#
+
+  "re-attributes a member's posts, comments and likes to a pseudonymous id and redacts their comments"
+  anonymiseMemberContent(input: AnonymiseMemberInput!): AnonymiseReport
+
+#-----------------RETENTION-------------------#
+
+  "hard-delete news and comments soft-deleted longer than the union retention policy allows"
+  purgeDeletedContent(unionID: ObjectID!, dryRun: Boolean!): PurgeReport!
 }
 
 
@@ -1257,11 +1550,15 @@ type Mutation{
 	scalar _FieldSet
 `, BuiltIn: true},
 	{Name: "../../federation/entity.graphql", Input: `
+# a union of all types that use the @key directive
+union _Entity = BargainingUnit | User
+
 type _Service {
   sdl: String
 }
 
 extend type Query {
+  _entities(representations: [_Any!]!): [_Entity]!
   _service: _Service!
 }
 `, BuiltIn: true},
@@ -1358,6 +1655,38 @@ func (ec *executionContext) field_Mutation_addComment_argsComment(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Mutation_anonymiseMemberContent_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Mutation_anonymiseMemberContent_argsInput(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_anonymiseMemberContent_argsInput(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (model.AnonymiseMemberInput, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["input"]
+	if !ok {
+		var zeroVal model.AnonymiseMemberInput
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+	if tmp, ok := rawArgs["input"]; ok {
+		return ec.unmarshalNAnonymiseMemberInput2younifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐAnonymiseMemberInput(ctx, tmp)
+	}
+
+	var zeroVal model.AnonymiseMemberInput
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Mutation_commentButtonToggle_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2390,27 +2719,22 @@ func (ec *executionContext) field_Mutation_pinNewsPost_argsNewsID(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_showPin_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_purgeDeletedContent_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	arg0, err := ec.field_Mutation_showPin_argsUnionID(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_purgeDeletedContent_argsUnionID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
 	args["unionID"] = arg0
-	arg1, err := ec.field_Mutation_showPin_argsNewsID(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["newsID"] = arg1
-	arg2, err := ec.field_Mutation_showPin_argsShow(ctx, rawArgs)
+	arg1, err := ec.field_Mutation_purgeDeletedContent_argsDryRun(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["show"] = arg2
+	args["dryRun"] = arg1
 	return args, nil
 }
-func (ec *executionContext) field_Mutation_showPin_argsUnionID(
+func (ec *executionContext) field_Mutation_purgeDeletedContent_argsUnionID(
 	ctx context.Context,
 	rawArgs map[string]interface{},
 ) (primitive.ObjectID, error) {
@@ -2432,10 +2756,74 @@ func (ec *executionContext) field_Mutation_showPin_argsUnionID(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_showPin_argsNewsID(
+func (ec *executionContext) field_Mutation_purgeDeletedContent_argsDryRun(
 	ctx context.Context,
 	rawArgs map[string]interface{},
-) (primitive.ObjectID, error) {
+) (bool, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["dryRun"]
+	if !ok {
+		var zeroVal bool
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("dryRun"))
+	if tmp, ok := rawArgs["dryRun"]; ok {
+		return ec.unmarshalNBoolean2bool(ctx, tmp)
+	}
+
+	var zeroVal bool
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_showPin_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Mutation_showPin_argsUnionID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["unionID"] = arg0
+	arg1, err := ec.field_Mutation_showPin_argsNewsID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["newsID"] = arg1
+	arg2, err := ec.field_Mutation_showPin_argsShow(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["show"] = arg2
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_showPin_argsUnionID(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (primitive.ObjectID, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["unionID"]
+	if !ok {
+		var zeroVal primitive.ObjectID
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("unionID"))
+	if tmp, ok := rawArgs["unionID"]; ok {
+		return ec.unmarshalNObjectID2goᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx, tmp)
+	}
+
+	var zeroVal primitive.ObjectID
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_showPin_argsNewsID(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (primitive.ObjectID, error) {
 	// We won't call the directive if the argument is null.
 	// Set call_argument_directives_with_null to true to call directives
 	// even if the argument is null.
@@ -2594,6 +2982,38 @@ func (ec *executionContext) field_Query___type_argsName(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query__entities_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query__entities_argsRepresentations(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["representations"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query__entities_argsRepresentations(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) ([]map[string]interface{}, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["representations"]
+	if !ok {
+		var zeroVal []map[string]interface{}
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("representations"))
+	if tmp, ok := rawArgs["representations"]; ok {
+		return ec.unmarshalN_Any2ᚕmapᚄ(ctx, tmp)
+	}
+
+	var zeroVal []map[string]interface{}
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Query_getAllNewsPosts_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2680,6 +3100,38 @@ func (ec *executionContext) field_Query_getAllNewsPosts_argsLimit(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query_getBlogPosts_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query_getBlogPosts_argsUnionID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["unionID"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_getBlogPosts_argsUnionID(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (primitive.ObjectID, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["unionID"]
+	if !ok {
+		var zeroVal primitive.ObjectID
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("unionID"))
+	if tmp, ok := rawArgs["unionID"]; ok {
+		return ec.unmarshalNObjectID2goᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx, tmp)
+	}
+
+	var zeroVal primitive.ObjectID
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Query_getComments_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2796,13 +3248,40 @@ func (ec *executionContext) field_Query_getComments_argsLimit(
 func (ec *executionContext) field_Query_getOneBlogPost_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	arg0, err := ec.field_Query_getOneBlogPost_argsBlogID(ctx, rawArgs)
+	arg0, err := ec.field_Query_getOneBlogPost_argsUnionID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["unionID"] = arg0
+	arg1, err := ec.field_Query_getOneBlogPost_argsBlogID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["blogID"] = arg0
+	args["blogID"] = arg1
 	return args, nil
 }
+func (ec *executionContext) field_Query_getOneBlogPost_argsUnionID(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (primitive.ObjectID, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["unionID"]
+	if !ok {
+		var zeroVal primitive.ObjectID
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("unionID"))
+	if tmp, ok := rawArgs["unionID"]; ok {
+		return ec.unmarshalNObjectID2goᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx, tmp)
+	}
+
+	var zeroVal primitive.ObjectID
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Query_getOneBlogPost_argsBlogID(
 	ctx context.Context,
 	rawArgs map[string]interface{},
@@ -2825,6 +3304,151 @@ func (ec *executionContext) field_Query_getOneBlogPost_argsBlogID(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query_memberContent_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query_memberContent_argsUnionID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["unionID"] = arg0
+	arg1, err := ec.field_Query_memberContent_argsUserID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["userID"] = arg1
+	return args, nil
+}
+func (ec *executionContext) field_Query_memberContent_argsUnionID(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (primitive.ObjectID, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["unionID"]
+	if !ok {
+		var zeroVal primitive.ObjectID
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("unionID"))
+	if tmp, ok := rawArgs["unionID"]; ok {
+		return ec.unmarshalNObjectID2goᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx, tmp)
+	}
+
+	var zeroVal primitive.ObjectID
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_memberContent_argsUserID(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (primitive.ObjectID, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["userID"]
+	if !ok {
+		var zeroVal primitive.ObjectID
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("userID"))
+	if tmp, ok := rawArgs["userID"]; ok {
+		return ec.unmarshalNObjectID2goᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx, tmp)
+	}
+
+	var zeroVal primitive.ObjectID
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_publicContent_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query_publicContent_argsUnionID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["unionID"] = arg0
+	arg1, err := ec.field_Query_publicContent_argsNewsLimit(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["newsLimit"] = arg1
+	arg2, err := ec.field_Query_publicContent_argsBlogLimit(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["blogLimit"] = arg2
+	return args, nil
+}
+func (ec *executionContext) field_Query_publicContent_argsUnionID(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (primitive.ObjectID, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["unionID"]
+	if !ok {
+		var zeroVal primitive.ObjectID
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("unionID"))
+	if tmp, ok := rawArgs["unionID"]; ok {
+		return ec.unmarshalNObjectID2goᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx, tmp)
+	}
+
+	var zeroVal primitive.ObjectID
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_publicContent_argsNewsLimit(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*int, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["newsLimit"]
+	if !ok {
+		var zeroVal *int
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("newsLimit"))
+	if tmp, ok := rawArgs["newsLimit"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	}
+
+	var zeroVal *int
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_publicContent_argsBlogLimit(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*int, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["blogLimit"]
+	if !ok {
+		var zeroVal *int
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("blogLimit"))
+	if tmp, ok := rawArgs["blogLimit"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	}
+
+	var zeroVal *int
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2897,8 +3521,8 @@ func (ec *executionContext) field___Type_fields_argsIncludeDeprecated(
 
 // region    **************************** field.gotpl *****************************
 
-func (ec *executionContext) _Blog_id(ctx context.Context, field graphql.CollectedField, obj *model.Blog) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Blog_id(ctx, field)
+func (ec *executionContext) _AnonymiseReport_news(ctx context.Context, field graphql.CollectedField, obj *model.AnonymiseReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AnonymiseReport_news(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2911,7 +3535,174 @@ func (ec *executionContext) _Blog_id(ctx context.Context, field graphql.Collecte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.News, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalOInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AnonymiseReport_news(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AnonymiseReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AnonymiseReport_comments(ctx context.Context, field graphql.CollectedField, obj *model.AnonymiseReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AnonymiseReport_comments(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Comments, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalOInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AnonymiseReport_comments(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AnonymiseReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AnonymiseReport_likes(ctx context.Context, field graphql.CollectedField, obj *model.AnonymiseReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AnonymiseReport_likes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Likes, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalOInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AnonymiseReport_likes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AnonymiseReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BargainingUnit_id(ctx context.Context, field graphql.CollectedField, obj *model1.BargainingUnit) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_BargainingUnit_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(primitive.ObjectID)
+	fc.Result = res
+	return ec.marshalNObjectID2goᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_BargainingUnit_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BargainingUnit",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ObjectID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Blog_id(ctx context.Context, field graphql.CollectedField, obj *model.Blog) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Blog_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3266,6 +4057,47 @@ func (ec *executionContext) fieldContext_Comment_id(_ context.Context, field gra
 	return fc, nil
 }
 
+func (ec *executionContext) _Comment_newsID(ctx context.Context, field graphql.CollectedField, obj *model.Comment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Comment_newsID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NewsID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(primitive.ObjectID)
+	fc.Result = res
+	return ec.marshalOObjectID2goᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Comment_newsID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Comment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ObjectID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Comment_content(ctx context.Context, field graphql.CollectedField, obj *model.Comment) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Comment_content(ctx, field)
 	if err != nil {
@@ -3485,7 +4317,7 @@ func (ec *executionContext) _Comment_creator(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Creator, nil
+		return obj.Creator(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3494,7 +4326,7 @@ func (ec *executionContext) _Comment_creator(ctx context.Context, field graphql.
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model1.User)
+	res := resTmp.(*model2.User)
 	fc.Result = res
 	return ec.marshalOUser2ᚖyounifiedᚑbackendᚋcontractsᚋuserᚋmodelᚐUser(ctx, field.Selections, res)
 }
@@ -3503,34 +4335,12 @@ func (ec *executionContext) fieldContext_Comment_creator(_ context.Context, fiel
 	fc = &graphql.FieldContext{
 		Object:     "Comment",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
 				return ec.fieldContext_User_id(ctx, field)
-			case "unionID":
-				return ec.fieldContext_User_unionID(ctx, field)
-			case "deleted":
-				return ec.fieldContext_User_deleted(ctx, field)
-			case "username":
-				return ec.fieldContext_User_username(ctx, field)
-			case "password":
-				return ec.fieldContext_User_password(ctx, field)
-			case "firstName":
-				return ec.fieldContext_User_firstName(ctx, field)
-			case "lastName":
-				return ec.fieldContext_User_lastName(ctx, field)
-			case "middleName":
-				return ec.fieldContext_User_middleName(ctx, field)
-			case "maidenName":
-				return ec.fieldContext_User_maidenName(ctx, field)
-			case "commonName":
-				return ec.fieldContext_User_commonName(ctx, field)
-			case "gender":
-				return ec.fieldContext_User_gender(ctx, field)
-			case "profile":
-				return ec.fieldContext_User_profile(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
@@ -3538,8 +4348,8 @@ func (ec *executionContext) fieldContext_Comment_creator(_ context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createNews(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_createNews(ctx, field)
+func (ec *executionContext) _MemberContent_news(ctx context.Context, field graphql.CollectedField, obj *model.MemberContent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MemberContent_news(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3552,7 +4362,7 @@ func (ec *executionContext) _Mutation_createNews(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateNews(rctx, fc.Args["unionID"].(primitive.ObjectID), fc.Args["input"].(model.News), fc.Args["images"].([]*string), fc.Args["documents"].([]*model.Document), fc.Args["category"].(string))
+		return obj.News, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3561,17 +4371,17 @@ func (ec *executionContext) _Mutation_createNews(ctx context.Context, field grap
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.News)
+	res := resTmp.([]*model.News)
 	fc.Result = res
-	return ec.marshalONewsItem2ᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐNews(ctx, field.Selections, res)
+	return ec.marshalONewsItem2ᚕᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐNews(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createNews(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MemberContent_news(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "MemberContent",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
@@ -3582,6 +4392,8 @@ func (ec *executionContext) fieldContext_Mutation_createNews(ctx context.Context
 				return ec.fieldContext_NewsItem_createdOn(ctx, field)
 			case "unit":
 				return ec.fieldContext_NewsItem_unit(ctx, field)
+			case "units":
+				return ec.fieldContext_NewsItem_units(ctx, field)
 			case "creator":
 				return ec.fieldContext_NewsItem_creator(ctx, field)
 			case "userID":
@@ -3616,22 +4428,11 @@ func (ec *executionContext) fieldContext_Mutation_createNews(ctx context.Context
 			return nil, fmt.Errorf("no field named %q was found under type NewsItem", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createNews_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_deleteNews(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_deleteNews(ctx, field)
+func (ec *executionContext) _MemberContent_comments(ctx context.Context, field graphql.CollectedField, obj *model.MemberContent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MemberContent_comments(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3644,7 +4445,7 @@ func (ec *executionContext) _Mutation_deleteNews(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().DeleteNews(rctx, fc.Args["unionID"].(primitive.ObjectID), fc.Args["newsID"].(primitive.ObjectID))
+		return obj.Comments, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3653,37 +4454,44 @@ func (ec *executionContext) _Mutation_deleteNews(ctx context.Context, field grap
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.Comment)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOComment2ᚕᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐComment(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_deleteNews(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MemberContent_comments(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "MemberContent",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Comment_id(ctx, field)
+			case "newsID":
+				return ec.fieldContext_Comment_newsID(ctx, field)
+			case "content":
+				return ec.fieldContext_Comment_content(ctx, field)
+			case "createdOn":
+				return ec.fieldContext_Comment_createdOn(ctx, field)
+			case "userID":
+				return ec.fieldContext_Comment_userID(ctx, field)
+			case "likes":
+				return ec.fieldContext_Comment_likes(ctx, field)
+			case "dislikes":
+				return ec.fieldContext_Comment_dislikes(ctx, field)
+			case "creator":
+				return ec.fieldContext_Comment_creator(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Comment", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_deleteNews_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_addComment(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_addComment(ctx, field)
+func (ec *executionContext) _MemberContent_likedNews(ctx context.Context, field graphql.CollectedField, obj *model.MemberContent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MemberContent_likedNews(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3696,7 +4504,235 @@ func (ec *executionContext) _Mutation_addComment(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().AddComment(rctx, fc.Args["unionID"].(primitive.ObjectID), fc.Args["newsID"].(primitive.ObjectID), fc.Args["comment"].(model.Comment))
+		return obj.LikedNews, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]primitive.ObjectID)
+	fc.Result = res
+	return ec.marshalOObjectID2ᚕgoᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MemberContent_likedNews(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MemberContent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ObjectID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MemberContent_likedComments(ctx context.Context, field graphql.CollectedField, obj *model.MemberContent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MemberContent_likedComments(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LikedComments, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]primitive.ObjectID)
+	fc.Result = res
+	return ec.marshalOObjectID2ᚕgoᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MemberContent_likedComments(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MemberContent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ObjectID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_createNews(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createNews(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateNews(rctx, fc.Args["unionID"].(primitive.ObjectID), fc.Args["input"].(model.News), fc.Args["images"].([]*string), fc.Args["documents"].([]*model.Document), fc.Args["category"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.News)
+	fc.Result = res
+	return ec.marshalONewsItem2ᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐNews(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_createNews(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_NewsItem_id(ctx, field)
+			case "content":
+				return ec.fieldContext_NewsItem_content(ctx, field)
+			case "createdOn":
+				return ec.fieldContext_NewsItem_createdOn(ctx, field)
+			case "unit":
+				return ec.fieldContext_NewsItem_unit(ctx, field)
+			case "units":
+				return ec.fieldContext_NewsItem_units(ctx, field)
+			case "creator":
+				return ec.fieldContext_NewsItem_creator(ctx, field)
+			case "userID":
+				return ec.fieldContext_NewsItem_userID(ctx, field)
+			case "likes":
+				return ec.fieldContext_NewsItem_likes(ctx, field)
+			case "dislikes":
+				return ec.fieldContext_NewsItem_dislikes(ctx, field)
+			case "comments":
+				return ec.fieldContext_NewsItem_comments(ctx, field)
+			case "images":
+				return ec.fieldContext_NewsItem_images(ctx, field)
+			case "documents":
+				return ec.fieldContext_NewsItem_documents(ctx, field)
+			case "pinned":
+				return ec.fieldContext_NewsItem_pinned(ctx, field)
+			case "show":
+				return ec.fieldContext_NewsItem_show(ctx, field)
+			case "private":
+				return ec.fieldContext_NewsItem_private(ctx, field)
+			case "showLikes":
+				return ec.fieldContext_NewsItem_showLikes(ctx, field)
+			case "showComments":
+				return ec.fieldContext_NewsItem_showComments(ctx, field)
+			case "asUnion":
+				return ec.fieldContext_NewsItem_asUnion(ctx, field)
+			case "commentCount":
+				return ec.fieldContext_NewsItem_commentCount(ctx, field)
+			case "likedBy":
+				return ec.fieldContext_NewsItem_likedBy(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type NewsItem", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createNews_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_deleteNews(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_deleteNews(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteNews(rctx, fc.Args["unionID"].(primitive.ObjectID), fc.Args["newsID"].(primitive.ObjectID))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_deleteNews(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_deleteNews_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_addComment(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_addComment(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().AddComment(rctx, fc.Args["unionID"].(primitive.ObjectID), fc.Args["newsID"].(primitive.ObjectID), fc.Args["comment"].(model.Comment))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3720,6 +4756,8 @@ func (ec *executionContext) fieldContext_Mutation_addComment(ctx context.Context
 			switch field.Name {
 			case "id":
 				return ec.fieldContext_Comment_id(ctx, field)
+			case "newsID":
+				return ec.fieldContext_Comment_newsID(ctx, field)
 			case "content":
 				return ec.fieldContext_Comment_content(ctx, field)
 			case "createdOn":
@@ -3794,6 +4832,8 @@ func (ec *executionContext) fieldContext_Mutation_likeNewsItem(ctx context.Conte
 				return ec.fieldContext_NewsItem_createdOn(ctx, field)
 			case "unit":
 				return ec.fieldContext_NewsItem_unit(ctx, field)
+			case "units":
+				return ec.fieldContext_NewsItem_units(ctx, field)
 			case "creator":
 				return ec.fieldContext_NewsItem_creator(ctx, field)
 			case "userID":
@@ -3880,6 +4920,8 @@ func (ec *executionContext) fieldContext_Mutation_likeComment(ctx context.Contex
 			switch field.Name {
 			case "id":
 				return ec.fieldContext_Comment_id(ctx, field)
+			case "newsID":
+				return ec.fieldContext_Comment_newsID(ctx, field)
 			case "content":
 				return ec.fieldContext_Comment_content(ctx, field)
 			case "createdOn":
@@ -4006,6 +5048,8 @@ func (ec *executionContext) fieldContext_Mutation_pinNewsPost(ctx context.Contex
 				return ec.fieldContext_NewsItem_createdOn(ctx, field)
 			case "unit":
 				return ec.fieldContext_NewsItem_unit(ctx, field)
+			case "units":
+				return ec.fieldContext_NewsItem_units(ctx, field)
 			case "creator":
 				return ec.fieldContext_NewsItem_creator(ctx, field)
 			case "userID":
@@ -4098,6 +5142,8 @@ func (ec *executionContext) fieldContext_Mutation_showPin(ctx context.Context, f
 				return ec.fieldContext_NewsItem_createdOn(ctx, field)
 			case "unit":
 				return ec.fieldContext_NewsItem_unit(ctx, field)
+			case "units":
+				return ec.fieldContext_NewsItem_units(ctx, field)
 			case "creator":
 				return ec.fieldContext_NewsItem_creator(ctx, field)
 			case "userID":
@@ -4190,6 +5236,8 @@ func (ec *executionContext) fieldContext_Mutation_makePrivate(ctx context.Contex
 				return ec.fieldContext_NewsItem_createdOn(ctx, field)
 			case "unit":
 				return ec.fieldContext_NewsItem_unit(ctx, field)
+			case "units":
+				return ec.fieldContext_NewsItem_units(ctx, field)
 			case "creator":
 				return ec.fieldContext_NewsItem_creator(ctx, field)
 			case "userID":
@@ -4282,6 +5330,8 @@ func (ec *executionContext) fieldContext_Mutation_likeButtonToggle(ctx context.C
 				return ec.fieldContext_NewsItem_createdOn(ctx, field)
 			case "unit":
 				return ec.fieldContext_NewsItem_unit(ctx, field)
+			case "units":
+				return ec.fieldContext_NewsItem_units(ctx, field)
 			case "creator":
 				return ec.fieldContext_NewsItem_creator(ctx, field)
 			case "userID":
@@ -4374,6 +5424,8 @@ func (ec *executionContext) fieldContext_Mutation_commentButtonToggle(ctx contex
 				return ec.fieldContext_NewsItem_createdOn(ctx, field)
 			case "unit":
 				return ec.fieldContext_NewsItem_unit(ctx, field)
+			case "units":
+				return ec.fieldContext_NewsItem_units(ctx, field)
 			case "creator":
 				return ec.fieldContext_NewsItem_creator(ctx, field)
 			case "userID":
@@ -4666,8 +5718,8 @@ func (ec *executionContext) fieldContext_Mutation_updateBlogPost(ctx context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _News_id(ctx context.Context, field graphql.CollectedField, obj *model.News) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_News_id(ctx, field)
+func (ec *executionContext) _Mutation_anonymiseMemberContent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_anonymiseMemberContent(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4680,7 +5732,7 @@ func (ec *executionContext) _News_id(ctx context.Context, field graphql.Collecte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return ec.resolvers.Mutation().AnonymiseMemberContent(rctx, fc.Args["input"].(model.AnonymiseMemberInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4689,26 +5741,45 @@ func (ec *executionContext) _News_id(ctx context.Context, field graphql.Collecte
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(primitive.ObjectID)
+	res := resTmp.(*model.AnonymiseReport)
 	fc.Result = res
-	return ec.marshalOObjectID2goᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx, field.Selections, res)
+	return ec.marshalOAnonymiseReport2ᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐAnonymiseReport(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_News_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_anonymiseMemberContent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "News",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ObjectID does not have child fields")
+			switch field.Name {
+			case "news":
+				return ec.fieldContext_AnonymiseReport_news(ctx, field)
+			case "comments":
+				return ec.fieldContext_AnonymiseReport_comments(ctx, field)
+			case "likes":
+				return ec.fieldContext_AnonymiseReport_likes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AnonymiseReport", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_anonymiseMemberContent_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _News_content(ctx context.Context, field graphql.CollectedField, obj *model.News) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_News_content(ctx, field)
+func (ec *executionContext) _Mutation_purgeDeletedContent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_purgeDeletedContent(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4721,23 +5792,129 @@ func (ec *executionContext) _News_content(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Content, nil
+		return ec.resolvers.Mutation().PurgeDeletedContent(rctx, fc.Args["unionID"].(primitive.ObjectID), fc.Args["dryRun"].(bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.PurgeReport)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNPurgeReport2ᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐPurgeReport(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_News_content(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_purgeDeletedContent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "News",
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "unionID":
+				return ec.fieldContext_PurgeReport_unionID(ctx, field)
+			case "dryRun":
+				return ec.fieldContext_PurgeReport_dryRun(ctx, field)
+			case "ranAt":
+				return ec.fieldContext_PurgeReport_ranAt(ctx, field)
+			case "items":
+				return ec.fieldContext_PurgeReport_items(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PurgeReport", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_purgeDeletedContent_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _News_id(ctx context.Context, field graphql.CollectedField, obj *model.News) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_News_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(primitive.ObjectID)
+	fc.Result = res
+	return ec.marshalOObjectID2goᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_News_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "News",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ObjectID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _News_content(ctx context.Context, field graphql.CollectedField, obj *model.News) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_News_content(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Content, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_News_content(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "News",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4830,6 +6007,51 @@ func (ec *executionContext) fieldContext_News_unit(_ context.Context, field grap
 	return fc, nil
 }
 
+func (ec *executionContext) _News_units(ctx context.Context, field graphql.CollectedField, obj *model.News) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_News_units(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Units(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model1.BargainingUnit)
+	fc.Result = res
+	return ec.marshalOBargainingUnit2ᚕᚖyounifiedᚑbackendᚋcontractsᚋunionᚋmodelᚐBargainingUnit(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_News_units(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "News",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_BargainingUnit_id(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BargainingUnit", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _News_creator(ctx context.Context, field graphql.CollectedField, obj *model.News) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_News_creator(ctx, field)
 	if err != nil {
@@ -4844,7 +6066,7 @@ func (ec *executionContext) _News_creator(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Creator, nil
+		return obj.Creator(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4853,43 +6075,21 @@ func (ec *executionContext) _News_creator(ctx context.Context, field graphql.Col
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(model1.User)
+	res := resTmp.(*model2.User)
 	fc.Result = res
-	return ec.marshalOUser2younifiedᚑbackendᚋcontractsᚋuserᚋmodelᚐUser(ctx, field.Selections, res)
+	return ec.marshalOUser2ᚖyounifiedᚑbackendᚋcontractsᚋuserᚋmodelᚐUser(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_News_creator(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "News",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
 				return ec.fieldContext_User_id(ctx, field)
-			case "unionID":
-				return ec.fieldContext_User_unionID(ctx, field)
-			case "deleted":
-				return ec.fieldContext_User_deleted(ctx, field)
-			case "username":
-				return ec.fieldContext_User_username(ctx, field)
-			case "password":
-				return ec.fieldContext_User_password(ctx, field)
-			case "firstName":
-				return ec.fieldContext_User_firstName(ctx, field)
-			case "lastName":
-				return ec.fieldContext_User_lastName(ctx, field)
-			case "middleName":
-				return ec.fieldContext_User_middleName(ctx, field)
-			case "maidenName":
-				return ec.fieldContext_User_maidenName(ctx, field)
-			case "commonName":
-				return ec.fieldContext_User_commonName(ctx, field)
-			case "gender":
-				return ec.fieldContext_User_gender(ctx, field)
-			case "profile":
-				return ec.fieldContext_User_profile(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
@@ -5184,6 +6384,51 @@ func (ec *executionContext) fieldContext_NewsItem_unit(_ context.Context, field
 	return fc, nil
 }
 
+func (ec *executionContext) _NewsItem_units(ctx context.Context, field graphql.CollectedField, obj *model.News) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NewsItem_units(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Units(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model1.BargainingUnit)
+	fc.Result = res
+	return ec.marshalOBargainingUnit2ᚕᚖyounifiedᚑbackendᚋcontractsᚋunionᚋmodelᚐBargainingUnit(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_NewsItem_units(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NewsItem",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_BargainingUnit_id(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BargainingUnit", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _NewsItem_creator(ctx context.Context, field graphql.CollectedField, obj *model.News) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_NewsItem_creator(ctx, field)
 	if err != nil {
@@ -5198,7 +6443,7 @@ func (ec *executionContext) _NewsItem_creator(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Creator, nil
+		return obj.Creator(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5207,43 +6452,21 @@ func (ec *executionContext) _NewsItem_creator(ctx context.Context, field graphql
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(model1.User)
+	res := resTmp.(*model2.User)
 	fc.Result = res
-	return ec.marshalOUser2younifiedᚑbackendᚋcontractsᚋuserᚋmodelᚐUser(ctx, field.Selections, res)
+	return ec.marshalOUser2ᚖyounifiedᚑbackendᚋcontractsᚋuserᚋmodelᚐUser(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_NewsItem_creator(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "NewsItem",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
 				return ec.fieldContext_User_id(ctx, field)
-			case "unionID":
-				return ec.fieldContext_User_unionID(ctx, field)
-			case "deleted":
-				return ec.fieldContext_User_deleted(ctx, field)
-			case "username":
-				return ec.fieldContext_User_username(ctx, field)
-			case "password":
-				return ec.fieldContext_User_password(ctx, field)
-			case "firstName":
-				return ec.fieldContext_User_firstName(ctx, field)
-			case "lastName":
-				return ec.fieldContext_User_lastName(ctx, field)
-			case "middleName":
-				return ec.fieldContext_User_middleName(ctx, field)
-			case "maidenName":
-				return ec.fieldContext_User_maidenName(ctx, field)
-			case "commonName":
-				return ec.fieldContext_User_commonName(ctx, field)
-			case "gender":
-				return ec.fieldContext_User_gender(ctx, field)
-			case "profile":
-				return ec.fieldContext_User_profile(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
@@ -5412,6 +6635,8 @@ func (ec *executionContext) fieldContext_NewsItem_comments(_ context.Context, fi
 			switch field.Name {
 			case "id":
 				return ec.fieldContext_Comment_id(ctx, field)
+			case "newsID":
+				return ec.fieldContext_Comment_newsID(ctx, field)
 			case "content":
 				return ec.fieldContext_Comment_content(ctx, field)
 			case "createdOn":
@@ -5820,7 +7045,7 @@ func (ec *executionContext) _NewsItem_likedBy(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LikedBy, nil
+		return obj.LikedBy(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5829,43 +7054,21 @@ func (ec *executionContext) _NewsItem_likedBy(ctx context.Context, field graphql
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]model1.User)
+	res := resTmp.([]*model2.User)
 	fc.Result = res
-	return ec.marshalOUser2ᚕyounifiedᚑbackendᚋcontractsᚋuserᚋmodelᚐUser(ctx, field.Selections, res)
+	return ec.marshalOUser2ᚕᚖyounifiedᚑbackendᚋcontractsᚋuserᚋmodelᚐUser(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_NewsItem_likedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "NewsItem",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
 				return ec.fieldContext_User_id(ctx, field)
-			case "unionID":
-				return ec.fieldContext_User_unionID(ctx, field)
-			case "deleted":
-				return ec.fieldContext_User_deleted(ctx, field)
-			case "username":
-				return ec.fieldContext_User_username(ctx, field)
-			case "password":
-				return ec.fieldContext_User_password(ctx, field)
-			case "firstName":
-				return ec.fieldContext_User_firstName(ctx, field)
-			case "lastName":
-				return ec.fieldContext_User_lastName(ctx, field)
-			case "middleName":
-				return ec.fieldContext_User_middleName(ctx, field)
-			case "maidenName":
-				return ec.fieldContext_User_maidenName(ctx, field)
-			case "commonName":
-				return ec.fieldContext_User_commonName(ctx, field)
-			case "gender":
-				return ec.fieldContext_User_gender(ctx, field)
-			case "profile":
-				return ec.fieldContext_User_profile(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
@@ -5917,6 +7120,8 @@ func (ec *executionContext) fieldContext_NewsReport_data(_ context.Context, fiel
 				return ec.fieldContext_NewsItem_createdOn(ctx, field)
 			case "unit":
 				return ec.fieldContext_NewsItem_unit(ctx, field)
+			case "units":
+				return ec.fieldContext_NewsItem_units(ctx, field)
 			case "creator":
 				return ec.fieldContext_NewsItem_creator(ctx, field)
 			case "userID":
@@ -5995,8 +7200,8 @@ func (ec *executionContext) fieldContext_NewsReport_total(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getAllNewsPosts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getAllNewsPosts(ctx, field)
+func (ec *executionContext) _PublicBlog_id(ctx context.Context, field graphql.CollectedField, obj *model.PublicBlog) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PublicBlog_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6009,52 +7214,79 @@ func (ec *executionContext) _Query_getAllNewsPosts(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetAllNewsPosts(rctx, fc.Args["unionID"].(primitive.ObjectID), fc.Args["page"].(int), fc.Args["limit"].(int))
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Report)
+	res := resTmp.(primitive.ObjectID)
 	fc.Result = res
-	return ec.marshalONewsReport2ᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐReport(ctx, field.Selections, res)
+	return ec.marshalNObjectID2goᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getAllNewsPosts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PublicBlog_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PublicBlog",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "data":
-				return ec.fieldContext_NewsReport_data(ctx, field)
-			case "total":
-				return ec.fieldContext_NewsReport_total(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type NewsReport", field.Name)
+			return nil, errors.New("field of type ObjectID does not have child fields")
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PublicBlog_header(ctx context.Context, field graphql.CollectedField, obj *model.PublicBlog) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PublicBlog_header(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getAllNewsPosts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Header, nil
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return fc, err
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PublicBlog_header(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PublicBlog",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getComments(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getComments(ctx, field)
+func (ec *executionContext) _PublicBlog_subHeader(ctx context.Context, field graphql.CollectedField, obj *model.PublicBlog) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PublicBlog_subHeader(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6067,7 +7299,7 @@ func (ec *executionContext) _Query_getComments(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetComments(rctx, fc.Args["unionID"].(primitive.ObjectID), fc.Args["newsID"].(primitive.ObjectID), fc.Args["page"].(int), fc.Args["limit"].(int))
+		return obj.SubHeader, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6076,53 +7308,108 @@ func (ec *executionContext) _Query_getComments(ctx context.Context, field graphq
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Comment)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOComment2ᚕᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐComment(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getComments(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PublicBlog_subHeader(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PublicBlog",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Comment_id(ctx, field)
-			case "content":
-				return ec.fieldContext_Comment_content(ctx, field)
-			case "createdOn":
-				return ec.fieldContext_Comment_createdOn(ctx, field)
-			case "userID":
-				return ec.fieldContext_Comment_userID(ctx, field)
-			case "likes":
-				return ec.fieldContext_Comment_likes(ctx, field)
-			case "dislikes":
-				return ec.fieldContext_Comment_dislikes(ctx, field)
-			case "creator":
-				return ec.fieldContext_Comment_creator(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Comment", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PublicBlog_content(ctx context.Context, field graphql.CollectedField, obj *model.PublicBlog) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PublicBlog_content(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Content, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PublicBlog_content(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PublicBlog",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PublicBlog_images(ctx context.Context, field graphql.CollectedField, obj *model.PublicBlog) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PublicBlog_images(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getComments_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Images, nil
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return fc, err
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalOString2ᚕstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PublicBlog_images(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PublicBlog",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getBlogPosts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getBlogPosts(ctx, field)
+func (ec *executionContext) _PublicBlog_createdOn(ctx context.Context, field graphql.CollectedField, obj *model.PublicBlog) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PublicBlog_createdOn(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6135,7 +7422,7 @@ func (ec *executionContext) _Query_getBlogPosts(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetBlogPosts(rctx)
+		return obj.CreatedOn, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6144,44 +7431,86 @@ func (ec *executionContext) _Query_getBlogPosts(ctx context.Context, field graph
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Blog)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOBlog2ᚕᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐBlog(ctx, field.Selections, res)
+	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getBlogPosts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PublicBlog_createdOn(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PublicBlog",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PublicContent_pinned(ctx context.Context, field graphql.CollectedField, obj *model.PublicContent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PublicContent_pinned(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Pinned, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.PublicNews)
+	fc.Result = res
+	return ec.marshalNPublicNews2ᚕᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐPublicNewsᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PublicContent_pinned(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PublicContent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Blog_id(ctx, field)
-			case "header":
-				return ec.fieldContext_Blog_header(ctx, field)
-			case "subHeader":
-				return ec.fieldContext_Blog_subHeader(ctx, field)
+				return ec.fieldContext_PublicNews_id(ctx, field)
 			case "content":
-				return ec.fieldContext_Blog_content(ctx, field)
+				return ec.fieldContext_PublicNews_content(ctx, field)
 			case "images":
-				return ec.fieldContext_Blog_images(ctx, field)
+				return ec.fieldContext_PublicNews_images(ctx, field)
+			case "documents":
+				return ec.fieldContext_PublicNews_documents(ctx, field)
+			case "category":
+				return ec.fieldContext_PublicNews_category(ctx, field)
+			case "pinned":
+				return ec.fieldContext_PublicNews_pinned(ctx, field)
 			case "createdOn":
-				return ec.fieldContext_Blog_createdOn(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Blog_createdBy(ctx, field)
-			case "featured":
-				return ec.fieldContext_Blog_featured(ctx, field)
+				return ec.fieldContext_PublicNews_createdOn(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Blog", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PublicNews", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getOneBlogPost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getOneBlogPost(ctx, field)
+func (ec *executionContext) _PublicContent_news(ctx context.Context, field graphql.CollectedField, obj *model.PublicContent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PublicContent_news(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6194,64 +7523,112 @@ func (ec *executionContext) _Query_getOneBlogPost(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetOneBlogPost(rctx, fc.Args["blogID"].(primitive.ObjectID))
+		return obj.News, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Blog)
+	res := resTmp.([]*model.PublicNews)
 	fc.Result = res
-	return ec.marshalOBlog2ᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐBlog(ctx, field.Selections, res)
+	return ec.marshalNPublicNews2ᚕᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐPublicNewsᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getOneBlogPost(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PublicContent_news(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PublicContent",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Blog_id(ctx, field)
-			case "header":
-				return ec.fieldContext_Blog_header(ctx, field)
-			case "subHeader":
-				return ec.fieldContext_Blog_subHeader(ctx, field)
+				return ec.fieldContext_PublicNews_id(ctx, field)
 			case "content":
-				return ec.fieldContext_Blog_content(ctx, field)
+				return ec.fieldContext_PublicNews_content(ctx, field)
 			case "images":
-				return ec.fieldContext_Blog_images(ctx, field)
+				return ec.fieldContext_PublicNews_images(ctx, field)
+			case "documents":
+				return ec.fieldContext_PublicNews_documents(ctx, field)
+			case "category":
+				return ec.fieldContext_PublicNews_category(ctx, field)
+			case "pinned":
+				return ec.fieldContext_PublicNews_pinned(ctx, field)
 			case "createdOn":
-				return ec.fieldContext_Blog_createdOn(ctx, field)
-			case "createdBy":
-				return ec.fieldContext_Blog_createdBy(ctx, field)
-			case "featured":
-				return ec.fieldContext_Blog_featured(ctx, field)
+				return ec.fieldContext_PublicNews_createdOn(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Blog", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PublicNews", field.Name)
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PublicContent_blogs(ctx context.Context, field graphql.CollectedField, obj *model.PublicContent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PublicContent_blogs(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getOneBlogPost_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Blogs, nil
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return fc, err
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.PublicBlog)
+	fc.Result = res
+	return ec.marshalNPublicBlog2ᚕᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐPublicBlogᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PublicContent_blogs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PublicContent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_PublicBlog_id(ctx, field)
+			case "header":
+				return ec.fieldContext_PublicBlog_header(ctx, field)
+			case "subHeader":
+				return ec.fieldContext_PublicBlog_subHeader(ctx, field)
+			case "content":
+				return ec.fieldContext_PublicBlog_content(ctx, field)
+			case "images":
+				return ec.fieldContext_PublicBlog_images(ctx, field)
+			case "createdOn":
+				return ec.fieldContext_PublicBlog_createdOn(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PublicBlog", field.Name)
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query__service(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query__service(ctx, field)
+func (ec *executionContext) _PublicNews_id(ctx context.Context, field graphql.CollectedField, obj *model.PublicNews) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PublicNews_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6264,7 +7641,7 @@ func (ec *executionContext) _Query__service(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.__resolve__service(ctx)
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6276,30 +7653,26 @@ func (ec *executionContext) _Query__service(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(fedruntime.Service)
+	res := resTmp.(primitive.ObjectID)
 	fc.Result = res
-	return ec.marshalN_Service2githubᚗcomᚋ99designsᚋgqlgenᚋpluginᚋfederationᚋfedruntimeᚐService(ctx, field.Selections, res)
+	return ec.marshalNObjectID2goᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query__service(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PublicNews_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PublicNews",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "sdl":
-				return ec.fieldContext__Service_sdl(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type _Service", field.Name)
+			return nil, errors.New("field of type ObjectID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___type(ctx, field)
+func (ec *executionContext) _PublicNews_content(ctx context.Context, field graphql.CollectedField, obj *model.PublicNews) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PublicNews_content(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6312,7 +7685,7 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(fc.Args["name"].(string))
+		return obj.Content, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6321,59 +7694,67 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PublicNews_content(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PublicNews",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PublicNews_images(ctx context.Context, field graphql.CollectedField, obj *model.PublicNews) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PublicNews_images(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Images, nil
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return fc, err
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalOString2ᚕstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PublicNews_images(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PublicNews",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___schema(ctx, field)
+func (ec *executionContext) _PublicNews_documents(ctx context.Context, field graphql.CollectedField, obj *model.PublicNews) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PublicNews_documents(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6386,7 +7767,7 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return obj.Documents, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6395,40 +7776,32 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.([]*model.Document)
 	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalONewsDocument2ᚕᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐDocument(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PublicNews_documents(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PublicNews",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
+			case "url":
+				return ec.fieldContext_NewsDocument_url(ctx, field)
+			case "name":
+				return ec.fieldContext_NewsDocument_name(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type NewsDocument", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_id(ctx context.Context, field graphql.CollectedField, obj *model1.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_id(ctx, field)
+func (ec *executionContext) _PublicNews_category(ctx context.Context, field graphql.CollectedField, obj *model.PublicNews) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PublicNews_category(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6441,7 +7814,7 @@ func (ec *executionContext) _User_id(ctx context.Context, field graphql.Collecte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Category, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6450,26 +7823,26 @@ func (ec *executionContext) _User_id(ctx context.Context, field graphql.Collecte
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(primitive.ObjectID)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOObjectID2goᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PublicNews_category(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "PublicNews",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ObjectID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_unionID(ctx context.Context, field graphql.CollectedField, obj *model1.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_unionID(ctx, field)
+func (ec *executionContext) _PublicNews_pinned(ctx context.Context, field graphql.CollectedField, obj *model.PublicNews) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PublicNews_pinned(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6482,35 +7855,38 @@ func (ec *executionContext) _User_unionID(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UnionID, nil
+		return obj.Pinned, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(primitive.ObjectID)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOObjectID2goᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_unionID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PublicNews_pinned(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "PublicNews",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ObjectID does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_deleted(ctx context.Context, field graphql.CollectedField, obj *model1.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_deleted(ctx, field)
+func (ec *executionContext) _PublicNews_createdOn(ctx context.Context, field graphql.CollectedField, obj *model.PublicNews) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PublicNews_createdOn(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6523,7 +7899,7 @@ func (ec *executionContext) _User_deleted(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Deleted, nil
+		return obj.CreatedOn, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6532,26 +7908,26 @@ func (ec *executionContext) _User_deleted(ctx context.Context, field graphql.Col
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_deleted(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PublicNews_createdOn(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "PublicNews",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_username(ctx context.Context, field graphql.CollectedField, obj *model1.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_username(ctx, field)
+func (ec *executionContext) _PurgeItem_collection(ctx context.Context, field graphql.CollectedField, obj *model.PurgeItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurgeItem_collection(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6564,23 +7940,26 @@ func (ec *executionContext) _User_username(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Username, nil
+		return obj.Collection, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_username(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PurgeItem_collection(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "PurgeItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6591,8 +7970,8 @@ func (ec *executionContext) fieldContext_User_username(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _User_password(ctx context.Context, field graphql.CollectedField, obj *model1.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_password(ctx, field)
+func (ec *executionContext) _PurgeItem_retentionDays(ctx context.Context, field graphql.CollectedField, obj *model.PurgeItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurgeItem_retentionDays(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6605,35 +7984,123 @@ func (ec *executionContext) _User_password(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Password, nil
+		return obj.RetentionDays, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PurgeItem_retentionDays(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PurgeItem",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PurgeItem_cutoff(ctx context.Context, field graphql.CollectedField, obj *model.PurgeItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurgeItem_cutoff(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cutoff, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PurgeItem_cutoff(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PurgeItem",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PurgeItem_matched(ctx context.Context, field graphql.CollectedField, obj *model.PurgeItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurgeItem_matched(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Matched, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_password(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PurgeItem_matched(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "PurgeItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_firstName(ctx context.Context, field graphql.CollectedField, obj *model1.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_firstName(ctx, field)
+func (ec *executionContext) _PurgeItem_purged(ctx context.Context, field graphql.CollectedField, obj *model.PurgeItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurgeItem_purged(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6646,35 +8113,38 @@ func (ec *executionContext) _User_firstName(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FirstName, nil
+		return obj.Purged, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_firstName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PurgeItem_purged(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "PurgeItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_lastName(ctx context.Context, field graphql.CollectedField, obj *model1.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_lastName(ctx, field)
+func (ec *executionContext) _PurgeItem_mediaDeleted(ctx context.Context, field graphql.CollectedField, obj *model.PurgeItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurgeItem_mediaDeleted(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6687,35 +8157,38 @@ func (ec *executionContext) _User_lastName(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LastName, nil
+		return obj.MediaDeleted, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_lastName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PurgeItem_mediaDeleted(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "PurgeItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_middleName(ctx context.Context, field graphql.CollectedField, obj *model1.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_middleName(ctx, field)
+func (ec *executionContext) _PurgeReport_unionID(ctx context.Context, field graphql.CollectedField, obj *model.PurgeReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurgeReport_unionID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6728,35 +8201,38 @@ func (ec *executionContext) _User_middleName(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MiddleName, nil
+		return obj.UnionID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(primitive.ObjectID)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNObjectID2goᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_middleName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PurgeReport_unionID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "PurgeReport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ObjectID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_maidenName(ctx context.Context, field graphql.CollectedField, obj *model1.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_maidenName(ctx, field)
+func (ec *executionContext) _PurgeReport_dryRun(ctx context.Context, field graphql.CollectedField, obj *model.PurgeReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurgeReport_dryRun(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6769,35 +8245,38 @@ func (ec *executionContext) _User_maidenName(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MaidenName, nil
+		return obj.DryRun, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_maidenName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PurgeReport_dryRun(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "PurgeReport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_commonName(ctx context.Context, field graphql.CollectedField, obj *model1.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_commonName(ctx, field)
+func (ec *executionContext) _PurgeReport_ranAt(ctx context.Context, field graphql.CollectedField, obj *model.PurgeReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurgeReport_ranAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6810,7 +8289,7 @@ func (ec *executionContext) _User_commonName(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CommonName, nil
+		return obj.RanAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6819,26 +8298,26 @@ func (ec *executionContext) _User_commonName(ctx context.Context, field graphql.
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_commonName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PurgeReport_ranAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "PurgeReport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_gender(ctx context.Context, field graphql.CollectedField, obj *model1.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_gender(ctx, field)
+func (ec *executionContext) _PurgeReport_items(ctx context.Context, field graphql.CollectedField, obj *model.PurgeReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PurgeReport_items(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6851,35 +8330,52 @@ func (ec *executionContext) _User_gender(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Gender, nil
+		return obj.Items, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.PurgeItem)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNPurgeItem2ᚕᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐPurgeItemᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_gender(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PurgeReport_items(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "PurgeReport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "collection":
+				return ec.fieldContext_PurgeItem_collection(ctx, field)
+			case "retentionDays":
+				return ec.fieldContext_PurgeItem_retentionDays(ctx, field)
+			case "cutoff":
+				return ec.fieldContext_PurgeItem_cutoff(ctx, field)
+			case "matched":
+				return ec.fieldContext_PurgeItem_matched(ctx, field)
+			case "purged":
+				return ec.fieldContext_PurgeItem_purged(ctx, field)
+			case "mediaDeleted":
+				return ec.fieldContext_PurgeItem_mediaDeleted(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PurgeItem", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_profile(ctx context.Context, field graphql.CollectedField, obj *model1.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_profile(ctx, field)
+func (ec *executionContext) _Query_getAllNewsPosts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getAllNewsPosts(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6892,7 +8388,7 @@ func (ec *executionContext) _User_profile(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Profile, nil
+		return ec.resolvers.Query().GetAllNewsPosts(rctx, fc.Args["unionID"].(primitive.ObjectID), fc.Args["page"].(int), fc.Args["limit"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6901,48 +8397,43 @@ func (ec *executionContext) _User_profile(ctx context.Context, field graphql.Col
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(model1.UserInfo)
+	res := resTmp.(*model.Report)
 	fc.Result = res
-	return ec.marshalOUserInfo2younifiedᚑbackendᚋcontractsᚋuserᚋmodelᚐUserInfo(ctx, field.Selections, res)
+	return ec.marshalONewsReport2ᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐReport(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_profile(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getAllNewsPosts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "email":
-				return ec.fieldContext_UserInfo_email(ctx, field)
-			case "unionMail":
-				return ec.fieldContext_UserInfo_unionMail(ctx, field)
-			case "imageURL":
-				return ec.fieldContext_UserInfo_imageURL(ctx, field)
-			case "address":
-				return ec.fieldContext_UserInfo_address(ctx, field)
-			case "city":
-				return ec.fieldContext_UserInfo_city(ctx, field)
-			case "province":
-				return ec.fieldContext_UserInfo_province(ctx, field)
-			case "postalCode":
-				return ec.fieldContext_UserInfo_postalCode(ctx, field)
-			case "phone":
-				return ec.fieldContext_UserInfo_phone(ctx, field)
-			case "mobile":
-				return ec.fieldContext_UserInfo_mobile(ctx, field)
-			case "description":
-				return ec.fieldContext_UserInfo_description(ctx, field)
+			case "data":
+				return ec.fieldContext_NewsReport_data(ctx, field)
+			case "total":
+				return ec.fieldContext_NewsReport_total(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type UserInfo", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type NewsReport", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getAllNewsPosts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserInfo_email(ctx context.Context, field graphql.CollectedField, obj *model1.UserInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_UserInfo_email(ctx, field)
+func (ec *executionContext) _Query_getComments(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getComments(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6955,7 +8446,7 @@ func (ec *executionContext) _UserInfo_email(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Email, nil
+		return ec.resolvers.Query().GetComments(rctx, fc.Args["unionID"].(primitive.ObjectID), fc.Args["newsID"].(primitive.ObjectID), fc.Args["page"].(int), fc.Args["limit"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6964,26 +8455,55 @@ func (ec *executionContext) _UserInfo_email(ctx context.Context, field graphql.C
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.Comment)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalOComment2ᚕᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐComment(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_UserInfo_email(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getComments(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserInfo",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Comment_id(ctx, field)
+			case "newsID":
+				return ec.fieldContext_Comment_newsID(ctx, field)
+			case "content":
+				return ec.fieldContext_Comment_content(ctx, field)
+			case "createdOn":
+				return ec.fieldContext_Comment_createdOn(ctx, field)
+			case "userID":
+				return ec.fieldContext_Comment_userID(ctx, field)
+			case "likes":
+				return ec.fieldContext_Comment_likes(ctx, field)
+			case "dislikes":
+				return ec.fieldContext_Comment_dislikes(ctx, field)
+			case "creator":
+				return ec.fieldContext_Comment_creator(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Comment", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getComments_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserInfo_unionMail(ctx context.Context, field graphql.CollectedField, obj *model1.UserInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_UserInfo_unionMail(ctx, field)
+func (ec *executionContext) _Query_getBlogPosts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getBlogPosts(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6996,7 +8516,7 @@ func (ec *executionContext) _UserInfo_unionMail(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UnionMail, nil
+		return ec.resolvers.Query().GetBlogPosts(rctx, fc.Args["unionID"].(primitive.ObjectID))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7005,26 +8525,55 @@ func (ec *executionContext) _UserInfo_unionMail(ctx context.Context, field graph
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.Blog)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalOBlog2ᚕᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐBlog(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_UserInfo_unionMail(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getBlogPosts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserInfo",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Blog_id(ctx, field)
+			case "header":
+				return ec.fieldContext_Blog_header(ctx, field)
+			case "subHeader":
+				return ec.fieldContext_Blog_subHeader(ctx, field)
+			case "content":
+				return ec.fieldContext_Blog_content(ctx, field)
+			case "images":
+				return ec.fieldContext_Blog_images(ctx, field)
+			case "createdOn":
+				return ec.fieldContext_Blog_createdOn(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Blog_createdBy(ctx, field)
+			case "featured":
+				return ec.fieldContext_Blog_featured(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Blog", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getBlogPosts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserInfo_imageURL(ctx context.Context, field graphql.CollectedField, obj *model1.UserInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_UserInfo_imageURL(ctx, field)
+func (ec *executionContext) _Query_getOneBlogPost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getOneBlogPost(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7037,7 +8586,7 @@ func (ec *executionContext) _UserInfo_imageURL(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ImageURL, nil
+		return ec.resolvers.Query().GetOneBlogPost(rctx, fc.Args["unionID"].(primitive.ObjectID), fc.Args["blogID"].(primitive.ObjectID))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7046,26 +8595,55 @@ func (ec *executionContext) _UserInfo_imageURL(ctx context.Context, field graphq
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Blog)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalOBlog2ᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐBlog(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_UserInfo_imageURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getOneBlogPost(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserInfo",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Blog_id(ctx, field)
+			case "header":
+				return ec.fieldContext_Blog_header(ctx, field)
+			case "subHeader":
+				return ec.fieldContext_Blog_subHeader(ctx, field)
+			case "content":
+				return ec.fieldContext_Blog_content(ctx, field)
+			case "images":
+				return ec.fieldContext_Blog_images(ctx, field)
+			case "createdOn":
+				return ec.fieldContext_Blog_createdOn(ctx, field)
+			case "createdBy":
+				return ec.fieldContext_Blog_createdBy(ctx, field)
+			case "featured":
+				return ec.fieldContext_Blog_featured(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Blog", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getOneBlogPost_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserInfo_address(ctx context.Context, field graphql.CollectedField, obj *model1.UserInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_UserInfo_address(ctx, field)
+func (ec *executionContext) _Query_memberContent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_memberContent(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7078,7 +8656,7 @@ func (ec *executionContext) _UserInfo_address(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Address, nil
+		return ec.resolvers.Query().MemberContent(rctx, fc.Args["unionID"].(primitive.ObjectID), fc.Args["userID"].(primitive.ObjectID))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7087,26 +8665,47 @@ func (ec *executionContext) _UserInfo_address(ctx context.Context, field graphql
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.MemberContent)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalOMemberContent2ᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐMemberContent(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_UserInfo_address(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_memberContent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserInfo",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "news":
+				return ec.fieldContext_MemberContent_news(ctx, field)
+			case "comments":
+				return ec.fieldContext_MemberContent_comments(ctx, field)
+			case "likedNews":
+				return ec.fieldContext_MemberContent_likedNews(ctx, field)
+			case "likedComments":
+				return ec.fieldContext_MemberContent_likedComments(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MemberContent", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_memberContent_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserInfo_city(ctx context.Context, field graphql.CollectedField, obj *model1.UserInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_UserInfo_city(ctx, field)
+func (ec *executionContext) _Query_publicContent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_publicContent(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7119,35 +8718,57 @@ func (ec *executionContext) _UserInfo_city(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.City, nil
+		return ec.resolvers.Query().PublicContent(rctx, fc.Args["unionID"].(primitive.ObjectID), fc.Args["newsLimit"].(*int), fc.Args["blogLimit"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.PublicContent)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNPublicContent2ᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐPublicContent(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_UserInfo_city(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_publicContent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserInfo",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "pinned":
+				return ec.fieldContext_PublicContent_pinned(ctx, field)
+			case "news":
+				return ec.fieldContext_PublicContent_news(ctx, field)
+			case "blogs":
+				return ec.fieldContext_PublicContent_blogs(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PublicContent", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_publicContent_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserInfo_province(ctx context.Context, field graphql.CollectedField, obj *model1.UserInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_UserInfo_province(ctx, field)
+func (ec *executionContext) _Query__entities(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query__entities(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7160,35 +8781,49 @@ func (ec *executionContext) _UserInfo_province(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Province, nil
+		return ec.__resolve_entities(ctx, fc.Args["representations"].([]map[string]interface{})), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]fedruntime.Entity)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalN_Entity2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋpluginᚋfederationᚋfedruntimeᚐEntity(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_UserInfo_province(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query__entities(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserInfo",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type _Entity does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query__entities_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserInfo_postalCode(ctx context.Context, field graphql.CollectedField, obj *model1.UserInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_UserInfo_postalCode(ctx, field)
+func (ec *executionContext) _Query__service(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query__service(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7201,35 +8836,42 @@ func (ec *executionContext) _UserInfo_postalCode(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PostalCode, nil
+		return ec.__resolve__service(ctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(fedruntime.Service)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalN_Service2githubᚗcomᚋ99designsᚋgqlgenᚋpluginᚋfederationᚋfedruntimeᚐService(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_UserInfo_postalCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query__service(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserInfo",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "sdl":
+				return ec.fieldContext__Service_sdl(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type _Service", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserInfo_phone(ctx context.Context, field graphql.CollectedField, obj *model1.UserInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_UserInfo_phone(ctx, field)
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7242,7 +8884,7 @@ func (ec *executionContext) _UserInfo_phone(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Phone, nil
+		return ec.introspectType(fc.Args["name"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7251,26 +8893,59 @@ func (ec *executionContext) _UserInfo_phone(ctx context.Context, field graphql.C
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*introspection.Type)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_UserInfo_phone(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserInfo",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserInfo_mobile(ctx context.Context, field graphql.CollectedField, obj *model1.UserInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_UserInfo_mobile(ctx, field)
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___schema(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7283,7 +8958,7 @@ func (ec *executionContext) _UserInfo_mobile(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Mobile, nil
+		return ec.introspectSchema()
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7292,26 +8967,40 @@ func (ec *executionContext) _UserInfo_mobile(ctx context.Context, field graphql.
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*introspection.Schema)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_UserInfo_mobile(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserInfo",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserInfo_description(ctx context.Context, field graphql.CollectedField, obj *model1.UserInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_UserInfo_description(ctx, field)
+func (ec *executionContext) _User_id(ctx context.Context, field graphql.CollectedField, obj *model2.User) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_User_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7324,28 +9013,31 @@ func (ec *executionContext) _UserInfo_description(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(primitive.ObjectID)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNObjectID2goᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_UserInfo_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_User_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserInfo",
+		Object:     "User",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ObjectID does not have child fields")
 		},
 	}
 	return fc, nil
@@ -9165,6 +10857,47 @@ func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context
 
 // region    **************************** input.gotpl *****************************
 
+func (ec *executionContext) unmarshalInputAnonymiseMemberInput(ctx context.Context, obj interface{}) (model.AnonymiseMemberInput, error) {
+	var it model.AnonymiseMemberInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"unionID", "userID", "replacementID"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "unionID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("unionID"))
+			data, err := ec.unmarshalNObjectID2goᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.UnionID = data
+		case "userID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userID"))
+			data, err := ec.unmarshalNObjectID2goᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.UserID = data
+		case "replacementID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("replacementID"))
+			data, err := ec.unmarshalNObjectID2goᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ReplacementID = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputBlogInput(ctx context.Context, obj interface{}) (model.Blog, error) {
 	var it model.Blog
 	asMap := map[string]interface{}{}
@@ -9375,10 +11108,112 @@ func (ec *executionContext) unmarshalInputNewsInput(ctx context.Context, obj int
 
 // region    ************************** interface.gotpl ***************************
 
+func (ec *executionContext) __Entity(ctx context.Context, sel ast.SelectionSet, obj fedruntime.Entity) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model1.BargainingUnit:
+		return ec._BargainingUnit(ctx, sel, &obj)
+	case *model1.BargainingUnit:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._BargainingUnit(ctx, sel, obj)
+	case model2.User:
+		return ec._User(ctx, sel, &obj)
+	case *model2.User:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._User(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
 // endregion ************************** interface.gotpl ***************************
 
 // region    **************************** object.gotpl ****************************
 
+var anonymiseReportImplementors = []string{"AnonymiseReport"}
+
+func (ec *executionContext) _AnonymiseReport(ctx context.Context, sel ast.SelectionSet, obj *model.AnonymiseReport) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, anonymiseReportImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AnonymiseReport")
+		case "news":
+			out.Values[i] = ec._AnonymiseReport_news(ctx, field, obj)
+		case "comments":
+			out.Values[i] = ec._AnonymiseReport_comments(ctx, field, obj)
+		case "likes":
+			out.Values[i] = ec._AnonymiseReport_likes(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var bargainingUnitImplementors = []string{"BargainingUnit", "_Entity"}
+
+func (ec *executionContext) _BargainingUnit(ctx context.Context, sel ast.SelectionSet, obj *model1.BargainingUnit) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, bargainingUnitImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BargainingUnit")
+		case "id":
+			out.Values[i] = ec._BargainingUnit_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var blogImplementors = []string{"Blog"}
 
 func (ec *executionContext) _Blog(ctx context.Context, sel ast.SelectionSet, obj *model.Blog) graphql.Marshaler {
@@ -9442,6 +11277,8 @@ func (ec *executionContext) _Comment(ctx context.Context, sel ast.SelectionSet,
 			out.Values[i] = graphql.MarshalString("Comment")
 		case "id":
 			out.Values[i] = ec._Comment_id(ctx, field, obj)
+		case "newsID":
+			out.Values[i] = ec._Comment_newsID(ctx, field, obj)
 		case "content":
 			out.Values[i] = ec._Comment_content(ctx, field, obj)
 		case "createdOn":
@@ -9477,6 +11314,48 @@ func (ec *executionContext) _Comment(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
+var memberContentImplementors = []string{"MemberContent"}
+
+func (ec *executionContext) _MemberContent(ctx context.Context, sel ast.SelectionSet, obj *model.MemberContent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, memberContentImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MemberContent")
+		case "news":
+			out.Values[i] = ec._MemberContent_news(ctx, field, obj)
+		case "comments":
+			out.Values[i] = ec._MemberContent_comments(ctx, field, obj)
+		case "likedNews":
+			out.Values[i] = ec._MemberContent_likedNews(ctx, field, obj)
+		case "likedComments":
+			out.Values[i] = ec._MemberContent_likedComments(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var mutationImplementors = []string{"Mutation"}
 
 func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
@@ -9556,6 +11435,17 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_updateBlogPost(ctx, field)
 			})
+		case "anonymiseMemberContent":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_anonymiseMemberContent(ctx, field)
+			})
+		case "purgeDeletedContent":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_purgeDeletedContent(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -9598,6 +11488,8 @@ func (ec *executionContext) _News(ctx context.Context, sel ast.SelectionSet, obj
 			out.Values[i] = ec._News_createdOn(ctx, field, obj)
 		case "unit":
 			out.Values[i] = ec._News_unit(ctx, field, obj)
+		case "units":
+			out.Values[i] = ec._News_units(ctx, field, obj)
 		case "creator":
 			out.Values[i] = ec._News_creator(ctx, field, obj)
 		case "userID":
@@ -9682,6 +11574,8 @@ func (ec *executionContext) _NewsItem(ctx context.Context, sel ast.SelectionSet,
 			out.Values[i] = ec._NewsItem_createdOn(ctx, field, obj)
 		case "unit":
 			out.Values[i] = ec._NewsItem_unit(ctx, field, obj)
+		case "units":
+			out.Values[i] = ec._NewsItem_units(ctx, field, obj)
 		case "creator":
 			out.Values[i] = ec._NewsItem_creator(ctx, field, obj)
 		case "userID":
@@ -9773,6 +11667,270 @@ func (ec *executionContext) _NewsReport(ctx context.Context, sel ast.SelectionSe
 	return out
 }
 
+var publicBlogImplementors = []string{"PublicBlog"}
+
+func (ec *executionContext) _PublicBlog(ctx context.Context, sel ast.SelectionSet, obj *model.PublicBlog) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, publicBlogImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PublicBlog")
+		case "id":
+			out.Values[i] = ec._PublicBlog_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "header":
+			out.Values[i] = ec._PublicBlog_header(ctx, field, obj)
+		case "subHeader":
+			out.Values[i] = ec._PublicBlog_subHeader(ctx, field, obj)
+		case "content":
+			out.Values[i] = ec._PublicBlog_content(ctx, field, obj)
+		case "images":
+			out.Values[i] = ec._PublicBlog_images(ctx, field, obj)
+		case "createdOn":
+			out.Values[i] = ec._PublicBlog_createdOn(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var publicContentImplementors = []string{"PublicContent"}
+
+func (ec *executionContext) _PublicContent(ctx context.Context, sel ast.SelectionSet, obj *model.PublicContent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, publicContentImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PublicContent")
+		case "pinned":
+			out.Values[i] = ec._PublicContent_pinned(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "news":
+			out.Values[i] = ec._PublicContent_news(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "blogs":
+			out.Values[i] = ec._PublicContent_blogs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var publicNewsImplementors = []string{"PublicNews"}
+
+func (ec *executionContext) _PublicNews(ctx context.Context, sel ast.SelectionSet, obj *model.PublicNews) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, publicNewsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PublicNews")
+		case "id":
+			out.Values[i] = ec._PublicNews_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "content":
+			out.Values[i] = ec._PublicNews_content(ctx, field, obj)
+		case "images":
+			out.Values[i] = ec._PublicNews_images(ctx, field, obj)
+		case "documents":
+			out.Values[i] = ec._PublicNews_documents(ctx, field, obj)
+		case "category":
+			out.Values[i] = ec._PublicNews_category(ctx, field, obj)
+		case "pinned":
+			out.Values[i] = ec._PublicNews_pinned(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdOn":
+			out.Values[i] = ec._PublicNews_createdOn(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var purgeItemImplementors = []string{"PurgeItem"}
+
+func (ec *executionContext) _PurgeItem(ctx context.Context, sel ast.SelectionSet, obj *model.PurgeItem) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, purgeItemImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PurgeItem")
+		case "collection":
+			out.Values[i] = ec._PurgeItem_collection(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "retentionDays":
+			out.Values[i] = ec._PurgeItem_retentionDays(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cutoff":
+			out.Values[i] = ec._PurgeItem_cutoff(ctx, field, obj)
+		case "matched":
+			out.Values[i] = ec._PurgeItem_matched(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "purged":
+			out.Values[i] = ec._PurgeItem_purged(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "mediaDeleted":
+			out.Values[i] = ec._PurgeItem_mediaDeleted(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var purgeReportImplementors = []string{"PurgeReport"}
+
+func (ec *executionContext) _PurgeReport(ctx context.Context, sel ast.SelectionSet, obj *model.PurgeReport) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, purgeReportImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PurgeReport")
+		case "unionID":
+			out.Values[i] = ec._PurgeReport_unionID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "dryRun":
+			out.Values[i] = ec._PurgeReport_dryRun(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "ranAt":
+			out.Values[i] = ec._PurgeReport_ranAt(ctx, field, obj)
+		case "items":
+			out.Values[i] = ec._PurgeReport_items(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var queryImplementors = []string{"Query"}
 
 func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
@@ -9789,10 +11947,48 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			Field:  field,
 		})
 
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "getAllNewsPosts":
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "getAllNewsPosts":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getAllNewsPosts(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getComments":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getComments(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getBlogPosts":
 			field := field
 
 			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
@@ -9801,7 +11997,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getAllNewsPosts(ctx, field)
+				res = ec._Query_getBlogPosts(ctx, field)
 				return res
 			}
 
@@ -9811,7 +12007,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getComments":
+		case "getOneBlogPost":
 			field := field
 
 			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
@@ -9820,7 +12016,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getComments(ctx, field)
+				res = ec._Query_getOneBlogPost(ctx, field)
 				return res
 			}
 
@@ -9830,7 +12026,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getBlogPosts":
+		case "memberContent":
 			field := field
 
 			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
@@ -9839,7 +12035,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getBlogPosts(ctx, field)
+				res = ec._Query_memberContent(ctx, field)
 				return res
 			}
 
@@ -9849,16 +12045,41 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getOneBlogPost":
+		case "publicContent":
 			field := field
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
 				defer func() {
 					if r := recover(); r != nil {
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getOneBlogPost(ctx, field)
+				res = ec._Query_publicContent(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "_entities":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query__entities(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
 				return res
 			}
 
@@ -9921,9 +12142,9 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 	return out
 }
 
-var userImplementors = []string{"User"}
+var userImplementors = []string{"User", "_Entity"}
 
-func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj *model1.User) graphql.Marshaler {
+func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj *model2.User) graphql.Marshaler {
 	fields := graphql.CollectFields(ec.OperationContext, sel, userImplementors)
 
 	out := graphql.NewFieldSet(fields)
@@ -9934,82 +12155,9 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 			out.Values[i] = graphql.MarshalString("User")
 		case "id":
 			out.Values[i] = ec._User_id(ctx, field, obj)
-		case "unionID":
-			out.Values[i] = ec._User_unionID(ctx, field, obj)
-		case "deleted":
-			out.Values[i] = ec._User_deleted(ctx, field, obj)
-		case "username":
-			out.Values[i] = ec._User_username(ctx, field, obj)
-		case "password":
-			out.Values[i] = ec._User_password(ctx, field, obj)
-		case "firstName":
-			out.Values[i] = ec._User_firstName(ctx, field, obj)
-		case "lastName":
-			out.Values[i] = ec._User_lastName(ctx, field, obj)
-		case "middleName":
-			out.Values[i] = ec._User_middleName(ctx, field, obj)
-		case "maidenName":
-			out.Values[i] = ec._User_maidenName(ctx, field, obj)
-		case "commonName":
-			out.Values[i] = ec._User_commonName(ctx, field, obj)
-		case "gender":
-			out.Values[i] = ec._User_gender(ctx, field, obj)
-		case "profile":
-			out.Values[i] = ec._User_profile(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var userInfoImplementors = []string{"UserInfo"}
-
-func (ec *executionContext) _UserInfo(ctx context.Context, sel ast.SelectionSet, obj *model1.UserInfo) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, userInfoImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("UserInfo")
-		case "email":
-			out.Values[i] = ec._UserInfo_email(ctx, field, obj)
-		case "unionMail":
-			out.Values[i] = ec._UserInfo_unionMail(ctx, field, obj)
-		case "imageURL":
-			out.Values[i] = ec._UserInfo_imageURL(ctx, field, obj)
-		case "address":
-			out.Values[i] = ec._UserInfo_address(ctx, field, obj)
-		case "city":
-			out.Values[i] = ec._UserInfo_city(ctx, field, obj)
-		case "province":
-			out.Values[i] = ec._UserInfo_province(ctx, field, obj)
-		case "postalCode":
-			out.Values[i] = ec._UserInfo_postalCode(ctx, field, obj)
-		case "phone":
-			out.Values[i] = ec._UserInfo_phone(ctx, field, obj)
-		case "mobile":
-			out.Values[i] = ec._UserInfo_mobile(ctx, field, obj)
-		case "description":
-			out.Values[i] = ec._UserInfo_description(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -10372,81 +12520,291 @@ func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, o
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) unmarshalNAnonymiseMemberInput2younifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐAnonymiseMemberInput(ctx context.Context, v interface{}) (model.AnonymiseMemberInput, error) {
+	res, err := ec.unmarshalInputAnonymiseMemberInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNBlogInput2younifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐBlog(ctx context.Context, v interface{}) (model.Blog, error) {
+	res, err := ec.unmarshalInputBlogInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNCommentInput2younifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐComment(ctx context.Context, v interface{}) (model.Comment, error) {
+	res, err := ec.unmarshalInputCommentInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNNewsInput2younifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐNews(ctx context.Context, v interface{}) (model.News, error) {
+	res, err := ec.unmarshalInputNewsInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNObjectID2goᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx context.Context, v interface{}) (primitive.ObjectID, error) {
+	res, err := model3.UnmarshalObjectID(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNObjectID2goᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx context.Context, sel ast.SelectionSet, v primitive.ObjectID) graphql.Marshaler {
+	res := model3.MarshalObjectID(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalNPublicBlog2ᚕᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐPublicBlogᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PublicBlog) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPublicBlog2ᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐPublicBlog(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNPublicBlog2ᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐPublicBlog(ctx context.Context, sel ast.SelectionSet, v *model.PublicBlog) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PublicBlog(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNPublicContent2younifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐPublicContent(ctx context.Context, sel ast.SelectionSet, v model.PublicContent) graphql.Marshaler {
+	return ec._PublicContent(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPublicContent2ᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐPublicContent(ctx context.Context, sel ast.SelectionSet, v *model.PublicContent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PublicContent(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNPublicNews2ᚕᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐPublicNewsᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PublicNews) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPublicNews2ᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐPublicNews(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNPublicNews2ᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐPublicNews(ctx context.Context, sel ast.SelectionSet, v *model.PublicNews) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
-
-	return out
+	return ec._PublicNews(ctx, sel, v)
 }
 
-// endregion **************************** object.gotpl ****************************
+func (ec *executionContext) marshalNPurgeItem2ᚕᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐPurgeItemᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PurgeItem) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPurgeItem2ᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐPurgeItem(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-// region    ***************************** type.gotpl *****************************
+	}
+	wg.Wait()
 
-func (ec *executionContext) unmarshalNBlogInput2younifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐBlog(ctx context.Context, v interface{}) (model.Blog, error) {
-	res, err := ec.unmarshalInputBlogInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ret
 }
 
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	res := graphql.MarshalBoolean(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNPurgeItem2ᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐPurgeItem(ctx context.Context, sel ast.SelectionSet, v *model.PurgeItem) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNCommentInput2younifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐComment(ctx context.Context, v interface{}) (model.Comment, error) {
-	res, err := ec.unmarshalInputCommentInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ec._PurgeItem(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
-	res, err := graphql.UnmarshalInt(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNPurgeReport2younifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐPurgeReport(ctx context.Context, sel ast.SelectionSet, v model.PurgeReport) graphql.Marshaler {
+	return ec._PurgeReport(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
-	res := graphql.MarshalInt(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNPurgeReport2ᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐPurgeReport(ctx context.Context, sel ast.SelectionSet, v *model.PurgeReport) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNNewsInput2younifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐNews(ctx context.Context, v interface{}) (model.News, error) {
-	res, err := ec.unmarshalInputNewsInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ec._PurgeReport(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNObjectID2goᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx context.Context, v interface{}) (primitive.ObjectID, error) {
-	res, err := model2.UnmarshalObjectID(v)
+func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNObjectID2goᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx context.Context, sel ast.SelectionSet, v primitive.ObjectID) graphql.Marshaler {
-	res := model2.MarshalObjectID(v)
+func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalString(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
@@ -10455,13 +12813,19 @@ func (ec *executionContext) marshalNObjectID2goᚗmongodbᚗorgᚋmongoᚑdriver
 	return res
 }
 
-func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
-	res, err := graphql.UnmarshalString(v)
+func (ec *executionContext) unmarshalN_Any2map(ctx context.Context, v interface{}) (map[string]interface{}, error) {
+	res, err := graphql.UnmarshalMap(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalString(v)
+func (ec *executionContext) marshalN_Any2map(ctx context.Context, sel ast.SelectionSet, v map[string]interface{}) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	res := graphql.MarshalMap(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
@@ -10470,6 +12834,76 @@ func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.S
 	return res
 }
 
+func (ec *executionContext) unmarshalN_Any2ᚕmapᚄ(ctx context.Context, v interface{}) ([]map[string]interface{}, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]map[string]interface{}, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalN_Any2map(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalN_Any2ᚕmapᚄ(ctx context.Context, sel ast.SelectionSet, v []map[string]interface{}) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalN_Any2map(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalN_Entity2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋpluginᚋfederationᚋfedruntimeᚐEntity(ctx context.Context, sel ast.SelectionSet, v []fedruntime.Entity) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalO_Entity2githubᚗcomᚋ99designsᚋgqlgenᚋpluginᚋfederationᚋfedruntimeᚐEntity(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalN_FieldSet2string(ctx context.Context, v interface{}) (string, error) {
 	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -10742,6 +13176,61 @@ func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel a
 	return res
 }
 
+func (ec *executionContext) marshalOAnonymiseReport2ᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐAnonymiseReport(ctx context.Context, sel ast.SelectionSet, v *model.AnonymiseReport) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._AnonymiseReport(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOBargainingUnit2ᚕᚖyounifiedᚑbackendᚋcontractsᚋunionᚋmodelᚐBargainingUnit(ctx context.Context, sel ast.SelectionSet, v []*model1.BargainingUnit) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOBargainingUnit2ᚖyounifiedᚑbackendᚋcontractsᚋunionᚋmodelᚐBargainingUnit(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	return ret
+}
+
+func (ec *executionContext) marshalOBargainingUnit2ᚖyounifiedᚑbackendᚋcontractsᚋunionᚋmodelᚐBargainingUnit(ctx context.Context, sel ast.SelectionSet, v *model1.BargainingUnit) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._BargainingUnit(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOBlog2ᚕᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐBlog(ctx context.Context, sel ast.SelectionSet, v []*model.Blog) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -10864,6 +13353,16 @@ func (ec *executionContext) marshalOComment2ᚖyounifiedᚑbackendᚋcontracts
 	return ec._Comment(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOInt2int(ctx context.Context, v interface{}) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	res := graphql.MarshalInt(v)
+	return res
+}
+
 func (ec *executionContext) unmarshalOInt2int64(ctx context.Context, v interface{}) (int64, error) {
 	res, err := graphql.UnmarshalInt64(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -10874,6 +13373,29 @@ func (ec *executionContext) marshalOInt2int64(ctx context.Context, sel ast.Selec
 	return res
 }
 
+func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v interface{}) (*int, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalInt(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	res := graphql.MarshalInt(*v)
+	return res
+}
+
+func (ec *executionContext) marshalOMemberContent2ᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐMemberContent(ctx context.Context, sel ast.SelectionSet, v *model.MemberContent) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._MemberContent(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalONewsDocument2ᚕᚖyounifiedᚑbackendᚋcontractsᚋcmsᚋmodelᚐDocument(ctx context.Context, sel ast.SelectionSet, v []*model.Document) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -11006,12 +13528,12 @@ func (ec *executionContext) marshalONewsReport2ᚖyounifiedᚑbackendᚋcontract
 }
 
 func (ec *executionContext) unmarshalOObjectID2goᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx context.Context, v interface{}) (primitive.ObjectID, error) {
-	res, err := model2.UnmarshalObjectID(v)
+	res, err := model3.UnmarshalObjectID(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) marshalOObjectID2goᚗmongodbᚗorgᚋmongoᚑdriverᚋbsonᚋprimitiveᚐObjectID(ctx context.Context, sel ast.SelectionSet, v primitive.ObjectID) graphql.Marshaler {
-	res := model2.MarshalObjectID(v)
+	res := model3.MarshalObjectID(v)
 	return res
 }
 
@@ -11147,11 +13669,7 @@ func (ec *executionContext) marshalOTime2timeᚐTime(ctx context.Context, sel as
 	return res
 }
 
-func (ec *executionContext) marshalOUser2younifiedᚑbackendᚋcontractsᚋuserᚋmodelᚐUser(ctx context.Context, sel ast.SelectionSet, v model1.User) graphql.Marshaler {
-	return ec._User(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalOUser2ᚕyounifiedᚑbackendᚋcontractsᚋuserᚋmodelᚐUser(ctx context.Context, sel ast.SelectionSet, v []model1.User) graphql.Marshaler {
+func (ec *executionContext) marshalOUser2ᚕᚖyounifiedᚑbackendᚋcontractsᚋuserᚋmodelᚐUser(ctx context.Context, sel ast.SelectionSet, v []*model2.User) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -11178,7 +13696,7 @@ func (ec *executionContext) marshalOUser2ᚕyounifiedᚑbackendᚋcontractsᚋus
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalOUser2younifiedᚑbackendᚋcontractsᚋuserᚋmodelᚐUser(ctx, sel, v[i])
+			ret[i] = ec.marshalOUser2ᚖyounifiedᚑbackendᚋcontractsᚋuserᚋmodelᚐUser(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -11192,15 +13710,18 @@ func (ec *executionContext) marshalOUser2ᚕyounifiedᚑbackendᚋcontractsᚋus
 	return ret
 }
 
-func (ec *executionContext) marshalOUser2ᚖyounifiedᚑbackendᚋcontractsᚋuserᚋmodelᚐUser(ctx context.Context, sel ast.SelectionSet, v *model1.User) graphql.Marshaler {
+func (ec *executionContext) marshalOUser2ᚖyounifiedᚑbackendᚋcontractsᚋuserᚋmodelᚐUser(ctx context.Context, sel ast.SelectionSet, v *model2.User) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	return ec._User(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalOUserInfo2younifiedᚑbackendᚋcontractsᚋuserᚋmodelᚐUserInfo(ctx context.Context, sel ast.SelectionSet, v model1.UserInfo) graphql.Marshaler {
-	return ec._UserInfo(ctx, sel, &v)
+func (ec *executionContext) marshalO_Entity2githubᚗcomᚋ99designsᚋgqlgenᚋpluginᚋfederationᚋfedruntimeᚐEntity(ctx context.Context, sel ast.SelectionSet, v fedruntime.Entity) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec.__Entity(ctx, sel, v)
 }
 
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
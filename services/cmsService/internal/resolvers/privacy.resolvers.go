@@ -0,0 +1,22 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.60
+
+import (
+	"context"
+	"younified-backend/contracts/cms/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// AnonymiseMemberContent is the resolver for the anonymiseMemberContent field.
+func (r *mutationResolver) AnonymiseMemberContent(ctx context.Context, input model.AnonymiseMemberInput) (*model.AnonymiseReport, error) {
+	return r.CMSController.AnonymiseMemberContent(ctx, input)
+}
+
+// MemberContent is the resolver for the memberContent field.
+func (r *queryResolver) MemberContent(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID) (*model.MemberContent, error) {
+	return r.CMSController.MemberContent(ctx, unionID, userID)
+}
@@ -0,0 +1,17 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.60
+
+import (
+	"context"
+	"younified-backend/contracts/cms/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PublicContent is the resolver for the publicContent field.
+func (r *queryResolver) PublicContent(ctx context.Context, unionID primitive.ObjectID, newsLimit *int, blogLimit *int) (*model.PublicContent, error) {
+	return r.CMSController.PublicContent(ctx, unionID, newsLimit, blogLimit)
+}
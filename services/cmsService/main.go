@@ -2,21 +2,26 @@ package main
 
 import (
 	"context"
+	"errors"
 	"log"
 	"net/http"
 	"os"
 	"strconv"
+	"time"
 
 	"younified-backend/providers/aws"
 	"younified-backend/providers/database"
 	"younified-backend/providers/graphqlclient"
 
+	"younified-backend/services/cmsService/internal/auth"
 	controller "younified-backend/services/cmsService/internal/controller"
 	resolver "younified-backend/services/cmsService/internal/resolvers"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/joho/godotenv"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 const (
@@ -25,6 +30,7 @@ const (
 	defaultDatabaseName = "unified_base"
 	defaultRedisHost    = "localhost"
 	defaultRedisPort    = 6379
+	defaultPurgeHours   = 24
 )
 
 // Config holds the application configuration
@@ -125,21 +131,54 @@ func initializeAwsService(config Config) *aws.AWSProvider {
 // createGraphQLServer sets up the GraphQL server with resolvers
 func createGraphQLServer(
 	dbManager *database.DBManager,
-	redisClient *database.RedisClient,
-	awsProvider *aws.AWSProvider,
-	graphqlManager *graphqlclient.Graph) *handler.Server {
-	return handler.NewDefaultServer(resolver.NewExecutableSchema(resolver.Config{
+	cmsController *controller.CmsController) *handler.Server {
+	srv := handler.NewDefaultServer(resolver.NewExecutableSchema(resolver.Config{
 		Resolvers: &resolver.Resolver{
 			DBManager:     dbManager,
-			CMSController: controller.NewCMSController(dbManager, graphqlManager, redisClient, awsProvider),
+			CMSController: cmsController,
 		},
 	}))
+	// entitlement errors carry their code so clients can tell them apart
+	srv.SetErrorPresenter(func(ctx context.Context, err error) *gqlerror.Error {
+		presented := graphql.DefaultErrorPresenter(ctx, err)
+		var notEnabled *database.ModuleNotEnabledError
+		if errors.As(err, &notEnabled) {
+			if presented.Extensions == nil {
+				presented.Extensions = map[string]interface{}{}
+			}
+			presented.Extensions["code"] = notEnabled.Code()
+			presented.Extensions["module"] = notEnabled.Module
+		}
+		return presented
+	})
+	return srv
 }
 
 // setupRoutes configures HTTP routes
 func setupRoutes(srv *handler.Server) {
 	http.Handle("/", playground.Handler("GraphQL playground", "/graphql"))
-	http.Handle("/graphql", srv)
+	http.Handle("/graphql", graphqlclient.ServiceMiddleware(auth.Middleware(srv)))
+}
+
+// startRetentionPurge applies the unions' retention policies on a fixed interval.
+// RETENTION_PURGE_DRY_RUN=true only logs what would be removed.
+func startRetentionPurge(ctx context.Context, cmsController *controller.CmsController) {
+	hours, err := strconv.Atoi(os.Getenv("RETENTION_PURGE_INTERVAL_HOURS"))
+	if err != nil || hours <= 0 {
+		hours = defaultPurgeHours
+	}
+	dryRun := os.Getenv("RETENTION_PURGE_DRY_RUN") == "true"
+
+	ticker := time.NewTicker(time.Duration(hours) * time.Hour)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			cmsController.RunRetentionPurge(ctx, dryRun)
+		}
+	}
 }
 
 // startServer begins listening on the specified port
@@ -166,8 +205,12 @@ func main() {
 
 	awsProvider := initializeAwsService(config)
 
+	cmsController := controller.NewCMSController(dbManager, graphqlManager, redisProvider, awsProvider)
+
+	go startRetentionPurge(ctx, cmsController)
+
 	// Create GraphQL server
-	srv := createGraphQLServer(dbManager, redisProvider, awsProvider, graphqlManager)
+	srv := createGraphQLServer(dbManager, cmsController)
 
 	// Setup routes
 	setupRoutes(srv)
@@ -0,0 +1,213 @@
+package controllers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+	"younified-backend/contracts/union/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// archiveStale is how long an archive run may take before another instance takes
+// the union over
+const archiveStale = time.Hour
+
+// DeleteUnion soft deletes a union; only platform staff can. Its tenant database is locked at once and
+// archived by the archive job once the grace period is over.
+func (c *UnionController) DeleteUnion(ctx context.Context, id primitive.ObjectID) (*bool, error) {
+	if err := c.requireStaff(ctx); err != nil {
+		return boolPtr(false), err
+	}
+	union, err := c.UnionMongoRepository.SoftDeleteUnion(ctx, id)
+	if err != nil {
+		return boolPtr(false), err
+	}
+	c.invalidateUnion(union)
+	return boolPtr(true), nil
+}
+
+// RestoreUnion brings a deleted union back. A union still waiting for the archive
+// job is made live at once; an archived one is rebuilt from its archive in the
+// background and stays archived until that is done.
+func (c *UnionController) RestoreUnion(ctx context.Context, id primitive.ObjectID) (*model.Union, error) {
+	if id.IsZero() {
+		err := fmt.Errorf("union id is required")
+		return nil, err
+	}
+	if err := c.requireStaff(ctx); err != nil {
+		return nil, err
+	}
+	union, err := c.UnionMongoRepository.UnionById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !union.Deleted {
+		err := fmt.Errorf("union %s is not deleted", union.Name)
+		return nil, err
+	}
+
+	switch union.ArchiveStatus {
+	case model.ArchivePending, "":
+		claimed, err := c.UnionMongoRepository.ClaimRestore(ctx, id, union.ArchiveStatus)
+		if err != nil || claimed == nil {
+			return nil, fmt.Errorf("union %s changed while restoring, please try again", union.Name)
+		}
+		restored, err := c.UnionMongoRepository.Undelete(ctx, id, union.Archive)
+		if err != nil {
+			return nil, err
+		}
+		c.invalidateUnion(restored)
+		return restored, nil
+	case model.ArchiveDone:
+		claimed, err := c.UnionMongoRepository.ClaimRestore(ctx, id, model.ArchiveDone)
+		if err != nil || claimed == nil {
+			return nil, fmt.Errorf("union %s changed while restoring, please try again", union.Name)
+		}
+		go c.restoreArchive(context.Background(), claimed)
+		return claimed, nil
+	default:
+		err := fmt.Errorf("union %s is %s, please try again later", union.Name, union.ArchiveStatus)
+		return nil, err
+	}
+}
+
+// RunUnionArchival archives every union deleted more than grace ago; it is what the
+// scheduled job calls
+func (c *UnionController) RunUnionArchival(ctx context.Context, grace time.Duration) {
+	for {
+		now := time.Now()
+		union, err := c.UnionMongoRepository.ClaimArchive(ctx, now.Add(-grace), now.Add(-archiveStale))
+		if err != nil {
+			log.Printf("union archival: %v", err)
+			return
+		}
+		if union == nil {
+			return
+		}
+		c.archiveUnion(ctx, union)
+	}
+}
+
+// archiveUnion exports the tenant database, records the archive and then drops the
+// database. A run that stopped after the upload only drops the database again.
+func (c *UnionController) archiveUnion(ctx context.Context, union *model.Union) {
+	archive := union.Archive
+	if archive == nil {
+		archive = &model.UnionArchive{}
+	}
+	if archive.Key == "" || archive.ArchivedOn != nil {
+		bucket := os.Getenv("AWS_ARCHIVE_BUCKET")
+		if bucket == "" {
+			c.failArchive(ctx, union, archive, model.ArchivePending, fmt.Errorf("AWS_ARCHIVE_BUCKET is not set"))
+			return
+		}
+		key, err := archiveKey(union)
+		if err != nil {
+			c.failArchive(ctx, union, archive, model.ArchivePending, err)
+			return
+		}
+		// the export is uploaded while it is written, never held whole in memory
+		reader, writer := io.Pipe()
+		exported := make(chan *model.UnionArchive, 1)
+		go func() {
+			stats, err := c.TenantMongoRepository.ExportDatabase(ctx, union.DatabaseName(), writer)
+			if err != nil {
+				err = fmt.Errorf("could not export: %v", err)
+			}
+			exported <- stats
+			writer.CloseWithError(err)
+		}()
+		size, err := c.awsProvider.UploadStream(ctx, bucket, key, reader)
+		reader.CloseWithError(err)
+		stats := <-exported
+		if err != nil {
+			c.failArchive(ctx, union, archive, model.ArchivePending, fmt.Errorf("could not upload: %v", err))
+			return
+		}
+		archive.Bucket = bucket
+		archive.Key = key
+		archive.SizeBytes = size
+		archive.Collections = stats.Collections
+		archive.Documents = stats.Documents
+		archive.ArchivedOn = nil
+		archive.Error = ""
+		if err := c.UnionMongoRepository.SetArchive(ctx, union.ID, model.ArchiveRunning, archive); err != nil {
+			log.Printf("could not record archive of union %s: %v", union.UnionID, err)
+			return
+		}
+	}
+
+	if err := c.TenantMongoRepository.DropDatabase(ctx, union.DatabaseName()); err != nil {
+		c.failArchive(ctx, union, archive, model.ArchiveRunning, fmt.Errorf("could not drop database: %v", err))
+		return
+	}
+	archivedOn := time.Now()
+	archive.ArchivedOn = &archivedOn
+	if err := c.UnionMongoRepository.SetArchive(ctx, union.ID, model.ArchiveDone, archive); err != nil {
+		log.Printf("could not record archive of union %s: %v", union.UnionID, err)
+		return
+	}
+	c.invalidateUnion(union)
+	log.Printf("archived union %s to %s: %d collections, %d documents", union.UnionID, archive.Key, archive.Collections, archive.Documents)
+}
+
+// restoreArchive rebuilds the tenant database from the union's archive and makes
+// the union live; on failure the union stays archived
+func (c *UnionController) restoreArchive(ctx context.Context, union *model.Union) {
+	archive := union.Archive
+	if archive == nil || archive.Key == "" {
+		c.failArchive(ctx, union, &model.UnionArchive{}, model.ArchiveDone, fmt.Errorf("union has no archive"))
+		return
+	}
+	bucket := archive.Bucket
+	if bucket == "" {
+		// archived before archives had a bucket of their own
+		bucket = os.Getenv("AWS_S3_BUCKET")
+	}
+	data, err := c.awsProvider.OpenS3Object(ctx, bucket, archive.Key)
+	if err != nil {
+		c.failArchive(ctx, union, archive, model.ArchiveDone, fmt.Errorf("could not download %s: %v", archive.Key, err))
+		return
+	}
+	defer data.Close()
+	if err := c.TenantMongoRepository.ImportDatabase(ctx, union.DatabaseName(), data); err != nil {
+		c.failArchive(ctx, union, archive, model.ArchiveDone, fmt.Errorf("could not restore: %v", err))
+		return
+	}
+
+	restoredOn := time.Now()
+	archive.RestoredOn = &restoredOn
+	archive.Error = ""
+	restored, err := c.UnionMongoRepository.Undelete(ctx, union.ID, archive)
+	if err != nil {
+		log.Printf("could not undelete union %s: %v", union.UnionID, err)
+		return
+	}
+	c.invalidateUnion(restored)
+	log.Printf("restored union %s from %s", union.UnionID, archive.Key)
+}
+
+// archiveKey names the archive of a union; the random part keeps it from being guessed
+func archiveKey(union *model.Union) (string, error) {
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return "", fmt.Errorf("could not name the archive: %v", err)
+	}
+	return fmt.Sprintf("archives/%s/%s-%s.tar.gz", union.ID.Hex(), time.Now().Format("20060102150405"), hex.EncodeToString(token)), nil
+}
+
+// failArchive records why an archive or restore run failed and puts the union back
+// in status for the next attempt
+func (c *UnionController) failArchive(ctx context.Context, union *model.Union, archive *model.UnionArchive, status string, err error) {
+	log.Printf("union %s: %v", union.UnionID, err)
+	archive.Error = err.Error()
+	if err := c.UnionMongoRepository.SetArchive(ctx, union.ID, status, archive); err != nil {
+		log.Printf("could not record archive error of union %s: %v", union.UnionID, err)
+	}
+}
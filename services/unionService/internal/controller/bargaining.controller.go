@@ -0,0 +1,399 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"younified-backend/contracts/union/model"
+	"younified-backend/providers/database"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// BargainingUnits returns the units of a union by name
+func (c *UnionController) BargainingUnits(ctx context.Context, unionID primitive.ObjectID) ([]*model.BargainingUnit, error) {
+	if unionID.IsZero() {
+		err := fmt.Errorf("union id is required")
+		return nil, err
+	}
+	return c.BargainingMongoRepository.List(ctx, unionID)
+}
+
+// BargainingUnitByID resolves the units other services reference
+func (c *UnionController) BargainingUnitByID(ctx context.Context, id primitive.ObjectID) (*model.BargainingUnit, error) {
+	unit, err := c.BargainingMongoRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if unit == nil {
+		err := fmt.Errorf("could not find bargaining unit %s", id.Hex())
+		return nil, err
+	}
+	return unit, nil
+}
+
+// UnitParent returns the unit a sub-unit belongs to
+func (c *UnionController) UnitParent(ctx context.Context, unit *model.BargainingUnit) (*model.BargainingUnit, error) {
+	if unit.ParentID == nil {
+		return nil, nil
+	}
+	return c.BargainingMongoRepository.Get(ctx, unit.UnionID, *unit.ParentID)
+}
+
+func (c *UnionController) CreateBargainingUnit(ctx context.Context, unionID primitive.ObjectID, input model.BargainingUnitInput) (*model.BargainingUnit, error) {
+	union, err := c.unitUnion(ctx, unionID)
+	if err != nil {
+		return nil, err
+	}
+	unit := &model.BargainingUnit{UnionID: unionID}
+	if err := c.applyUnitInput(ctx, unit, input); err != nil {
+		return nil, err
+	}
+	unit, err = c.BargainingMongoRepository.Create(ctx, unit)
+	if err != nil {
+		return nil, fmt.Errorf("could not create bargaining unit: %v", err)
+	}
+	c.syncUnitNames(ctx, union)
+	return unit, nil
+}
+
+// UpdateBargainingUnit changes a unit; a new name is copied to the unit text of its
+// members
+func (c *UnionController) UpdateBargainingUnit(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID, input model.BargainingUnitInput) (*model.BargainingUnit, error) {
+	union, err := c.unitUnion(ctx, unionID)
+	if err != nil {
+		return nil, err
+	}
+	unit, err := c.findUnit(ctx, unionID, id)
+	if err != nil {
+		return nil, err
+	}
+	renamed := unit.Name != strings.TrimSpace(input.Name)
+	if err := c.applyUnitInput(ctx, unit, input); err != nil {
+		return nil, err
+	}
+	unit, err = c.BargainingMongoRepository.Update(ctx, unit)
+	if err != nil {
+		return nil, fmt.Errorf("could not update bargaining unit: %v", err)
+	}
+	if renamed {
+		members, err := c.TenantMongoRepository.UnitMemberIDs(ctx, union.DatabaseName(), unit.ID)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.TenantMongoRepository.RenameUnitMembers(ctx, union.DatabaseName(), unit); err != nil {
+			return nil, fmt.Errorf("the unit was renamed but its members could not be updated: %v", err)
+		}
+		c.invalidateMembers(union, members)
+		c.syncUnitNames(ctx, union)
+	}
+	return unit, nil
+}
+
+// DeleteBargainingUnit removes a unit that has no members and no sub-units
+func (c *UnionController) DeleteBargainingUnit(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID) (*bool, error) {
+	union, err := c.unitUnion(ctx, unionID)
+	if err != nil {
+		return boolPtr(false), err
+	}
+	unit, err := c.findUnit(ctx, unionID, id)
+	if err != nil {
+		return boolPtr(false), err
+	}
+	children, err := c.BargainingMongoRepository.HasChildren(ctx, unionID, id)
+	if err != nil {
+		return boolPtr(false), err
+	}
+	if children {
+		err := fmt.Errorf("%s has sub-units, delete or move them first", unit.Name)
+		return boolPtr(false), err
+	}
+	members, err := c.TenantMongoRepository.UnitMemberIDs(ctx, union.DatabaseName(), id)
+	if err != nil {
+		return boolPtr(false), err
+	}
+	if len(members) > 0 {
+		err := fmt.Errorf("%s still has %d members, assign them to another unit first", unit.Name, len(members))
+		return boolPtr(false), err
+	}
+	if err := c.BargainingMongoRepository.Delete(ctx, unionID, id); err != nil {
+		return boolPtr(false), err
+	}
+	c.syncUnitNames(ctx, union)
+	return boolPtr(true), nil
+}
+
+// AssignMembersToUnit moves members into a unit and returns how many changed
+func (c *UnionController) AssignMembersToUnit(ctx context.Context, unionID primitive.ObjectID, unitID primitive.ObjectID, memberIDs []primitive.ObjectID) (int, error) {
+	union, err := c.unitUnion(ctx, unionID)
+	if err != nil {
+		return 0, err
+	}
+	unit, err := c.findUnit(ctx, unionID, unitID)
+	if err != nil {
+		return 0, err
+	}
+	if len(memberIDs) == 0 {
+		err := fmt.Errorf("memberIDs are required")
+		return 0, err
+	}
+	assigned, err := c.TenantMongoRepository.AssignUnit(ctx, union.DatabaseName(), memberIDs, unit)
+	if err != nil {
+		return 0, fmt.Errorf("could not assign members: %v", err)
+	}
+	c.invalidateMembers(union, memberIDs)
+	return int(assigned), nil
+}
+
+// BargainingUnitCounts returns the members of every unit of a union, with the
+// members of sub-units added up into their parents
+func (c *UnionController) BargainingUnitCounts(ctx context.Context, unionID primitive.ObjectID) ([]*model.BargainingUnitCount, error) {
+	union, err := c.UnionMongoRepository.UnionById(ctx, unionID)
+	if err != nil {
+		return nil, err
+	}
+	units, err := c.BargainingMongoRepository.List(ctx, unionID)
+	if err != nil {
+		return nil, err
+	}
+	members, err := c.TenantMongoRepository.UnitMemberCounts(ctx, union.DatabaseName())
+	if err != nil {
+		return nil, fmt.Errorf("could not count members: %v", err)
+	}
+
+	counts := []*model.BargainingUnitCount{}
+	byID := map[primitive.ObjectID]*model.BargainingUnitCount{}
+	for _, unit := range units {
+		count := &model.BargainingUnitCount{Unit: unit, Members: members[unit.ID]}
+		counts = append(counts, count)
+		byID[unit.ID] = count
+	}
+	for _, count := range counts {
+		// walk up the parents; seen stops a loop left behind in the data
+		seen := map[primitive.ObjectID]bool{}
+		for unit := count.Unit; unit != nil && !seen[unit.ID]; {
+			seen[unit.ID] = true
+			byID[unit.ID].TotalMembers += count.Members
+			if unit.ParentID == nil || byID[*unit.ParentID] == nil {
+				break
+			}
+			unit = byID[*unit.ParentID].Unit
+		}
+	}
+	return counts, nil
+}
+
+// MigrateBargainingUnits turns the unit names of a union, or of every union when
+// unionID is nil, into units: names listed on the union and on members become
+// units, members are assigned by name and news is retargeted at unit ids. Running
+// it again only picks up what is left.
+func (c *UnionController) MigrateBargainingUnits(ctx context.Context, unionID *primitive.ObjectID) ([]*model.UnitMigrationReport, error) {
+	if err := c.requireStaff(ctx); err != nil {
+		return nil, err
+	}
+	var ids []primitive.ObjectID
+	if unionID != nil {
+		ids = append(ids, *unionID)
+	} else {
+		unions, err := c.dbManager.ListUnions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, union := range unions {
+			ids = append(ids, union.ID)
+		}
+	}
+
+	reports := []*model.UnitMigrationReport{}
+	for _, id := range ids {
+		report, err := c.migrateUnionUnits(ctx, id)
+		if err != nil {
+			return reports, fmt.Errorf("could not migrate the units of union %s: %v", id.Hex(), err)
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+func (c *UnionController) migrateUnionUnits(ctx context.Context, unionID primitive.ObjectID) (*model.UnitMigrationReport, error) {
+	union, err := c.UnionMongoRepository.UnionById(ctx, unionID)
+	if err != nil {
+		return nil, err
+	}
+	report := &model.UnitMigrationReport{UnionID: unionID, Unmatched: []string{}}
+
+	names := []string{}
+	for _, name := range union.BargainingUnits {
+		if name != nil {
+			names = append(names, *name)
+		}
+	}
+	memberUnits, err := c.TenantMongoRepository.UnassignedUnitNames(ctx, union.DatabaseName())
+	if err != nil {
+		return nil, err
+	}
+	names = append(names, memberUnits...)
+
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		unit, err := c.BargainingMongoRepository.FindByName(ctx, unionID, name)
+		if err != nil {
+			return nil, err
+		}
+		if unit == nil {
+			unit, err = c.BargainingMongoRepository.Create(ctx, &model.BargainingUnit{UnionID: unionID, Name: name})
+			if err != nil {
+				return nil, err
+			}
+			report.UnitsCreated++
+		}
+		assigned, err := c.TenantMongoRepository.AssignUnitByName(ctx, union.DatabaseName(), name, unit)
+		if err != nil {
+			return nil, err
+		}
+		report.MembersAssigned += int(assigned)
+	}
+
+	newsUnits, err := c.TenantMongoRepository.NewsUnits(ctx, union.DatabaseName())
+	if err != nil {
+		return nil, err
+	}
+	for _, target := range newsUnits {
+		if id, err := primitive.ObjectIDFromHex(target); err == nil {
+			if unit, _ := c.BargainingMongoRepository.Get(ctx, unionID, id); unit != nil {
+				continue
+			}
+		}
+		unit, err := c.BargainingMongoRepository.FindByName(ctx, unionID, strings.TrimSpace(target))
+		if err != nil {
+			return nil, err
+		}
+		if unit == nil {
+			report.Unmatched = append(report.Unmatched, target)
+			continue
+		}
+		updated, err := c.TenantMongoRepository.ReplaceNewsUnit(ctx, union.DatabaseName(), target, unit.ID.Hex())
+		if err != nil {
+			return nil, err
+		}
+		report.NewsUpdated += int(updated)
+	}
+
+	c.syncUnitNames(ctx, union)
+	if report.MembersAssigned > 0 {
+		go c.UnionRedisRepository.InvalidateCache(context.Background(), "all-users-"+unionID.Hex())
+	}
+	log.Printf("migrated bargaining units of union %s: %d created, %d members, %d news, %d unmatched",
+		union.UnionID, report.UnitsCreated, report.MembersAssigned, report.NewsUpdated, len(report.Unmatched))
+	return report, nil
+}
+
+// unitUnion checks the caller may manage the units of a live union and returns it
+func (c *UnionController) unitUnion(ctx context.Context, unionID primitive.ObjectID) (*model.Union, error) {
+	if unionID.IsZero() {
+		err := fmt.Errorf("union id is required")
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	union, err := c.UnionMongoRepository.UnionById(ctx, unionID)
+	if err != nil {
+		return nil, err
+	}
+	if union.Deleted {
+		return nil, database.ErrUnionArchived
+	}
+	return union, nil
+}
+
+func (c *UnionController) findUnit(ctx context.Context, unionID, id primitive.ObjectID) (*model.BargainingUnit, error) {
+	unit, err := c.BargainingMongoRepository.Get(ctx, unionID, id)
+	if err != nil {
+		return nil, err
+	}
+	if unit == nil {
+		err := fmt.Errorf("could not find bargaining unit %s", id.Hex())
+		return nil, err
+	}
+	return unit, nil
+}
+
+// applyUnitInput validates input against the union's other units and copies it to unit
+func (c *UnionController) applyUnitInput(ctx context.Context, unit *model.BargainingUnit, input model.BargainingUnitInput) error {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		err := fmt.Errorf("a bargaining unit needs a name")
+		return err
+	}
+	existing, err := c.BargainingMongoRepository.FindByName(ctx, unit.UnionID, name)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != unit.ID {
+		err := fmt.Errorf("a bargaining unit called %s already exists", name)
+		return err
+	}
+	if input.ParentID != nil {
+		if err := c.checkUnitParent(ctx, unit, *input.ParentID); err != nil {
+			return err
+		}
+	}
+
+	unit.Name = name
+	unit.Employer = strings.TrimSpace(input.Employer)
+	unit.AgreementRef = strings.TrimSpace(input.AgreementRef)
+	unit.ParentID = input.ParentID
+	return nil
+}
+
+// checkUnitParent makes sure the parent is a unit of the same union and that the unit
+// does not end up under itself
+func (c *UnionController) checkUnitParent(ctx context.Context, unit *model.BargainingUnit, parentID primitive.ObjectID) error {
+	for id := &parentID; id != nil; {
+		if *id == unit.ID {
+			err := fmt.Errorf("a bargaining unit cannot be its own sub-unit")
+			return err
+		}
+		parent, err := c.BargainingMongoRepository.Get(ctx, unit.UnionID, *id)
+		if err != nil {
+			return err
+		}
+		if parent == nil {
+			err := fmt.Errorf("could not find parent unit %s", id.Hex())
+			return err
+		}
+		id = parent.ParentID
+	}
+	return nil
+}
+
+// syncUnitNames keeps the union's list of unit names current
+func (c *UnionController) syncUnitNames(ctx context.Context, union *model.Union) {
+	units, err := c.BargainingMongoRepository.List(ctx, union.ID)
+	if err != nil {
+		log.Printf("could not list bargaining units of union %s: %v", union.UnionID, err)
+		return
+	}
+	names := []string{}
+	for _, unit := range units {
+		names = append(names, unit.Name)
+	}
+	if err := c.UnionMongoRepository.SetBargainingUnits(ctx, union.ID, names); err != nil {
+		log.Printf("could not update bargaining units of union %s: %v", union.UnionID, err)
+		return
+	}
+	c.invalidateUnion(union)
+}
+
+// invalidateMembers drops the members userService has cached
+func (c *UnionController) invalidateMembers(union *model.Union, memberIDs []primitive.ObjectID) {
+	for _, id := range memberIDs {
+		go c.UnionRedisRepository.InvalidateCache(context.Background(), id.Hex())
+	}
+	go c.UnionRedisRepository.InvalidateCache(context.Background(), "all-users-"+union.ID.Hex())
+}
@@ -0,0 +1,124 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"younified-backend/contracts/union/model"
+	"younified-backend/providers/database"
+	"younified-backend/services/unionService/internal/auth"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CurrentUnion returns the union of the custom domain the request came through, or nil
+// when the host is no union's
+func (c *UnionController) CurrentUnion(ctx context.Context) (*model.Union, error) {
+	id := database.TenantFromContext(ctx)
+	if id.IsZero() {
+		return nil, nil
+	}
+	return c.UnionByID(ctx, id)
+}
+
+// SetUnionDomains sets where a union is served and who may register with it. Union
+// admins manage the email domains; moving the union to another domain is for platform
+// staff.
+func (c *UnionController) SetUnionDomains(ctx context.Context, id primitive.ObjectID, input model.UnionDomainInput) (*model.Union, error) {
+	if id.IsZero() {
+		err := fmt.Errorf("union id is required")
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, id); err != nil {
+		return nil, err
+	}
+	union, err := c.UnionMongoRepository.UnionById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := bson.M{}
+	previousDomain := union.Domain
+	if input.Domain != nil {
+		domain := database.NormalizeDomain(*input.Domain)
+		if domain != union.Domain {
+			if claims := auth.ForContext(ctx); claims == nil || !c.isStaff(ctx, claims) {
+				err := fmt.Errorf("only platform staff can change the domain of a union")
+				return nil, err
+			}
+			if domain != "" {
+				if strings.ContainsAny(domain, "/@ ") || !strings.Contains(domain, ".") {
+					err := fmt.Errorf("%s is not a domain name", *input.Domain)
+					return nil, err
+				}
+				taken, err := c.UnionMongoRepository.DomainTaken(ctx, id, domain)
+				if err != nil {
+					return nil, err
+				}
+				if taken {
+					err := fmt.Errorf("%s is already the domain of another union", domain)
+					return nil, err
+				}
+			}
+			fields["domain"] = domain
+			union.Domain = domain
+		}
+	}
+	if input.BannedDomains != nil {
+		banned := []*string{}
+		for _, domain := range normalizeDomains(input.BannedDomains) {
+			domain := domain
+			banned = append(banned, &domain)
+		}
+		fields["bannedDomains"] = banned
+		union.BannedDomains = banned
+	}
+	if input.EmailDomains != nil {
+		union.EmailDomains = normalizeDomains(input.EmailDomains)
+		fields["emailDomains"] = union.EmailDomains
+	}
+	if input.RequireEmailDomain != nil {
+		union.RequireEmailDomain = *input.RequireEmailDomain
+		fields["requireEmailDomain"] = union.RequireEmailDomain
+	}
+	if union.RequireEmailDomain && len(union.EmailDomains) == 0 {
+		err := fmt.Errorf("requiring an email domain needs at least one email domain")
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return union, nil
+	}
+
+	if err := c.UnionMongoRepository.SetDomains(ctx, id, fields); err != nil {
+		return nil, fmt.Errorf("could not update the union's domains: %v", err)
+	}
+	c.invalidateUnion(union)
+	if union.Domain != previousDomain {
+		for _, domain := range []string{previousDomain, union.Domain} {
+			if domain == "" {
+				continue
+			}
+			if err := c.domains.Invalidate(ctx, domain); err != nil {
+				log.Printf("could not drop the cached union of %s: %v", domain, err)
+			}
+		}
+	}
+	return union, nil
+}
+
+// normalizeDomains lowercases domains and drops blanks, duplicates and a leading @
+func normalizeDomains(domains []string) []string {
+	normalized := []string{}
+	seen := map[string]bool{}
+	for _, domain := range domains {
+		domain = database.NormalizeDomain(strings.TrimPrefix(strings.TrimSpace(domain), "@"))
+		if domain == "" || seen[domain] {
+			continue
+		}
+		seen[domain] = true
+		normalized = append(normalized, domain)
+	}
+	return normalized
+}
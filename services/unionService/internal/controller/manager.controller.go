@@ -0,0 +1,271 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"younified-backend/contracts/union/model"
+	userModel "younified-backend/contracts/user/model"
+	email "younified-backend/providers/emailBodyProvider"
+	"younified-backend/providers/graphqlclient"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// managerEntitiesQuery looks staff up in one call through userService's batch entity
+// resolver
+const managerEntitiesQuery = `query($representations: [_Any!]!) {
+  _entities(representations: $representations) {
+    ... on User {
+      id
+      firstName
+      lastName
+      department
+      profile { email phone mobile imageURL }
+    }
+  }
+}`
+
+// AccountManagers resolves the account managers of a union from userService
+func (c *UnionController) AccountManagers(ctx context.Context, union *model.Union) ([]*model.Manager, error) {
+	return c.managers(ctx, union.AccountManagerID)
+}
+
+// CommunicationReps resolves the communication reps of a union from userService
+func (c *UnionController) CommunicationReps(ctx context.Context, union *model.Union) ([]*model.Manager, error) {
+	return c.managers(ctx, union.CommunicationRepID)
+}
+
+// SetUnionManagers assigns staff to a union; a nil list keeps the staff in that role.
+// Staff new to a role are emailed.
+func (c *UnionController) SetUnionManagers(ctx context.Context, id primitive.ObjectID, accountManagers []primitive.ObjectID, communicationReps []primitive.ObjectID) (*model.Union, error) {
+	if err := c.requireStaff(ctx); err != nil {
+		return nil, err
+	}
+	if id.IsZero() {
+		err := fmt.Errorf("union id is required")
+		return nil, err
+	}
+	union, err := c.UnionMongoRepository.UnionById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if accountManagers == nil {
+		accountManagers = union.AccountManagerID
+	}
+	if communicationReps == nil {
+		communicationReps = union.CommunicationRepID
+	}
+	accountManagers = uniqueIDs(accountManagers)
+	communicationReps = uniqueIDs(communicationReps)
+	for _, staffID := range append(append([]primitive.ObjectID{}, accountManagers...), communicationReps...) {
+		if err := c.checkStaffMember(ctx, staffID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.UnionMongoRepository.SetManagerIDs(ctx, id, accountManagers, communicationReps); err != nil {
+		return nil, fmt.Errorf("could not assign staff: %v", err)
+	}
+	newAccountManagers := addedIDs(union.AccountManagerID, accountManagers)
+	newCommunicationReps := addedIDs(union.CommunicationRepID, communicationReps)
+	union.AccountManagerID = accountManagers
+	union.CommunicationRepID = communicationReps
+	c.invalidateUnion(union)
+
+	go func() {
+		c.notifyManagers(context.Background(), union, newAccountManagers, model.ManagerRoleAccount)
+		c.notifyManagers(context.Background(), union, newCommunicationReps, model.ManagerRoleCommunication)
+	}()
+	return union, nil
+}
+
+// ManagerPortfolios lists the unions of one staff member, or of every staff member
+// assigned to a union when managerID is nil
+func (c *UnionController) ManagerPortfolios(ctx context.Context, managerID *primitive.ObjectID) ([]*model.ManagerPortfolio, error) {
+	if err := c.requireStaff(ctx); err != nil {
+		return nil, err
+	}
+	var ids []primitive.ObjectID
+	if managerID != nil {
+		ids = []primitive.ObjectID{*managerID}
+	} else {
+		all, err := c.UnionMongoRepository.ManagerIDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids = all
+	}
+
+	managers, err := c.managers(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := map[primitive.ObjectID]*model.Manager{}
+	for _, manager := range managers {
+		byID[manager.ID] = manager
+	}
+
+	portfolios := []*model.ManagerPortfolio{}
+	for _, id := range ids {
+		unions, err := c.UnionMongoRepository.UnionsByManager(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		manager := byID[id]
+		if manager == nil {
+			// the staff member is gone from userService; the assignment still shows
+			manager = &model.Manager{ID: id}
+		}
+		portfolio := &model.ManagerPortfolio{Manager: manager, AccountManagerOf: []*model.Union{}, CommunicationRepOf: []*model.Union{}}
+		for _, union := range unions {
+			if containsID(union.AccountManagerID, id) {
+				portfolio.AccountManagerOf = append(portfolio.AccountManagerOf, union)
+			}
+			if containsID(union.CommunicationRepID, id) {
+				portfolio.CommunicationRepOf = append(portfolio.CommunicationRepOf, union)
+			}
+		}
+		portfolios = append(portfolios, portfolio)
+	}
+	return portfolios, nil
+}
+
+// managers looks staff up in userService in one batched call, in the order of ids;
+// staff userService no longer knows are left out
+func (c *UnionController) managers(ctx context.Context, ids []primitive.ObjectID) ([]*model.Manager, error) {
+	managers := []*model.Manager{}
+	if len(ids) == 0 {
+		return managers, nil
+	}
+	representations := make([]map[string]interface{}, 0, len(ids))
+	for _, id := range ids {
+		representations = append(representations, map[string]interface{}{"__typename": "User", "id": id.Hex()})
+	}
+
+	var result struct {
+		Entities []*struct {
+			ID         primitive.ObjectID `json:"id"`
+			FirstName  string             `json:"firstName"`
+			LastName   string             `json:"lastName"`
+			Department string             `json:"department"`
+			Profile    struct {
+				Email    string `json:"email"`
+				Phone    string `json:"phone"`
+				Mobile   string `json:"mobile"`
+				ImageURL string `json:"imageURL"`
+			} `json:"profile"`
+		} `json:"_entities"`
+	}
+	// staff belong to another union than the caller, only a service may look them up
+	users := c.graphqlManager.Endpoint(os.Getenv("GRAPHQL_ENDPOINT")).AsService()
+	vars := map[string]interface{}{"representations": representations}
+	if err := users.Execute(ctx, managerEntitiesQuery, vars, &result); err != nil {
+		return nil, fmt.Errorf("could not load staff from userService: %v", err)
+	}
+	for _, user := range result.Entities {
+		if user == nil || user.ID.IsZero() {
+			continue
+		}
+		managers = append(managers, &model.Manager{
+			ID:         user.ID,
+			FirstName:  user.FirstName,
+			LastName:   user.LastName,
+			Email:      user.Profile.Email,
+			Phone:      user.Profile.Phone,
+			Mobile:     user.Profile.Mobile,
+			Department: user.Department,
+			ImageURL:   user.Profile.ImageURL,
+		})
+	}
+	return managers, nil
+}
+
+// checkStaffMember makes sure a user is an active member of the staff union
+func (c *UnionController) checkStaffMember(ctx context.Context, id primitive.ObjectID) error {
+	staffUnion, err := primitive.ObjectIDFromHex(os.Getenv("STAFF_UNION_ID"))
+	if err != nil {
+		err := fmt.Errorf("STAFF_UNION_ID is not set")
+		return err
+	}
+	union, err := c.UnionMongoRepository.UnionById(ctx, staffUnion)
+	if err != nil {
+		return err
+	}
+	user, err := c.TenantMongoRepository.FindUser(ctx, union.DatabaseName(), id)
+	if err != nil {
+		return err
+	}
+	if user == nil || user.Deleted || adminBlocked[userModel.NormalizeStatus(user.Status)] {
+		err := fmt.Errorf("%s is not an active staff member", id.Hex())
+		return err
+	}
+	return nil
+}
+
+// notifyManagers emails staff newly assigned to a union
+func (c *UnionController) notifyManagers(ctx context.Context, union *model.Union, ids []primitive.ObjectID, role string) {
+	if len(ids) == 0 {
+		return
+	}
+	managers, err := c.managers(ctx, ids)
+	if err != nil {
+		log.Printf("could not notify the new %ss of union %s: %v", role, union.UnionID, err)
+		return
+	}
+	comms := c.graphqlManager.Endpoint(os.Getenv("Comm_GRAPHQL_ENDPOINT")).AsService()
+	for _, manager := range managers {
+		if manager.Email == "" {
+			log.Printf("%s %s of union %s has no email address", role, manager.ID.Hex(), union.UnionID)
+			continue
+		}
+		body := email.GetManagerAssignedBody(manager.FirstName, role, union.Name)
+		mailMutation, mailVars := graphqlclient.NewMutationBuilder().
+			SetMutationName("sendMail").
+			SetInputName("SendMailInput").
+			SetInput(map[string]interface{}{
+				"email":    manager.Email,
+				"subject":  fmt.Sprintf("You are now the %s of %s", role, union.Name),
+				"content":  body,
+				"category": "managerAssigned",
+			}).
+			Build()
+		var result struct {
+			Response string `json:"sendMail"`
+		}
+		if err := comms.Execute(ctx, mailMutation, mailVars, &result); err != nil {
+			log.Printf("could not notify %s %s of union %s: %v", role, manager.ID.Hex(), union.UnionID, err)
+		}
+	}
+}
+
+func uniqueIDs(ids []primitive.ObjectID) []primitive.ObjectID {
+	unique := []primitive.ObjectID{}
+	for _, id := range ids {
+		if !id.IsZero() && !containsID(unique, id) {
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+// addedIDs returns the ids of next that are not in previous
+func addedIDs(previous, next []primitive.ObjectID) []primitive.ObjectID {
+	added := []primitive.ObjectID{}
+	for _, id := range next {
+		if !containsID(previous, id) {
+			added = append(added, id)
+		}
+	}
+	return added
+}
+
+func containsID(ids []primitive.ObjectID, id primitive.ObjectID) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
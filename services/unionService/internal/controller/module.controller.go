@@ -0,0 +1,152 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"younified-backend/contracts/union/model"
+	userModel "younified-backend/contracts/user/model"
+	"younified-backend/services/unionService/internal/auth"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// adminBlocked are the member statuses that cannot act as admins
+var adminBlocked = map[string]bool{
+	userModel.StatusSuspended:  true,
+	userModel.StatusWithdrawn:  true,
+	userModel.StatusDeceased:   true,
+	userModel.UserStatusErased: true,
+}
+
+// UnionModules returns the modules enabled for a union, from the entitlement cache
+// the other services read
+func (c *UnionController) UnionModules(ctx context.Context, id primitive.ObjectID) ([]string, error) {
+	if id.IsZero() {
+		err := fmt.Errorf("union id is required")
+		return nil, err
+	}
+	return c.entitlements.Modules(ctx, id)
+}
+
+// EnableModule entitles a union to a module; only platform staff can
+func (c *UnionController) EnableModule(ctx context.Context, id primitive.ObjectID, module string) (*model.Union, error) {
+	if err := c.checkModuleChange(ctx, id, module); err != nil {
+		return nil, err
+	}
+	if err := c.UnionMongoRepository.AddModule(ctx, id, module); err != nil {
+		return nil, fmt.Errorf("could not enable %s: %v", module, err)
+	}
+	return c.moduleChanged(ctx, id)
+}
+
+// DisableModule takes a module away from a union; only platform staff can
+func (c *UnionController) DisableModule(ctx context.Context, id primitive.ObjectID, module string) (*model.Union, error) {
+	if err := c.checkModuleChange(ctx, id, module); err != nil {
+		return nil, err
+	}
+	if err := c.UnionMongoRepository.RemoveModule(ctx, id, module); err != nil {
+		return nil, fmt.Errorf("could not disable %s: %v", module, err)
+	}
+	return c.moduleChanged(ctx, id)
+}
+
+func (c *UnionController) checkModuleChange(ctx context.Context, id primitive.ObjectID, module string) error {
+	if err := c.requireStaff(ctx); err != nil {
+		return err
+	}
+	if id.IsZero() {
+		err := fmt.Errorf("union id is required")
+		return err
+	}
+	if !model.KnownModule(module) {
+		err := fmt.Errorf("unknown module %s", module)
+		return err
+	}
+	return nil
+}
+
+// BackfillModules enables the default modules of the unions registered before unions
+// had modules, which had every module then
+func (c *UnionController) BackfillModules(ctx context.Context) error {
+	ids, err := c.UnionMongoRepository.BackfillModules(ctx, model.DefaultModules)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if _, err := c.moduleChanged(ctx, id); err != nil {
+			log.Printf("could not refresh the modules of union %s: %v", id.Hex(), err)
+		}
+	}
+	if len(ids) > 0 {
+		log.Printf("enabled the default modules of %d unions", len(ids))
+	}
+	return nil
+}
+
+// moduleChanged drops the cached union and entitlements so every service sees the
+// change
+func (c *UnionController) moduleChanged(ctx context.Context, id primitive.ObjectID) (*model.Union, error) {
+	if err := c.entitlements.Invalidate(ctx, id); err != nil {
+		return nil, fmt.Errorf("could not refresh the union's modules: %v", err)
+	}
+	union, err := c.UnionMongoRepository.UnionById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	c.invalidateUnion(union)
+	return union, nil
+}
+
+// requireStaff allows platform staff only: admins of the staff union
+func (c *UnionController) requireStaff(ctx context.Context) error {
+	claims := auth.ForContext(ctx)
+	if claims == nil {
+		err := fmt.Errorf("authentication required")
+		return err
+	}
+	if !c.isStaff(ctx, claims) {
+		err := fmt.Errorf("only platform staff can do this")
+		return err
+	}
+	return nil
+}
+
+// requireUnionAdmin allows the admins of a union, and platform staff
+func (c *UnionController) requireUnionAdmin(ctx context.Context, unionID primitive.ObjectID) error {
+	claims := auth.ForContext(ctx)
+	if claims == nil {
+		err := fmt.Errorf("authentication required")
+		return err
+	}
+	if claims.UnionID == unionID && c.isActiveAdmin(ctx, unionID, claims.UserID) {
+		return nil
+	}
+	if c.isStaff(ctx, claims) {
+		return nil
+	}
+	err := fmt.Errorf("only the union's admins can do this")
+	return err
+}
+
+func (c *UnionController) isStaff(ctx context.Context, claims *auth.TokenClaim) bool {
+	staffUnion := os.Getenv("STAFF_UNION_ID")
+	if staffUnion == "" || claims.UnionID.Hex() != staffUnion {
+		return false
+	}
+	return c.isActiveAdmin(ctx, claims.UnionID, claims.UserID)
+}
+
+// isActiveAdmin reports whether a user is an admin of the union who can still sign in
+func (c *UnionController) isActiveAdmin(ctx context.Context, unionID, userID primitive.ObjectID) bool {
+	union, err := c.UnionMongoRepository.UnionById(ctx, unionID)
+	if err != nil || union == nil {
+		return false
+	}
+	user, err := c.TenantMongoRepository.FindUser(ctx, union.DatabaseName(), userID)
+	if err != nil || user == nil {
+		return false
+	}
+	return user.IsAdmin && !user.Deleted && !adminBlocked[userModel.NormalizeStatus(user.Status)]
+}
@@ -0,0 +1,119 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+	"younified-backend/contracts/union/model"
+	"younified-backend/services/unionService/internal/repository"
+)
+
+const publicContentQuery = `query($unionID: ObjectID!) {
+	publicContent(unionID: $unionID) {
+		pinned { id content images documents { url name } category pinned createdOn }
+		news { id content images documents { url name } category pinned createdOn }
+		blogs { id header subHeader content images createdOn }
+	}
+}`
+
+// PublicUnionProfile returns the public page of the union with slug. It is asked for
+// without signing in and is cached for everyone alike, so it must never depend on
+// who is asking.
+func (c *UnionController) PublicUnionProfile(ctx context.Context, slug string) (*model.PublicUnionProfile, error) {
+	if slug == "" {
+		err := fmt.Errorf("slug is required")
+		return nil, err
+	}
+	if profile, err := c.UnionRedisRepository.GetProfileFromCache(ctx, slug); err == nil && profile != nil {
+		return profile, nil
+	}
+	union, err := c.UnionMongoRepository.UnionBySlug(ctx, slug)
+	if errors.Is(err, repository.ErrUnionNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &model.PublicUnionProfile{
+		Slug:        union.UnionID,
+		Name:        union.Name,
+		Information: union.Information,
+		Social: model.SocialLinks{
+			Twitter:        union.Twitter,
+			TwitterLinks:   derefLinks(union.TwitterLinks),
+			Facebook:       union.Facebook,
+			FacebookLinks:  derefLinks(union.FacebookLinks),
+			Instagram:      union.Instagram,
+			InstagramLinks: derefLinks(union.InstagramLinks),
+		},
+		PinnedNews:  []*model.PublicNews{},
+		News:        []*model.PublicNews{},
+		Blogs:       []*model.PublicBlog{},
+		GeneratedOn: time.Now(),
+	}
+	if theme, err := c.currentTheme(ctx, union); err != nil {
+		log.Printf("could not load the theme of union %s: %v", union.UnionID, err)
+	} else if theme != nil {
+		profile.Theme = theme.Public()
+	}
+
+	var result struct {
+		PublicContent struct {
+			Pinned []*model.PublicNews `json:"pinned"`
+			News   []*model.PublicNews `json:"news"`
+			Blogs  []*model.PublicBlog `json:"blogs"`
+		} `json:"publicContent"`
+	}
+	// a call of its own: the shared client's endpoint is changed by other requests,
+	// and its cache would hold on to posts longer than the profile's cache does
+	vars := map[string]interface{}{"unionID": union.ID.Hex()}
+	if err := c.graphqlManager.Endpoint(os.Getenv("CMS_GRAPHQL_ENDPOINT")).Execute(ctx, publicContentQuery, vars, &result); err != nil {
+		// the page still renders without news; don't cache it so the posts come back
+		// with the next request
+		log.Printf("could not load the public content of union %s from cmsService: %v", union.UnionID, err)
+		return profile, nil
+	}
+	profile.PinnedNews = publicNews(result.PublicContent.Pinned)
+	profile.News = publicNews(result.PublicContent.News)
+	for _, blog := range result.PublicContent.Blogs {
+		if blog != nil {
+			profile.Blogs = append(profile.Blogs, blog)
+		}
+	}
+
+	go c.UnionRedisRepository.CacheProfile(context.Background(), slug, profile)
+	return profile, nil
+}
+
+// publicNews drops the empty entries cmsService may send
+func publicNews(posts []*model.PublicNews) []*model.PublicNews {
+	news := make([]*model.PublicNews, 0, len(posts))
+	for _, post := range posts {
+		if post == nil {
+			continue
+		}
+		documents := make([]*model.PublicDocument, 0, len(post.Documents))
+		for _, document := range post.Documents {
+			if document != nil {
+				documents = append(documents, document)
+			}
+		}
+		post.Documents = documents
+		news = append(news, post)
+	}
+	return news
+}
+
+func derefLinks(links []*string) []string {
+	out := make([]string, 0, len(links))
+	for _, link := range links {
+		if link != nil && *link != "" {
+			out = append(out, *link)
+		}
+	}
+	return out
+}
@@ -0,0 +1,327 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+	"younified-backend/contracts/union/model"
+	email "younified-backend/providers/emailBodyProvider"
+	"younified-backend/providers/graphqlclient"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	// provisioningAttempts is how often a step is tried before the workflow fails
+	provisioningAttempts = 3
+	// provisioningLease is how long an instance owns a workflow without saving progress
+	provisioningLease = 5 * time.Minute
+)
+
+// provisioningStep is what a step of the workflow does and how it is taken back.
+// Every do is safe to run again, a restarted service repeats the step it stopped in.
+type provisioningStep struct {
+	do func(ctx context.Context, workflow *model.ProvisioningWorkflow) error
+	// undo is nil when dropping the tenant database already takes the step back
+	undo func(ctx context.Context, workflow *model.ProvisioningWorkflow) error
+	// optional steps are skipped when they fail instead of failing the union
+	optional bool
+}
+
+func (c *UnionController) provisioningSteps() map[string]provisioningStep {
+	return map[string]provisioningStep{
+		model.StepUnionRecord:     {do: c.insertUnionRecord, undo: c.removeUnionRecord},
+		model.StepTenantDatabase:  {do: c.createTenantDatabase, undo: c.dropTenantDatabase},
+		model.StepSeedCollections: {do: c.seedTenantCollections},
+		model.StepFirstAdmin:      {do: c.createFirstAdmin},
+		model.StepDefaultUser:     {do: c.createDefaultUser},
+		model.StepWelcomeEmail:    {do: c.sendWelcomeEmail, optional: true},
+	}
+}
+
+func newProvisioningWorkflow(union *model.Union) *model.ProvisioningWorkflow {
+	workflow := &model.ProvisioningWorkflow{
+		UnionID: union.ID,
+		Slug:    union.UnionID,
+		Status:  model.ProvisioningPending,
+		Union:   *union,
+		Steps:   []*model.ProvisioningStep{},
+	}
+	for _, name := range model.ProvisioningSteps {
+		workflow.Steps = append(workflow.Steps, &model.ProvisioningStep{Name: name, Status: model.StepPending})
+	}
+	return workflow
+}
+
+func (c *UnionController) ProvisioningStatus(ctx context.Context, unionID primitive.ObjectID) (*model.ProvisioningWorkflow, error) {
+	if unionID.IsZero() {
+		err := fmt.Errorf("union id is required")
+		return nil, err
+	}
+	return c.ProvisioningMongoRepository.LatestWorkflow(ctx, unionID)
+}
+
+// ResumeProvisioning picks up the workflows an instance left unfinished; it runs at
+// startup and on a fixed interval
+func (c *UnionController) ResumeProvisioning(ctx context.Context) {
+	ids, err := c.ProvisioningMongoRepository.Stalled(ctx)
+	if err != nil {
+		log.Printf("could not load stalled provisioning: %v", err)
+		return
+	}
+	for _, id := range ids {
+		log.Printf("resuming provisioning %s", id.Hex())
+		go c.runProvisioning(context.Background(), id)
+	}
+}
+
+// runProvisioning runs the steps of a workflow that are not done yet. When a required
+// step fails after its retries, the steps before it are undone in reverse order.
+func (c *UnionController) runProvisioning(ctx context.Context, id primitive.ObjectID) {
+	workflow, err := c.ProvisioningMongoRepository.ClaimWorkflow(ctx, id, provisioningLease)
+	if err != nil {
+		log.Printf("could not claim provisioning %s: %v", id.Hex(), err)
+		return
+	}
+	if workflow == nil {
+		return
+	}
+	workflow.Status = model.ProvisioningRunning
+	c.saveProvisioning(ctx, workflow)
+
+	steps := c.provisioningSteps()
+	for i, name := range model.ProvisioningSteps {
+		step := workflow.Step(name)
+		if step == nil || step.Status == model.StepDone || step.Status == model.StepSkipped {
+			continue
+		}
+		definition := steps[name]
+		if err := c.runProvisioningStep(ctx, workflow, step, definition); err != nil {
+			if definition.optional {
+				log.Printf("skipping %s of union %s: %v", name, workflow.Slug, err)
+				step.Status = model.StepSkipped
+				c.saveProvisioning(ctx, workflow)
+				continue
+			}
+			log.Printf("provisioning of union %s failed at %s: %v", workflow.Slug, name, err)
+			c.undoProvisioning(ctx, workflow, i)
+			c.finishProvisioning(ctx, workflow, model.ProvisioningFailed, fmt.Sprintf("%s: %v", name, err))
+			return
+		}
+	}
+	c.finishProvisioning(ctx, workflow, model.ProvisioningCompleted, "")
+	log.Printf("provisioned union %s", workflow.Slug)
+}
+
+// runProvisioningStep tries a step until it succeeds or runs out of attempts, waiting
+// longer after each failure. Attempts made before a restart count.
+func (c *UnionController) runProvisioningStep(ctx context.Context, workflow *model.ProvisioningWorkflow, step *model.ProvisioningStep, definition provisioningStep) error {
+	for step.Attempts < provisioningAttempts {
+		step.Attempts++
+		started := time.Now()
+		step.Status = model.StepRunning
+		step.StartedOn = &started
+		c.saveProvisioning(ctx, workflow)
+
+		err := definition.do(ctx, workflow)
+		if err == nil {
+			completed := time.Now()
+			step.Status = model.StepDone
+			step.CompletedOn = &completed
+			step.Error = ""
+			c.saveProvisioning(ctx, workflow)
+			return nil
+		}
+		step.Error = err.Error()
+		c.saveProvisioning(ctx, workflow)
+		if step.Attempts < provisioningAttempts {
+			time.Sleep(time.Duration(1<<step.Attempts) * time.Second)
+		}
+	}
+	step.Status = model.StepFailed
+	if step.Error == "" {
+		step.Error = "interrupted"
+	}
+	return errors.New(step.Error)
+}
+
+// undoProvisioning takes back the failed step and the steps before it
+func (c *UnionController) undoProvisioning(ctx context.Context, workflow *model.ProvisioningWorkflow, failed int) {
+	steps := c.provisioningSteps()
+	for i := failed; i >= 0; i-- {
+		step := workflow.Step(model.ProvisioningSteps[i])
+		if step == nil || (step.Status != model.StepDone && step.Status != model.StepFailed) {
+			continue
+		}
+		if undo := steps[step.Name].undo; undo != nil {
+			if err := undo(ctx, workflow); err != nil {
+				log.Printf("could not undo %s of union %s: %v", step.Name, workflow.Slug, err)
+				step.Error = fmt.Sprintf("undo: %v", err)
+				continue
+			}
+		}
+		if step.Status == model.StepDone {
+			step.Status = model.StepUndone
+		}
+	}
+	c.saveProvisioning(ctx, workflow)
+}
+
+// finishProvisioning records the outcome and drops the passwords the workflow kept
+// to create the users
+func (c *UnionController) finishProvisioning(ctx context.Context, workflow *model.ProvisioningWorkflow, status string, reason string) {
+	completed := time.Now()
+	workflow.Status = status
+	workflow.Error = reason
+	workflow.CompletedOn = &completed
+	workflow.Union.FirstUser.Password = ""
+	workflow.Union.DefaultUser.Password = ""
+	c.saveProvisioning(ctx, workflow)
+	go c.UnionRedisRepository.InvalidateCache(context.Background(), "all-unions")
+}
+
+func (c *UnionController) saveProvisioning(ctx context.Context, workflow *model.ProvisioningWorkflow) {
+	if err := c.ProvisioningMongoRepository.SaveWorkflow(ctx, workflow, provisioningLease); err != nil {
+		log.Printf("could not save provisioning of union %s: %v", workflow.Slug, err)
+	}
+}
+
+func (c *UnionController) insertUnionRecord(ctx context.Context, workflow *model.ProvisioningWorkflow) error {
+	union := workflow.Union
+	// the users are created from the workflow; the union record never holds their passwords
+	union.FirstUser.Password = ""
+	union.DefaultUser.Password = ""
+	_, err := c.UnionMongoRepository.Register(ctx, &union, &union.FirstUser, &union.DefaultUser)
+	if mongo.IsDuplicateKeyError(err) {
+		// a repeated step finds its own record; any other duplicate is a union that took
+		// the slug meanwhile, whose database the undo must not drop
+		exists, existsErr := c.UnionMongoRepository.Exists(ctx, map[string]interface{}{"_id": workflow.UnionID})
+		if existsErr != nil {
+			return existsErr
+		}
+		if !exists {
+			return fmt.Errorf("slug %s was taken by another union meanwhile", workflow.Slug)
+		}
+	} else if err != nil {
+		return err
+	}
+	go c.UnionRedisRepository.InvalidateCache(context.Background(), "all-unions")
+	return nil
+}
+
+// DropStoredPasswords removes the passwords of the first and the default user from
+// the unions registered before they stopped being kept
+func (c *UnionController) DropStoredPasswords(ctx context.Context) error {
+	dropped, err := c.UnionMongoRepository.DropUserPasswords(ctx)
+	if err != nil {
+		return err
+	}
+	if dropped > 0 {
+		log.Printf("removed the stored user passwords of %d unions", dropped)
+		go c.UnionRedisRepository.InvalidateCache(context.Background(), "all-unions")
+	}
+	return nil
+}
+
+func (c *UnionController) removeUnionRecord(ctx context.Context, workflow *model.ProvisioningWorkflow) error {
+	go c.UnionRedisRepository.InvalidateCache(context.Background(), workflow.UnionID.Hex())
+	go c.UnionRedisRepository.InvalidateCache(context.Background(), workflow.Slug)
+	go c.UnionRedisRepository.InvalidateCache(context.Background(), "all-unions")
+	exists, err := c.UnionMongoRepository.Exists(ctx, map[string]interface{}{"_id": workflow.UnionID})
+	if err != nil || !exists {
+		return err
+	}
+	return c.UnionMongoRepository.DeleteUnion(ctx, workflow.UnionID)
+}
+
+func (c *UnionController) createTenantDatabase(ctx context.Context, workflow *model.ProvisioningWorkflow) error {
+	return c.TenantMongoRepository.CreateDatabase(ctx, workflow.Slug)
+}
+
+func (c *UnionController) dropTenantDatabase(ctx context.Context, workflow *model.ProvisioningWorkflow) error {
+	return c.TenantMongoRepository.DropDatabase(ctx, workflow.Slug)
+}
+
+func (c *UnionController) seedTenantCollections(ctx context.Context, workflow *model.ProvisioningWorkflow) error {
+	return c.TenantMongoRepository.SeedCollections(ctx, workflow.Slug)
+}
+
+func (c *UnionController) createFirstAdmin(ctx context.Context, workflow *model.ProvisioningWorkflow) error {
+	first := workflow.Union.FirstUser
+	return c.createTenantUser(ctx, workflow, "FIRST_ADMIN", map[string]interface{}{
+		"unionID":   workflow.UnionID,
+		"username":  first.Email,
+		"password":  first.Password,
+		"firstName": first.FirstName,
+		"lastName":  first.LastName,
+		"profile":   map[string]interface{}{"email": first.Email, "phone": first.Phone},
+	})
+}
+
+func (c *UnionController) createDefaultUser(ctx context.Context, workflow *model.ProvisioningWorkflow) error {
+	defaultUser := workflow.Union.DefaultUser
+	return c.createTenantUser(ctx, workflow, "DEFAULT_USER", map[string]interface{}{
+		"unionID":   workflow.UnionID,
+		"username":  defaultUser.Username,
+		"password":  defaultUser.Password,
+		"firstName": workflow.Union.Name,
+		"lastName":  "Default",
+	})
+}
+
+const provisionUserMutation = `mutation($input: UserInput!, $role: ProvisionedUser!) {
+	provisionUser(input: $input, role: $role) { id }
+}`
+
+// createTenantUser creates a user through userService unless an earlier attempt
+// already did. Only a service can give a user a role, so the call carries the service
+// token.
+func (c *UnionController) createTenantUser(ctx context.Context, workflow *model.ProvisioningWorkflow, role string, input map[string]interface{}) error {
+	username, _ := input["username"].(string)
+	exists, err := c.TenantMongoRepository.UserExists(ctx, workflow.Slug, username)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+
+	var result struct {
+		ProvisionUser struct {
+			ID string `json:"id"`
+		} `json:"provisionUser"`
+	}
+	vars := map[string]interface{}{"input": input, "role": role}
+	users := c.graphqlManager.Endpoint(os.Getenv("GRAPHQL_ENDPOINT")).AsService()
+	if err := users.Execute(ctx, provisionUserMutation, vars, &result); err != nil {
+		return err
+	}
+	if result.ProvisionUser.ID == "" {
+		return fmt.Errorf("userService did not create %s", username)
+	}
+	return nil
+}
+
+func (c *UnionController) sendWelcomeEmail(ctx context.Context, workflow *model.ProvisioningWorkflow) error {
+	first := workflow.Union.FirstUser
+	body := email.GetWelcomeBody(first.FirstName, workflow.Union.Name, first.Email, os.Getenv("WELCOME_LINK"))
+
+	mailMutation, mailVars := graphqlclient.NewMutationBuilder().
+		SetMutationName("sendMail").
+		SetInputName("SendMailInput").
+		SetInput(map[string]interface{}{
+			"email":    first.Email,
+			"subject":  fmt.Sprintf("Welcome to %s", workflow.Union.Name),
+			"content":  body,
+			"category": "welcome",
+		}).
+		Build()
+	var result struct {
+		Response string `json:"sendMail"`
+	}
+	return c.graphqlManager.Endpoint(os.Getenv("Comm_GRAPHQL_ENDPOINT")).Execute(ctx, mailMutation, mailVars, &result)
+}
@@ -0,0 +1,39 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"younified-backend/contracts/union/model"
+	"younified-backend/providers/graphqlclient"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SetRetentionPolicy stores how long soft-deleted records of a union are kept. The
+// purge jobs of the owning services read it from the union document on every run.
+// Only the union's admins and backend services can change it.
+func (c *UnionController) SetRetentionPolicy(ctx context.Context, id primitive.ObjectID, policy model.RetentionPolicy) (*model.Union, error) {
+	if id.IsZero() {
+		err := fmt.Errorf("union id is required")
+		return nil, err
+	}
+	if !graphqlclient.IsService(ctx) {
+		if err := c.requireUnionAdmin(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+	if policy.DeletedUsersDays < 0 || policy.DeletedNewsDays < 0 || policy.DeletedCommentsDays < 0 {
+		err := fmt.Errorf("retention days cannot be negative")
+		return nil, err
+	}
+	policy.UpdatedAt = time.Now()
+
+	updatedUnion, err := c.UnionMongoRepository.SetRetentionPolicy(ctx, id, &policy)
+	if err != nil {
+		return nil, err
+	}
+	go c.UnionRedisRepository.InvalidateCache(context.Background(), updatedUnion.ID.Hex())
+	go c.UnionRedisRepository.InvalidateCache(context.Background(), updatedUnion.UnionID)
+	return updatedUnion, nil
+}
@@ -0,0 +1,176 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+	"younified-backend/contracts/union/model"
+	"younified-backend/providers/database"
+	email "younified-backend/providers/emailBodyProvider"
+	"younified-backend/services/unionService/internal/auth"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// UnionSettings returns every setting of the schema with the union's value
+func (c *UnionController) UnionSettings(ctx context.Context, id primitive.ObjectID) ([]*model.UnionSetting, error) {
+	if id.IsZero() {
+		err := fmt.Errorf("union id is required")
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, id); err != nil {
+		return nil, err
+	}
+	settings, err := c.SettingsMongoRepository.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return unionSettings(settings), nil
+}
+
+// UpdateUnionSettings changes settings of a union. Every change is checked against the
+// schema first, so a bad value leaves them all unsaved; the other services hear of the
+// change and reload.
+func (c *UnionController) UpdateUnionSettings(ctx context.Context, id primitive.ObjectID, inputs []*model.UnionSettingInput) ([]*model.UnionSetting, error) {
+	if id.IsZero() {
+		err := fmt.Errorf("union id is required")
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, id); err != nil {
+		return nil, err
+	}
+	if _, err := c.UnionMongoRepository.UnionById(ctx, id); err != nil {
+		return nil, err
+	}
+
+	changes := map[string]interface{}{}
+	for _, input := range inputs {
+		if input == nil {
+			continue
+		}
+		if _, ok := database.SettingDefinitionOf(input.Key); !ok {
+			err := fmt.Errorf("there is no setting %s", input.Key)
+			return nil, err
+		}
+		if _, repeated := changes[input.Key]; repeated {
+			err := fmt.Errorf("%s is changed twice", input.Key)
+			return nil, err
+		}
+		if input.Value == nil {
+			changes[input.Key] = nil
+			continue
+		}
+		value, err := database.ParseSetting(input.Key, *input.Value)
+		if err != nil {
+			return nil, err
+		}
+		changes[input.Key] = value
+	}
+
+	settings, err := c.SettingsMongoRepository.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(changes) == 0 {
+		return unionSettings(settings), nil
+	}
+	var updatedBy primitive.ObjectID
+	if claims := auth.ForContext(ctx); claims != nil {
+		updatedBy = claims.UserID
+	}
+	applySettings(settings, changes, updatedBy)
+	if err := c.SettingsMongoRepository.Save(ctx, settings); err != nil {
+		return nil, fmt.Errorf("could not save the union's settings: %v", err)
+	}
+	if err := c.settings.Invalidate(ctx, id); err != nil {
+		log.Printf("could not announce the new settings of union %s: %v", id.Hex(), err)
+	}
+	return unionSettings(settings), nil
+}
+
+// applySettings sets the changed overrides of settings; nil drops the override
+func applySettings(settings *model.UnionSettings, changes map[string]interface{}, updatedBy primitive.ObjectID) {
+	now := time.Now()
+	overrides := []*model.SettingOverride{}
+	for _, override := range settings.Overrides {
+		if _, changed := changes[override.Key]; !changed {
+			overrides = append(overrides, override)
+		}
+	}
+	for key, value := range changes {
+		if value == nil {
+			continue
+		}
+		overrides = append(overrides, &model.SettingOverride{Key: key, Value: value, UpdatedBy: updatedBy, UpdatedOn: now})
+	}
+	settings.Overrides = overrides
+}
+
+// unionSettings lists the schema with the values of settings
+func unionSettings(settings *model.UnionSettings) []*model.UnionSetting {
+	overrides := map[string]*model.SettingOverride{}
+	for _, override := range settings.Overrides {
+		overrides[override.Key] = override
+	}
+	list := []*model.UnionSetting{}
+	for _, definition := range database.SettingsSchema() {
+		setting := &model.UnionSetting{
+			Key:         definition.Key,
+			Type:        definition.Type,
+			Description: definition.Description,
+			Default:     database.FormatSetting(definition.Default),
+			Options:     definition.Options,
+		}
+		setting.Value = setting.Default
+		if definition.Min != nil {
+			minimum := int(*definition.Min)
+			setting.Min = &minimum
+		}
+		if definition.Max != nil {
+			maximum := int(*definition.Max)
+			setting.Max = &maximum
+		}
+		if definition.MaxLength > 0 {
+			maxLength := definition.MaxLength
+			setting.MaxLength = &maxLength
+		}
+		if override, ok := overrides[definition.Key]; ok {
+			setting.Value = database.FormatSetting(override.Value)
+			setting.Overridden = true
+			updatedOn := override.UpdatedOn
+			setting.UpdatedOn = &updatedOn
+		}
+		list = append(list, setting)
+	}
+	return list
+}
+
+// resetPasswordMigration moved the password reset email of the union whose database is
+// downsyndrome, which used to be hard-coded, into its settings
+const resetPasswordMigration = "resetPasswordTemplate"
+
+// MigrateSettings moves behaviour that used to be hard-coded for a union into its
+// settings. Each migration runs once per union, so a union can undo it afterwards.
+func (c *UnionController) MigrateSettings(ctx context.Context) error {
+	union, err := c.UnionMongoRepository.UnionBySlug(ctx, "downsyndrome")
+	if err != nil {
+		// no such union here
+		return nil
+	}
+	settings, err := c.SettingsMongoRepository.Get(ctx, union.ID)
+	if err != nil {
+		return err
+	}
+	for _, migration := range settings.Migrations {
+		if migration == resetPasswordMigration {
+			return nil
+		}
+	}
+	applySettings(settings, map[string]interface{}{database.SettingResetPasswordEmail: email.ResetPasswordDownSyndrome}, primitive.NilObjectID)
+	settings.Migrations = append(settings.Migrations, resetPasswordMigration)
+	if err := c.SettingsMongoRepository.Save(ctx, settings); err != nil {
+		return err
+	}
+	return c.settings.Invalidate(ctx, union.ID)
+}
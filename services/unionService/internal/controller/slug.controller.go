@@ -0,0 +1,239 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"younified-backend/contracts/union/model"
+	"younified-backend/services/unionService/internal/repository"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	minSlugLength = 3
+	maxSlugLength = 48
+	// slugAttempts bounds the numbered suffixes tried for a name that is taken
+	slugAttempts = 50
+)
+
+// reservedSlugs cannot name a union: they are paths of the web apps, or the names of
+// MongoDB's own databases, which a slug would become
+var reservedSlugs = map[string]bool{
+	"admin": true, "api": true, "app": true, "assets": true, "auth": true, "blog": true,
+	"config": true, "dashboard": true, "docs": true, "graphql": true, "help": true,
+	"local": true, "login": true, "logout": true, "mail": true, "news": true,
+	"public": true, "register": true, "signup": true, "static": true, "status": true,
+	"support": true, "system": true, "unified-base": true, "union": true, "unions": true,
+	"www": true, "younified": true,
+}
+
+// slugify turns a union name into a slug: lower case letters and digits, words joined
+// by single hyphens
+func slugify(name string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			hyphen = false
+			continue
+		}
+		hyphen = true
+	}
+	slug := b.String()
+	if len(slug) > maxSlugLength {
+		slug = strings.TrimRight(slug[:maxSlugLength], "-")
+	}
+	return slug
+}
+
+// checkSlug returns why slug cannot name a union, ignoring who has it
+func checkSlug(slug string) error {
+	if slug == "" {
+		return fmt.Errorf("slug is required")
+	}
+	if slugify(slug) != slug {
+		return fmt.Errorf("a slug can only have lower case letters, digits and single hyphens between them")
+	}
+	if len(slug) < minSlugLength || len(slug) > maxSlugLength {
+		return fmt.Errorf("a slug has %d to %d characters", minSlugLength, maxSlugLength)
+	}
+	if reservedSlugs[slug] {
+		return fmt.Errorf("%s is reserved", slug)
+	}
+	return nil
+}
+
+// slugTaken reports whether slug is another union's, as its slug, a former slug or the
+// name of its database
+func (c *UnionController) slugTaken(ctx context.Context, union *model.Union, slug string) (bool, error) {
+	id := primitive.NilObjectID
+	if union != nil {
+		id = union.ID
+		if slug == union.DatabaseName() {
+			return false, nil
+		}
+	}
+	taken, err := c.UnionMongoRepository.SlugTaken(ctx, id, slug)
+	if err != nil || taken {
+		return taken, err
+	}
+	return c.TenantMongoRepository.DatabaseExists(ctx, slug)
+}
+
+// availableSlug returns base, or base with the lowest numbered suffix that is free
+func (c *UnionController) availableSlug(ctx context.Context, base string) (string, error) {
+	if len(base) < minSlugLength {
+		base = strings.Trim("union-"+base, "-")
+	}
+	for i := 1; i <= slugAttempts; i++ {
+		slug := base
+		if i > 1 {
+			suffix := "-" + strconv.Itoa(i)
+			slug = strings.TrimRight(base[:min(len(base), maxSlugLength-len(suffix))], "-") + suffix
+		}
+		if reservedSlugs[slug] {
+			continue
+		}
+		taken, err := c.slugTaken(ctx, nil, slug)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return slug, nil
+		}
+	}
+	err := fmt.Errorf("no free slug left for %s, choose another name", base)
+	return "", err
+}
+
+// ResolveUnionSlug returns the union of slug, which may be one of its former slugs
+func (c *UnionController) ResolveUnionSlug(ctx context.Context, slug string) (*model.SlugResolution, error) {
+	slug = strings.ToLower(strings.TrimSpace(slug))
+	if slug == "" {
+		err := fmt.Errorf("slug is required")
+		return nil, err
+	}
+	union, err := c.UnionMongoRepository.UnionBySlug(ctx, slug)
+	if errors.Is(err, repository.ErrUnionNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &model.SlugResolution{
+		Union:    union,
+		Slug:     union.UnionID,
+		Redirect: union.UnionID != slug,
+	}, nil
+}
+
+// SlugAvailability tells whether slug could be given to a new union or a renamed one
+func (c *UnionController) SlugAvailability(ctx context.Context, slug string) (*model.SlugAvailability, error) {
+	availability := &model.SlugAvailability{Slug: slug}
+	if err := checkSlug(slug); err != nil {
+		availability.Reason = err.Error()
+	} else {
+		taken, err := c.slugTaken(ctx, nil, slug)
+		if err != nil {
+			return nil, err
+		}
+		if !taken {
+			availability.Available = true
+			return availability, nil
+		}
+		availability.Reason = fmt.Sprintf("%s is taken", slug)
+	}
+	if base := slugify(slug); base != "" {
+		if suggestion, err := c.availableSlug(ctx, base); err == nil {
+			availability.Suggestion = suggestion
+		}
+	}
+	return availability, nil
+}
+
+// ChangeUnionSlug gives a union a new slug. The old one stays as an alias, so links and
+// bookmarks keep working, and the tenant database keeps its name.
+func (c *UnionController) ChangeUnionSlug(ctx context.Context, id primitive.ObjectID, slug string) (*model.Union, error) {
+	if id.IsZero() {
+		err := fmt.Errorf("union id is required")
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, id); err != nil {
+		return nil, err
+	}
+	if err := checkSlug(slug); err != nil {
+		return nil, err
+	}
+	union, err := c.UnionMongoRepository.UnionById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if slug == union.UnionID {
+		return union, nil
+	}
+	taken, err := c.slugTaken(ctx, union, slug)
+	if err != nil {
+		return nil, err
+	}
+	if taken {
+		err := fmt.Errorf("%s is already taken", slug)
+		return nil, err
+	}
+
+	// taking back a former slug removes it from the aliases
+	aliases := []string{union.UnionID}
+	for _, alias := range union.SlugAliases {
+		if alias != slug && alias != union.UnionID {
+			aliases = append(aliases, alias)
+		}
+	}
+	updated, err := c.UnionMongoRepository.ChangeSlug(ctx, id, union.UnionID, slug, union.DatabaseName(), aliases)
+	if errors.Is(err, repository.ErrSlugTaken) {
+		err := fmt.Errorf("%s is already taken", slug)
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.invalidateUnion(updated)
+	return updated, nil
+}
+
+// RemoveUnionSlugAlias stops a former slug from resolving to the union, so another
+// union can have it
+func (c *UnionController) RemoveUnionSlugAlias(ctx context.Context, id primitive.ObjectID, slug string) (*model.Union, error) {
+	if id.IsZero() {
+		err := fmt.Errorf("union id is required")
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, id); err != nil {
+		return nil, err
+	}
+	union, err := c.UnionMongoRepository.UnionById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	known := false
+	for _, alias := range union.SlugAliases {
+		known = known || alias == slug
+	}
+	if !known {
+		err := fmt.Errorf("%s is not a former slug of the union", slug)
+		return nil, err
+	}
+	// invalidate while the alias is still listed, its cache entries go with it
+	c.invalidateUnion(union)
+	return c.UnionMongoRepository.RemoveSlugAlias(ctx, id, slug)
+}
+
+// EnsureSlugIndexes creates the indexes that keep slugs unique and their lookups fast
+func (c *UnionController) EnsureSlugIndexes(ctx context.Context) error {
+	return c.UnionMongoRepository.EnsureSlugIndexes(ctx)
+}
@@ -0,0 +1,385 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"log"
+	"os"
+	"regexp"
+	"unicode/utf8"
+	"younified-backend/contracts/union/model"
+	"younified-backend/providers/imaging"
+	"younified-backend/services/unionService/internal/auth"
+	"younified-backend/services/unionService/internal/repository"
+
+	"github.com/99designs/gqlgen/graphql"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// themeImageMaxBytes bounds a theme image upload
+	themeImageMaxBytes int64 = 5 << 20
+	// themeImageMinSide is the smallest side a theme image can have
+	themeImageMinSide = 32
+	// themeIconMinSide is the smallest app icon the mobile clients can use
+	themeIconMinSide = 192
+	// themeTextMaxLength bounds the email header and footer
+	themeTextMaxLength = 2000
+	// themeNoteMaxLength bounds the note of a version
+	themeNoteMaxLength = 500
+)
+
+var (
+	themeColour = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+	themeFont   = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9 \-]{0,63}$`)
+)
+
+// themeImageMaxSide is the longest side an image of a slot is stored at; larger
+// uploads are scaled down
+var themeImageMaxSide = map[string]int{
+	model.ThemeImageLogo:        1024,
+	model.ThemeImageLogoInverse: 1024,
+	model.ThemeImageIcon:        1024,
+	model.ThemeImageBanner:      2400,
+	model.ThemeImageEmailHeader: 1200,
+}
+
+// themeImagePNG are the slots stored as PNG to keep their transparency; the others are
+// photos and stored as JPEG
+var themeImagePNG = map[string]bool{
+	model.ThemeImageLogo:        true,
+	model.ThemeImageLogoInverse: true,
+	model.ThemeImageIcon:        true,
+}
+
+// EnsureThemeIndexes creates the indexes the theme versions rely on
+func (c *UnionController) EnsureThemeIndexes(ctx context.Context) error {
+	return c.ThemeMongoRepository.EnsureIndexes(ctx)
+}
+
+// UnionTheme returns the current theme of the union with slug. It is public: the
+// white-label clients style themselves with it before anyone signs in.
+func (c *UnionController) UnionTheme(ctx context.Context, slug string) (*model.UnionTheme, error) {
+	if slug == "" {
+		err := fmt.Errorf("slug is required")
+		return nil, err
+	}
+	if theme, err := c.UnionRedisRepository.GetThemeFromCache(ctx, slug); err == nil && theme != nil {
+		return theme, nil
+	}
+	union, err := c.UnionMongoRepository.UnionBySlug(ctx, slug)
+	if errors.Is(err, repository.ErrUnionNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	theme, err := c.currentTheme(ctx, union)
+	if err != nil {
+		return nil, err
+	}
+	theme = theme.Public()
+	go c.UnionRedisRepository.CacheTheme(context.Background(), slug, theme)
+	return theme, nil
+}
+
+// UnionThemeVersions returns every saved version of a union's theme, newest first
+func (c *UnionController) UnionThemeVersions(ctx context.Context, unionID primitive.ObjectID) ([]*model.UnionTheme, error) {
+	if _, err := c.themeUnion(ctx, unionID); err != nil {
+		return nil, err
+	}
+	return c.ThemeMongoRepository.Versions(ctx, unionID)
+}
+
+// UpdateUnionTheme saves a new version of the theme with the changed parts of input
+func (c *UnionController) UpdateUnionTheme(ctx context.Context, unionID primitive.ObjectID, input model.UnionThemeInput) (*model.UnionTheme, error) {
+	union, err := c.themeUnion(ctx, unionID)
+	if err != nil {
+		return nil, err
+	}
+	theme, err := c.currentTheme(ctx, union)
+	if err != nil {
+		return nil, err
+	}
+	next := copyTheme(theme)
+	if input.Palette != nil {
+		next.Palette = *input.Palette
+	}
+	if input.Fonts != nil {
+		next.Fonts = *input.Fonts
+	}
+	if input.Email != nil {
+		next.Email = *input.Email
+	}
+	next.Note = ""
+	if input.Note != nil {
+		next.Note = *input.Note
+	}
+	if err := validateTheme(next); err != nil {
+		return nil, err
+	}
+	return c.saveTheme(ctx, union, theme, next)
+}
+
+// UploadThemeImage validates an image and saves a new version of the theme with it in
+// slot
+func (c *UnionController) UploadThemeImage(ctx context.Context, unionID primitive.ObjectID, slot string, file graphql.Upload) (*model.UnionTheme, error) {
+	if !model.KnownThemeImage(slot) {
+		err := fmt.Errorf("unknown theme image %s", slot)
+		return nil, err
+	}
+	union, err := c.themeUnion(ctx, unionID)
+	if err != nil {
+		return nil, err
+	}
+	if c.awsProvider == nil {
+		return nil, fmt.Errorf("image storage is not configured")
+	}
+
+	data, err := imaging.Read(file.File, themeImageMaxBytes)
+	if err != nil {
+		return nil, err
+	}
+	img, _, err := imaging.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkThemeImage(slot, img); err != nil {
+		return nil, err
+	}
+	img = imaging.Fit(img, themeImageMaxSide[slot])
+
+	encode, extension := imaging.EncodeJPEG, "jpg"
+	if themeImagePNG[slot] {
+		encode, extension = imaging.EncodePNG, "png"
+	}
+	encoded, err := encode(img)
+	if err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf("themes/%s/%s-%s.%s", unionID.Hex(), slot, primitive.NewObjectID().Hex(), extension)
+	url, err := c.awsProvider.UploadToS3(ctx, os.Getenv("AWS_S3_BUCKET"), os.Getenv("AWS_REGION"), key, encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	theme, err := c.currentTheme(ctx, union)
+	if err != nil {
+		c.deleteThemeObject(ctx, key)
+		return nil, err
+	}
+	next := copyTheme(theme)
+	next.Note = fmt.Sprintf("new %s", slot)
+	bounds := img.Bounds()
+	next.Images = append(withoutImage(next.Images, slot), &model.ThemeImage{
+		Slot:   slot,
+		URL:    *url,
+		Key:    key,
+		Width:  bounds.Dx(),
+		Height: bounds.Dy(),
+	})
+	saved, err := c.saveTheme(ctx, union, theme, next)
+	if err != nil {
+		c.deleteThemeObject(ctx, key)
+		return nil, err
+	}
+	return saved, nil
+}
+
+// RemoveThemeImage saves a new version of the theme without the image in slot. The
+// object stays in the bucket for the earlier versions.
+func (c *UnionController) RemoveThemeImage(ctx context.Context, unionID primitive.ObjectID, slot string) (*model.UnionTheme, error) {
+	if !model.KnownThemeImage(slot) {
+		err := fmt.Errorf("unknown theme image %s", slot)
+		return nil, err
+	}
+	union, err := c.themeUnion(ctx, unionID)
+	if err != nil {
+		return nil, err
+	}
+	theme, err := c.currentTheme(ctx, union)
+	if err != nil {
+		return nil, err
+	}
+	if theme.Image(slot) == nil {
+		return theme, nil
+	}
+	next := copyTheme(theme)
+	next.Images = withoutImage(next.Images, slot)
+	next.Note = fmt.Sprintf("removed %s", slot)
+	return c.saveTheme(ctx, union, theme, next)
+}
+
+// RollbackUnionTheme saves a copy of an earlier version as the current theme, so the
+// history keeps what was rolled back
+func (c *UnionController) RollbackUnionTheme(ctx context.Context, unionID primitive.ObjectID, version int) (*model.UnionTheme, error) {
+	union, err := c.themeUnion(ctx, unionID)
+	if err != nil {
+		return nil, err
+	}
+	earlier, err := c.ThemeMongoRepository.Version(ctx, unionID, version)
+	if err != nil {
+		return nil, err
+	}
+	if earlier == nil {
+		err := fmt.Errorf("the theme has no version %d", version)
+		return nil, err
+	}
+	theme, err := c.currentTheme(ctx, union)
+	if err != nil {
+		return nil, err
+	}
+	next := copyTheme(earlier)
+	next.Note = fmt.Sprintf("rolled back to version %d", version)
+	return c.saveTheme(ctx, union, theme, next)
+}
+
+// themeUnion loads a union whose theme the caller may change
+func (c *UnionController) themeUnion(ctx context.Context, unionID primitive.ObjectID) (*model.Union, error) {
+	if unionID.IsZero() {
+		err := fmt.Errorf("union id is required")
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	return c.UnionMongoRepository.UnionById(ctx, unionID)
+}
+
+// currentTheme returns the latest version of a union's theme. A union without one gets
+// version 0, made of the images set on the union before themes were versioned.
+func (c *UnionController) currentTheme(ctx context.Context, union *model.Union) (*model.UnionTheme, error) {
+	theme, err := c.ThemeMongoRepository.Current(ctx, union.ID)
+	if err != nil {
+		return nil, err
+	}
+	if theme != nil {
+		return theme, nil
+	}
+	theme = &model.UnionTheme{UnionID: union.ID, Images: []*model.ThemeImage{}}
+	logo := union.ThemeImage
+	if logo == "" {
+		logo = union.Information.ImageURL
+	}
+	if logo != "" {
+		theme.Images = append(theme.Images, &model.ThemeImage{Slot: model.ThemeImageLogo, URL: logo})
+	}
+	if union.BannerURL != "" {
+		theme.Images = append(theme.Images, &model.ThemeImage{Slot: model.ThemeImageBanner, URL: union.BannerURL})
+	}
+	return theme, nil
+}
+
+// saveTheme stores next as the version after current and points the union's image
+// fields at its images
+func (c *UnionController) saveTheme(ctx context.Context, union *model.Union, current, next *model.UnionTheme) (*model.UnionTheme, error) {
+	next.UnionID = union.ID
+	next.Version = current.Version + 1
+	if claims := auth.ForContext(ctx); claims != nil {
+		createdBy := claims.UserID
+		next.CreatedBy = &createdBy
+	}
+	saved, err := c.ThemeMongoRepository.Create(ctx, next)
+	if err != nil {
+		return nil, err
+	}
+
+	logoURL, bannerURL := "", ""
+	if logo := saved.Image(model.ThemeImageLogo); logo != nil {
+		logoURL = logo.URL
+	}
+	if banner := saved.Image(model.ThemeImageBanner); banner != nil {
+		bannerURL = banner.URL
+	}
+	if err := c.UnionMongoRepository.SetBranding(ctx, union.ID, logoURL, bannerURL); err != nil {
+		log.Printf("could not update the branding of union %s: %v", union.UnionID, err)
+	}
+	c.invalidateUnion(union)
+	return saved, nil
+}
+
+func (c *UnionController) deleteThemeObject(ctx context.Context, key string) {
+	if err := c.awsProvider.DeleteS3Object(ctx, os.Getenv("AWS_S3_BUCKET"), key); err != nil {
+		log.Printf("could not delete theme image %s: %v", key, err)
+	}
+}
+
+func validateTheme(theme *model.UnionTheme) error {
+	colours := map[string]string{
+		"primary":    theme.Palette.Primary,
+		"secondary":  theme.Palette.Secondary,
+		"accent":     theme.Palette.Accent,
+		"background": theme.Palette.Background,
+		"text":       theme.Palette.Text,
+	}
+	for name, colour := range colours {
+		if colour != "" && !themeColour.MatchString(colour) {
+			err := fmt.Errorf("the %s colour %q is not a #rrggbb colour", name, colour)
+			return err
+		}
+	}
+	for _, font := range []string{theme.Fonts.Heading, theme.Fonts.Body} {
+		if font != "" && !themeFont.MatchString(font) {
+			err := fmt.Errorf("%q is not a font family name", font)
+			return err
+		}
+	}
+	if utf8.RuneCountInString(theme.Email.HeaderText) > themeTextMaxLength || utf8.RuneCountInString(theme.Email.FooterText) > themeTextMaxLength {
+		err := fmt.Errorf("the email header and footer are limited to %d characters", themeTextMaxLength)
+		return err
+	}
+	if utf8.RuneCountInString(theme.Note) > themeNoteMaxLength {
+		err := fmt.Errorf("the note is limited to %d characters", themeNoteMaxLength)
+		return err
+	}
+	return nil
+}
+
+func checkThemeImage(slot string, img image.Image) error {
+	bounds := img.Bounds()
+	if bounds.Dx() < themeImageMinSide || bounds.Dy() < themeImageMinSide {
+		err := fmt.Errorf("the image must be at least %dx%d pixels", themeImageMinSide, themeImageMinSide)
+		return err
+	}
+	if slot == model.ThemeImageIcon {
+		if bounds.Dx() != bounds.Dy() {
+			err := fmt.Errorf("the icon must be square")
+			return err
+		}
+		if bounds.Dx() < themeIconMinSide {
+			err := fmt.Errorf("the icon must be at least %dx%d pixels", themeIconMinSide, themeIconMinSide)
+			return err
+		}
+	}
+	return nil
+}
+
+// copyTheme returns a new, unsaved version with the content of theme
+func copyTheme(theme *model.UnionTheme) *model.UnionTheme {
+	next := &model.UnionTheme{
+		UnionID: theme.UnionID,
+		Palette: theme.Palette,
+		Fonts:   theme.Fonts,
+		Email:   theme.Email,
+		Images:  []*model.ThemeImage{},
+	}
+	for _, image := range theme.Images {
+		if image != nil {
+			copied := *image
+			next.Images = append(next.Images, &copied)
+		}
+	}
+	return next
+}
+
+func withoutImage(images []*model.ThemeImage, slot string) []*model.ThemeImage {
+	kept := []*model.ThemeImage{}
+	for _, image := range images {
+		if image != nil && image.Slot != slot {
+			kept = append(kept, image)
+		}
+	}
+	return kept
+}
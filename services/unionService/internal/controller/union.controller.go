@@ -3,11 +3,10 @@ package controllers
 import (
 	"context"
 	"fmt"
-	"os"
 	"regexp"
 	"strings"
 	"younified-backend/contracts/union/model"
-	userModel "younified-backend/contracts/user/model"
+	"younified-backend/providers/aws"
 	"younified-backend/providers/database"
 	"younified-backend/providers/graphqlclient"
 	"younified-backend/services/unionService/internal/repository"
@@ -16,22 +15,40 @@ import (
 )
 
 type UnionController struct {
-	UnionMongoRepository *repository.MongoUnionRepository
-	UnionRedisRepository *repository.RedisUnionRepository
-	dbManager            *database.DBManager
-	graphqlManager       *graphqlclient.Graph
+	UnionMongoRepository        *repository.MongoUnionRepository
+	UnionRedisRepository        *repository.RedisUnionRepository
+	ProvisioningMongoRepository *repository.MongoProvisioningRepository
+	TenantMongoRepository       *repository.MongoTenantRepository
+	BargainingMongoRepository   *repository.MongoBargainingRepository
+	ThemeMongoRepository        *repository.MongoThemeRepository
+	SettingsMongoRepository     *repository.MongoSettingsRepository
+	dbManager                   *database.DBManager
+	graphqlManager              *graphqlclient.Graph
+	awsProvider                 *aws.AWSProvider
+	entitlements                *database.Entitlements
+	domains                     *database.DomainIndex
+	settings                    *database.Settings
 }
 
-func NewUnionController(dbManager *database.DBManager, graphqlManager *graphqlclient.Graph, redisClient *database.RedisClient) *UnionController {
+func NewUnionController(dbManager *database.DBManager, graphqlManager *graphqlclient.Graph, redisClient *database.RedisClient, awsProvider *aws.AWSProvider) *UnionController {
 	if dbManager == nil {
 		panic("dbManager cannot be nil")
 	}
 
 	return &UnionController{
-		UnionMongoRepository: repository.NewMongoUnionRepository(dbManager, "unified_base"),
-		UnionRedisRepository: repository.NewRedisUnionRepository(redisClient),
-		dbManager:            dbManager,
-		graphqlManager:       graphqlManager,
+		UnionMongoRepository:        repository.NewMongoUnionRepository(dbManager, "unified_base"),
+		UnionRedisRepository:        repository.NewRedisUnionRepository(redisClient),
+		ProvisioningMongoRepository: repository.NewMongoProvisioningRepository(dbManager),
+		TenantMongoRepository:       repository.NewMongoTenantRepository(dbManager),
+		BargainingMongoRepository:   repository.NewMongoBargainingRepository(dbManager),
+		ThemeMongoRepository:        repository.NewMongoThemeRepository(dbManager),
+		SettingsMongoRepository:     repository.NewMongoSettingsRepository(dbManager),
+		dbManager:                   dbManager,
+		graphqlManager:              graphqlManager,
+		awsProvider:                 awsProvider,
+		entitlements:                database.NewEntitlements(dbManager, redisClient),
+		domains:                     database.NewDomainIndex(dbManager, redisClient),
+		settings:                    database.NewSettings(dbManager, redisClient),
 	}
 }
 
@@ -54,13 +71,14 @@ func (c *UnionController) UnionByID(ctx context.Context, id primitive.ObjectID)
 func (c *UnionController) UnionByName(ctx context.Context, name string) (*model.Union, error) {
 	space := regexp.MustCompile(`\s+`)
 	name = space.ReplaceAllString(strings.TrimSpace(name), " ")
-	lower := strings.ToLower(name)
-	unionSlug := strings.ReplaceAll(lower, " ", "-")
-	nameRegex := `^` + name + `$`
+	unionSlug := slugify(name)
 	union, err := c.UnionRedisRepository.GetUnionFromCache(ctx, unionSlug)
 	if err != nil {
-		// get from db, cache and return
-		union, err := c.UnionMongoRepository.UnionByName(ctx, nameRegex)
+		// get from db, cache and return; the slug is indexed, the name only a fallback
+		union, err := c.UnionMongoRepository.UnionBySlug(ctx, unionSlug)
+		if err != nil {
+			union, err = c.UnionMongoRepository.UnionByName(ctx, name)
+		}
 		if err != nil {
 			return nil, fmt.Errorf("error resolving data from mongodb")
 		}
@@ -97,63 +115,57 @@ func (c *UnionController) Unions(ctx context.Context, page, limit int) (*model.U
 
 }
 
+// Register validates a new union and starts its provisioning workflow, which runs
+// in the background; provisioningStatus shows its progress
 func (c *UnionController) Register(ctx context.Context, input model.RegisterInput) (*model.Union, error) {
 	space := regexp.MustCompile(`\s+`)
-	input.Union.UnionID = strings.ToLower(
-		space.ReplaceAllString(strings.TrimSpace(input.Union.Name), "-"),
-	)
-	firstUser := &model.FirstUserInfo{
+	name := space.ReplaceAllString(strings.TrimSpace(input.Union.Name), " ")
+	if name == "" {
+		err := fmt.Errorf("union name is required")
+		return nil, err
+	}
+	if input.User.Email == "" || input.User.Password == "" {
+		err := fmt.Errorf("the first user needs an email and a password")
+		return nil, err
+	}
+	if input.DefaultUser.Username == "" || input.DefaultUser.Password == "" {
+		err := fmt.Errorf("the default user needs a username and a password")
+		return nil, err
+	}
+	input.Union.Name = name
+	slug, err := c.availableSlug(ctx, slugify(name))
+	if err != nil {
+		return nil, err
+	}
+	input.Union.UnionID = slug
+
+	input.Union.ID = primitive.NewObjectID()
+	input.Union.FirstUser = model.FirstUserInfo{
 		FirstName:   input.User.FirstName,
 		LastName:    input.User.LastName,
 		Email:       input.User.Email,
+		Password:    input.User.Password,
 		Phone:       input.User.Phone,
 		Position:    input.User.Position,
 		DateOfBirth: input.User.DateOfBirth,
 	}
-
-	defaultUser := &model.DefaultUserInfo{
+	input.Union.DefaultUser = model.DefaultUserInfo{
 		Username: input.DefaultUser.Username,
 		Password: input.DefaultUser.Password,
 		Level:    input.DefaultUser.Level,
 	}
-
-	union, _ := c.UnionMongoRepository.Register(ctx, &input.Union, firstUser, defaultUser)
-	// cache unionByID & unionByName
-	go c.UnionRedisRepository.CacheUnion(ctx, union.ID.Hex(), union)
-	go c.UnionRedisRepository.CacheUnion(ctx, union.UnionID, union)
-
-	// invalidate cache for all-unions
-	_, err := c.UnionRedisRepository.CacheExists(ctx, "all-unions")
-	if err == nil {
-		// invalidate if cache exists
-		go c.UnionRedisRepository.InvalidateCache(ctx, "all-unions")
-	}
-
-	mutationInput := map[string]interface{}{
-		"unionID":   union.ID,
-		"username":  input.Union.FirstUser.Email,
-		"password":  input.Union.FirstUser.Password,
-		"firstName": input.User.FirstName,
-		"lastName":  input.User.LastName,
+	input.Union.Modules = []*string{}
+	for _, module := range model.DefaultModules {
+		module := module
+		input.Union.Modules = append(input.Union.Modules, &module)
 	}
 
-	gqlEP := os.Getenv("GRAPHQL_ENDPOINT")
-	c.graphqlManager.SetgqlEndpoint(gqlEP)
-	userMutationBuilder := c.graphqlManager.GetMutationBuilder()
-
-	// Create a user
-	createUserMutation, createVars := userMutationBuilder.
-		SetMutationName("createUser").
-		SetInputName("UserInput").
-		SetInput(mutationInput).
-		AddField("id").
-		Build()
-	var result struct {
-		RegisterUser *userModel.User `json:"registerUser"`
+	workflow, err := c.ProvisioningMongoRepository.CreateWorkflow(ctx, newProvisioningWorkflow(&input.Union))
+	if err != nil {
+		return nil, fmt.Errorf("could not start provisioning: %v", err)
 	}
-
-	go c.graphqlManager.Execute(ctx, createUserMutation, createVars, &result)
-	return union, nil
+	go c.runProvisioning(context.Background(), workflow.ID)
+	return &input.Union, nil
 }
 
 func (c *UnionController) ModifyUnion(ctx context.Context, id primitive.ObjectID, union model.Union) (*model.Union, error) {
@@ -176,17 +188,17 @@ func (c *UnionController) ModifyUnion(ctx context.Context, id primitive.ObjectID
 	return updatedUnion, nil
 }
 
-func (c *UnionController) DeleteUnion(ctx context.Context, id primitive.ObjectID) (*bool, error) {
-	// remove from cache
-	go c.UnionRedisRepository.InvalidateCache(ctx, id.Hex())
-	// remove from DB
-	err := c.UnionMongoRepository.DeleteUnion(ctx, id)
-	if err != nil {
-		return boolPtr(false), err
-	}
-	return boolPtr(true), nil
-}
-
 func boolPtr(b bool) *bool {
 	return &b
 }
+
+func (c *UnionController) invalidateUnion(union *model.Union) {
+	go c.UnionRedisRepository.InvalidateCache(context.Background(), union.ID.Hex())
+	go c.UnionRedisRepository.InvalidateCache(context.Background(), "all-unions")
+	// the union is cached under each slug it is looked up by, former ones included
+	for _, slug := range append([]string{union.UnionID}, union.SlugAliases...) {
+		go c.UnionRedisRepository.InvalidateCache(context.Background(), slug)
+		go c.UnionRedisRepository.InvalidateCache(context.Background(), repository.ThemeKey(slug))
+		go c.UnionRedisRepository.InvalidateCache(context.Background(), repository.ProfileKey(slug))
+	}
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+	union "younified-backend/contracts/union/model"
+	"younified-backend/providers/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// BargainingUnitCollection holds the units of every union in the base database;
+// cmsService reads it to check news targeting
+const BargainingUnitCollection = "bargaining_units"
+
+type MongoBargainingRepository struct {
+	dbManager *database.DBManager
+}
+
+func NewMongoBargainingRepository(dbManager *database.DBManager) *MongoBargainingRepository {
+	return &MongoBargainingRepository{
+		dbManager: dbManager,
+	}
+}
+
+func (r *MongoBargainingRepository) collection(ctx context.Context) *mongo.Collection {
+	return r.dbManager.GetBaseDatabase(ctx).Collection(BargainingUnitCollection)
+}
+
+func (r *MongoBargainingRepository) Create(ctx context.Context, unit *union.BargainingUnit) (*union.BargainingUnit, error) {
+	unit.ID = primitive.NewObjectID()
+	unit.CreatedOn = time.Now()
+	unit.UpdatedOn = unit.CreatedOn
+	if _, err := r.collection(ctx).InsertOne(ctx, unit); err != nil {
+		return nil, err
+	}
+	return unit, nil
+}
+
+func (r *MongoBargainingRepository) Update(ctx context.Context, unit *union.BargainingUnit) (*union.BargainingUnit, error) {
+	unit.UpdatedOn = time.Now()
+	_, err := r.collection(ctx).ReplaceOne(ctx, bson.M{"_id": unit.ID, "unionID": unit.UnionID}, unit)
+	if err != nil {
+		return nil, err
+	}
+	return unit, nil
+}
+
+func (r *MongoBargainingRepository) Delete(ctx context.Context, unionID, id primitive.ObjectID) error {
+	_, err := r.collection(ctx).DeleteOne(ctx, bson.M{"_id": id, "unionID": unionID})
+	return err
+}
+
+// Get returns a unit of the union, or nil when it has none with that id
+func (r *MongoBargainingRepository) Get(ctx context.Context, unionID, id primitive.ObjectID) (*union.BargainingUnit, error) {
+	return r.findOne(ctx, bson.M{"_id": id, "unionID": unionID})
+}
+
+// GetByID returns a unit whatever its union, for the gateway's entity lookups
+func (r *MongoBargainingRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*union.BargainingUnit, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+// FindByName matches a unit name case-insensitively
+func (r *MongoBargainingRepository) FindByName(ctx context.Context, unionID primitive.ObjectID, name string) (*union.BargainingUnit, error) {
+	opts := options.FindOne().SetCollation(&options.Collation{Locale: "en", Strength: 2})
+	var unit union.BargainingUnit
+	err := r.collection(ctx).FindOne(ctx, bson.M{"unionID": unionID, "name": name}, opts).Decode(&unit)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &unit, nil
+}
+
+// List returns the units of a union by name
+func (r *MongoBargainingRepository) List(ctx context.Context, unionID primitive.ObjectID) ([]*union.BargainingUnit, error) {
+	opts := options.Find().SetSort(bson.M{"name": 1})
+	cursor, err := r.collection(ctx).Find(ctx, bson.M{"unionID": unionID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	units := []*union.BargainingUnit{}
+	if err := cursor.All(ctx, &units); err != nil {
+		return nil, err
+	}
+	return units, nil
+}
+
+// HasChildren reports whether a unit has sub-units
+func (r *MongoBargainingRepository) HasChildren(ctx context.Context, unionID, id primitive.ObjectID) (bool, error) {
+	count, err := r.collection(ctx).CountDocuments(ctx, bson.M{"unionID": unionID, "parentID": id})
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *MongoBargainingRepository) findOne(ctx context.Context, filter bson.M) (*union.BargainingUnit, error) {
+	var unit union.BargainingUnit
+	err := r.collection(ctx).FindOne(ctx, filter).Decode(&unit)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &unit, nil
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+	union "younified-backend/contracts/union/model"
+	"younified-backend/providers/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const provisioningCollection = "provisioning_workflows"
+
+// unfinished matches the workflows a service still has to run
+var unfinished = bson.M{"$in": []string{union.ProvisioningPending, union.ProvisioningRunning}}
+
+type MongoProvisioningRepository struct {
+	dbManager *database.DBManager
+}
+
+func NewMongoProvisioningRepository(dbManager *database.DBManager) *MongoProvisioningRepository {
+	return &MongoProvisioningRepository{
+		dbManager: dbManager,
+	}
+}
+
+func (r *MongoProvisioningRepository) CreateWorkflow(ctx context.Context, workflow *union.ProvisioningWorkflow) (*union.ProvisioningWorkflow, error) {
+	collection := r.dbManager.GetBaseDatabase(ctx).Collection(provisioningCollection)
+	workflow.ID = primitive.NewObjectID()
+	workflow.CreatedOn = time.Now()
+	workflow.UpdatedOn = workflow.CreatedOn
+	if _, err := collection.InsertOne(ctx, workflow); err != nil {
+		return nil, err
+	}
+	return workflow, nil
+}
+
+// SaveWorkflow stores the progress of a workflow and extends the lease of the
+// instance running it
+func (r *MongoProvisioningRepository) SaveWorkflow(ctx context.Context, workflow *union.ProvisioningWorkflow, lease time.Duration) error {
+	collection := r.dbManager.GetBaseDatabase(ctx).Collection(provisioningCollection)
+	workflow.UpdatedOn = time.Now()
+	workflow.LeaseUntil = workflow.UpdatedOn.Add(lease)
+	_, err := collection.ReplaceOne(ctx, bson.M{"_id": workflow.ID}, workflow)
+	return err
+}
+
+// ClaimWorkflow takes the lease of an unfinished workflow no other instance is
+// running; it returns nil when there is nothing to claim
+func (r *MongoProvisioningRepository) ClaimWorkflow(ctx context.Context, id primitive.ObjectID, lease time.Duration) (*union.ProvisioningWorkflow, error) {
+	collection := r.dbManager.GetBaseDatabase(ctx).Collection(provisioningCollection)
+	now := time.Now()
+	filter := bson.M{"_id": id, "status": unfinished, "leaseUntil": bson.M{"$lt": now}}
+	update := bson.M{"$set": bson.M{"leaseUntil": now.Add(lease)}}
+	var workflow union.ProvisioningWorkflow
+	err := collection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&workflow)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &workflow, nil
+}
+
+// Stalled returns the unfinished workflows whose lease ran out, left behind by an
+// instance that stopped
+func (r *MongoProvisioningRepository) Stalled(ctx context.Context) ([]primitive.ObjectID, error) {
+	collection := r.dbManager.GetBaseDatabase(ctx).Collection(provisioningCollection)
+	filter := bson.M{"status": unfinished, "leaseUntil": bson.M{"$lt": time.Now()}}
+	cursor, err := collection.Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	ids := make([]primitive.ObjectID, 0, len(docs))
+	for _, doc := range docs {
+		ids = append(ids, doc.ID)
+	}
+	return ids, nil
+}
+
+// LatestWorkflow returns the most recent workflow of a union
+func (r *MongoProvisioningRepository) LatestWorkflow(ctx context.Context, unionID primitive.ObjectID) (*union.ProvisioningWorkflow, error) {
+	collection := r.dbManager.GetBaseDatabase(ctx).Collection(provisioningCollection)
+	var workflow union.ProvisioningWorkflow
+	err := collection.FindOne(ctx, bson.M{"unionID": unionID}, options.FindOne().SetSort(bson.M{"createdOn": -1})).Decode(&workflow)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.New("no provisioning found for the given union")
+		}
+		return nil, err
+	}
+	return &workflow, nil
+}
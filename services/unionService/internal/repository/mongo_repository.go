@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"regexp"
 	"time"
 	union "younified-backend/contracts/union/model"
 	"younified-backend/providers/database"
@@ -46,6 +47,21 @@ func (r *MongoUnionRepository) Register(ctx context.Context, unionData *union.Un
 	return unionData, nil
 }
 
+// DropUserPasswords unsets the passwords of the first and the default user of every union
+func (r *MongoUnionRepository) DropUserPasswords(ctx context.Context) (int64, error) {
+	unionCollection := r.dbManager.GetBaseDatabase(ctx).Collection("unions")
+	filter := bson.M{"$or": bson.A{
+		bson.M{"firstUser.password": bson.M{"$exists": true}},
+		bson.M{"defaultUser.password": bson.M{"$exists": true}},
+	}}
+	update := bson.M{"$unset": bson.M{"firstUser.password": "", "defaultUser.password": ""}}
+	result, err := unionCollection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
 // DeleteUnion permanently removes a Union from the database
 func (r *MongoUnionRepository) DeleteUnion(ctx context.Context, unionID primitive.ObjectID) error {
 	// Perform a hard delete
@@ -64,6 +80,97 @@ func (r *MongoUnionRepository) DeleteUnion(ctx context.Context, unionID primitiv
 	return nil
 }
 
+// SoftDeleteUnion marks a union deleted and queues it for the archive job
+func (r *MongoUnionRepository) SoftDeleteUnion(ctx context.Context, unionID primitive.ObjectID) (*union.Union, error) {
+	unifiedDB := r.dbManager.GetBaseDatabase(ctx)
+	unionCollection := unifiedDB.Collection("unions")
+	filter := bson.M{"_id": unionID, "deleted": bson.M{"$ne": true}}
+	update := bson.M{
+		"$set": bson.M{"deleted": true, "deletedAt": time.Now(), "archiveStatus": union.ArchivePending},
+	}
+
+	var deletedUnion union.Union
+	err := unionCollection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&deletedUnion)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.New("no live union found with the given ID")
+		}
+		return nil, err
+	}
+	return &deletedUnion, nil
+}
+
+// ClaimArchive takes the next deleted union deleted before cutoff for archiving. A
+// union left archiving since before stale, by a stopped instance, is taken again.
+func (r *MongoUnionRepository) ClaimArchive(ctx context.Context, cutoff time.Time, stale time.Time) (*union.Union, error) {
+	unifiedDB := r.dbManager.GetBaseDatabase(ctx)
+	unionCollection := unifiedDB.Collection("unions")
+	now := time.Now()
+	filter := bson.M{
+		"deleted": true,
+		"$or": []bson.M{
+			{"archiveStatus": union.ArchivePending, "deletedAt": bson.M{"$lte": cutoff}},
+			{"archiveStatus": union.ArchiveRunning, "archive.startedOn": bson.M{"$lt": stale}},
+		},
+	}
+	update := bson.M{"$set": bson.M{"archiveStatus": union.ArchiveRunning, "archive.startedOn": now}}
+
+	var claimed union.Union
+	err := unionCollection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&claimed)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &claimed, nil
+}
+
+// SetArchive records the archive of a deleted union and where it stands
+func (r *MongoUnionRepository) SetArchive(ctx context.Context, unionID primitive.ObjectID, status string, archive *union.UnionArchive) error {
+	unifiedDB := r.dbManager.GetBaseDatabase(ctx)
+	unionCollection := unifiedDB.Collection("unions")
+	update := bson.M{"$set": bson.M{"archiveStatus": status, "archive": archive}}
+	_, err := unionCollection.UpdateOne(ctx, bson.M{"_id": unionID}, update)
+	return err
+}
+
+// ClaimRestore moves a deleted union from status to restoring, so only one restore
+// runs; it returns nil when the union is not in that status
+func (r *MongoUnionRepository) ClaimRestore(ctx context.Context, unionID primitive.ObjectID, status string) (*union.Union, error) {
+	unifiedDB := r.dbManager.GetBaseDatabase(ctx)
+	unionCollection := unifiedDB.Collection("unions")
+	filter := bson.M{"_id": unionID, "deleted": true, "archiveStatus": status}
+	update := bson.M{"$set": bson.M{"archiveStatus": union.ArchiveRestoring}}
+
+	var claimed union.Union
+	err := unionCollection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&claimed)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &claimed, nil
+}
+
+// Undelete makes a restored union live again
+func (r *MongoUnionRepository) Undelete(ctx context.Context, unionID primitive.ObjectID, archive *union.UnionArchive) (*union.Union, error) {
+	unifiedDB := r.dbManager.GetBaseDatabase(ctx)
+	unionCollection := unifiedDB.Collection("unions")
+	update := bson.M{
+		"$set":   bson.M{"deleted": false, "archive": archive},
+		"$unset": bson.M{"deletedAt": "", "archiveStatus": ""},
+	}
+
+	var restored union.Union
+	err := unionCollection.FindOneAndUpdate(ctx, bson.M{"_id": unionID}, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&restored)
+	if err != nil {
+		return nil, err
+	}
+	return &restored, nil
+}
+
 // ModifyUnion updates an existing Union's information
 func (r *MongoUnionRepository) ModifyUnion(ctx context.Context, unionID primitive.ObjectID, update union.Union) (*union.Union, error) {
 	// Prepare the update document
@@ -115,7 +222,7 @@ func (r *MongoUnionRepository) UnionById(ctx context.Context, unionID primitive.
 	return &foundUnion, nil
 }
 
-// UnionByName retrieves a Union by its name
+// UnionByName retrieves a Union by its exact name, ignoring case
 func (r *MongoUnionRepository) UnionByName(ctx context.Context, name string) (*union.Union, error) {
 	// Use read preference if set
 	unifiedDB := r.dbManager.GetBaseDatabase(ctx)
@@ -124,7 +231,7 @@ func (r *MongoUnionRepository) UnionByName(ctx context.Context, name string) (*u
 
 	// Find the union
 	var foundUnion union.Union
-	err := unionCollection.FindOne(ctx, bson.M{"name": primitive.Regex{Pattern: name, Options: "i"}, "deleted": false}, findOptions).Decode(&foundUnion)
+	err := unionCollection.FindOne(ctx, bson.M{"name": primitive.Regex{Pattern: "^" + regexp.QuoteMeta(name) + "$", Options: "i"}, "deleted": false}, findOptions).Decode(&foundUnion)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return nil, errors.New("no union found with the given name")
@@ -135,6 +242,22 @@ func (r *MongoUnionRepository) UnionByName(ctx context.Context, name string) (*u
 	return &foundUnion, nil
 }
 
+// UnionBySlug retrieves a live Union by its slug or one of its former slugs
+func (r *MongoUnionRepository) UnionBySlug(ctx context.Context, slug string) (*union.Union, error) {
+	unionCollection := r.dbManager.GetBaseDatabase(ctx).Collection("unions")
+
+	var foundUnion union.Union
+	err := unionCollection.FindOne(ctx, bson.M{"$or": slugFilter(slug), "deleted": bson.M{"$ne": true}}).Decode(&foundUnion)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrUnionNotFound
+		}
+		return nil, err
+	}
+
+	return &foundUnion, nil
+}
+
 // Unions retrieves all Unions with optional filtering and pagination
 func (r *MongoUnionRepository) Unions(ctx context.Context, filter map[string]interface{}, page, limit int) ([]*union.Union, int64, error) {
 	unifiedDB := r.dbManager.GetBaseDatabase(ctx)
@@ -188,6 +311,35 @@ func (r *MongoUnionRepository) Exists(ctx context.Context, filter map[string]int
 	return count > 0, nil
 }
 
+// BackfillModules gives modules to the unions registered before unions had modules and
+// returns their ids
+func (r *MongoUnionRepository) BackfillModules(ctx context.Context, modules []string) ([]primitive.ObjectID, error) {
+	unionCollection := r.dbManager.GetBaseDatabase(ctx).Collection("unions")
+	filter := bson.M{"modules": bson.M{"$exists": false}}
+	cursor, err := unionCollection.Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	var unions []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &unions); err != nil {
+		return nil, err
+	}
+	ids := make([]primitive.ObjectID, 0, len(unions))
+	for _, union := range unions {
+		ids = append(ids, union.ID)
+	}
+	if len(ids) == 0 {
+		return ids, nil
+	}
+	filter["_id"] = bson.M{"$in": ids}
+	if _, err := unionCollection.UpdateMany(ctx, filter, bson.M{"$set": bson.M{"modules": modules}}); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // Additional methods from the previous interface...
 func (r *MongoUnionRepository) AddModule(ctx context.Context, unionID primitive.ObjectID, module string) error {
 	unifiedDB := r.dbManager.GetBaseDatabase(ctx)
@@ -214,6 +366,61 @@ func (r *MongoUnionRepository) RemoveModule(ctx context.Context, unionID primiti
 	return err
 }
 
+// SetBargainingUnits keeps the unit names on the union for readers of the old field
+func (r *MongoUnionRepository) SetBargainingUnits(ctx context.Context, unionID primitive.ObjectID, names []string) error {
+	unifiedDB := r.dbManager.GetBaseDatabase(ctx)
+	unionCollection := unifiedDB.Collection("unions")
+	filter := bson.M{"_id": unionID}
+	update := bson.M{
+		"$set": bson.M{"bargainingUnits": names},
+	}
+
+	_, err := unionCollection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+// SetDomains sets the custom domain and registration domains of a union
+func (r *MongoUnionRepository) SetDomains(ctx context.Context, unionID primitive.ObjectID, fields bson.M) error {
+	unifiedDB := r.dbManager.GetBaseDatabase(ctx)
+	unionCollection := unifiedDB.Collection("unions")
+	filter := bson.M{"_id": unionID}
+	update := bson.M{
+		"$set": fields,
+	}
+
+	_, err := unionCollection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+// DomainTaken reports whether a live union other than unionID serves domain
+func (r *MongoUnionRepository) DomainTaken(ctx context.Context, unionID primitive.ObjectID, domain string) (bool, error) {
+	unionCollection := r.dbManager.GetBaseDatabase(ctx).Collection("unions")
+	filter := bson.M{"domain": domain, "_id": bson.M{"$ne": unionID}, "deleted": bson.M{"$ne": true}}
+	count, err := unionCollection.CountDocuments(ctx, filter)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// SetBranding keeps the union's image fields in step with its current theme for
+// readers of the old fields
+func (r *MongoUnionRepository) SetBranding(ctx context.Context, unionID primitive.ObjectID, logoURL, bannerURL string) error {
+	unifiedDB := r.dbManager.GetBaseDatabase(ctx)
+	unionCollection := unifiedDB.Collection("unions")
+	filter := bson.M{"_id": unionID}
+	update := bson.M{
+		"$set": bson.M{
+			"themeImage":           logoURL,
+			"information.imageURL": logoURL,
+			"bannerURL":            bannerURL,
+		},
+	}
+
+	_, err := unionCollection.UpdateOne(ctx, filter, update)
+	return err
+}
+
 func (r *MongoUnionRepository) UpdateDefaultUser(ctx context.Context, unionID primitive.ObjectID, defaultUser *union.DefaultUserInfo) error {
 	unifiedDB := r.dbManager.GetBaseDatabase(ctx)
 	unionCollection := unifiedDB.Collection("unions")
@@ -240,3 +447,146 @@ func (r *MongoUnionRepository) SetManagerIDs(ctx context.Context, unionID primit
 	_, err := unionCollection.UpdateOne(ctx, filter, update)
 	return err
 }
+
+// UnionsByManager returns the live unions a staff member is account manager or
+// communication rep of
+func (r *MongoUnionRepository) UnionsByManager(ctx context.Context, managerID primitive.ObjectID) ([]*union.Union, error) {
+	unionCollection := r.dbManager.GetBaseDatabase(ctx).Collection("unions")
+	filter := bson.M{
+		"deleted": bson.M{"$ne": true},
+		"$or":     bson.A{bson.M{"accountManager": managerID}, bson.M{"communicationRep": managerID}},
+	}
+	cursor, err := unionCollection.Find(ctx, filter, options.Find().SetSort(bson.M{"name": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	unions := []*union.Union{}
+	if err := cursor.All(ctx, &unions); err != nil {
+		return nil, err
+	}
+	return unions, nil
+}
+
+// ManagerIDs returns every staff member assigned to a live union
+func (r *MongoUnionRepository) ManagerIDs(ctx context.Context) ([]primitive.ObjectID, error) {
+	unionCollection := r.dbManager.GetBaseDatabase(ctx).Collection("unions")
+	live := bson.M{"deleted": bson.M{"$ne": true}}
+	seen := map[primitive.ObjectID]bool{}
+	ids := []primitive.ObjectID{}
+	for _, field := range []string{"accountManager", "communicationRep"} {
+		values, err := unionCollection.Distinct(ctx, field, live)
+		if err != nil {
+			return nil, err
+		}
+		for _, value := range values {
+			if id, ok := value.(primitive.ObjectID); ok && !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids, nil
+}
+
+func (r *MongoUnionRepository) SetRetentionPolicy(ctx context.Context, unionID primitive.ObjectID, policy *union.RetentionPolicy) (*union.Union, error) {
+	unifiedDB := r.dbManager.GetBaseDatabase(ctx)
+	unionCollection := unifiedDB.Collection("unions")
+	filter := bson.M{"_id": unionID}
+	update := bson.M{
+		"$set": bson.M{"retentionPolicy": policy},
+	}
+
+	var updatedUnion union.Union
+	err := unionCollection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&updatedUnion)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.New("no union found with the given ID")
+		}
+		return nil, err
+	}
+	return &updatedUnion, nil
+}
+
+// ErrUnionNotFound is returned when no union has the given slug
+var ErrUnionNotFound = errors.New("no union found with the given slug")
+
+// ErrSlugTaken is returned when a slug is already a union's slug or alias
+var ErrSlugTaken = errors.New("slug is taken")
+
+func slugFilter(slug string) bson.A {
+	return bson.A{bson.M{"unionID": slug}, bson.M{"slugAliases": slug}}
+}
+
+// EnsureSlugIndexes makes slugs unique and their lookups, aliases included, indexed
+func (r *MongoUnionRepository) EnsureSlugIndexes(ctx context.Context) error {
+	unionCollection := r.dbManager.GetBaseDatabase(ctx).Collection("unions")
+	_, err := unionCollection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "unionID", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "slugAliases", Value: 1}}, Options: options.Index().SetUnique(true).SetSparse(true)},
+	})
+	return err
+}
+
+// SlugTaken reports whether a union other than unionID has slug as its slug, an alias
+// or its database name. Deleted unions count: their slug still names their archived
+// database.
+func (r *MongoUnionRepository) SlugTaken(ctx context.Context, unionID primitive.ObjectID, slug string) (bool, error) {
+	unionCollection := r.dbManager.GetBaseDatabase(ctx).Collection("unions")
+	filter := bson.M{"$or": append(slugFilter(slug), bson.M{"database": slug}), "_id": bson.M{"$ne": unionID}}
+	count, err := unionCollection.CountDocuments(ctx, filter)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// ChangeSlug moves the union from slug from to slug to, unless another change got
+// there first. database pins the tenant database, which keeps its original name.
+func (r *MongoUnionRepository) ChangeSlug(ctx context.Context, unionID primitive.ObjectID, from, to, database string, aliases []string) (*union.Union, error) {
+	unionCollection := r.dbManager.GetBaseDatabase(ctx).Collection("unions")
+	filter := bson.M{"_id": unionID, "unionID": from}
+	update := bson.M{
+		"$set": bson.M{
+			"unionID":     to,
+			"database":    database,
+			"slugAliases": aliases,
+		},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var updated union.Union
+	err := unionCollection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&updated)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, ErrSlugTaken
+		}
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.New("the union's slug changed meanwhile, try again")
+		}
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// RemoveSlugAlias drops slug from the former slugs of the union
+func (r *MongoUnionRepository) RemoveSlugAlias(ctx context.Context, unionID primitive.ObjectID, slug string) (*union.Union, error) {
+	unionCollection := r.dbManager.GetBaseDatabase(ctx).Collection("unions")
+	filter := bson.M{"_id": unionID}
+	update := bson.M{"$pull": bson.M{"slugAliases": slug}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var updated union.Union
+	if err := unionCollection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&updated); err != nil {
+		return nil, err
+	}
+	if len(updated.SlugAliases) == 0 {
+		// the alias index is unique and would count an empty list as a value
+		empty := bson.M{"_id": unionID, "slugAliases": bson.M{"$size": 0}}
+		if _, err := unionCollection.UpdateOne(ctx, empty, bson.M{"$unset": bson.M{"slugAliases": ""}}); err != nil {
+			return nil, err
+		}
+	}
+	return &updated, nil
+}
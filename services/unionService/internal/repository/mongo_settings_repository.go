@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	union "younified-backend/contracts/union/model"
+	"younified-backend/providers/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoSettingsRepository struct {
+	dbManager *database.DBManager
+}
+
+func NewMongoSettingsRepository(dbManager *database.DBManager) *MongoSettingsRepository {
+	return &MongoSettingsRepository{
+		dbManager: dbManager,
+	}
+}
+
+func (r *MongoSettingsRepository) collection(ctx context.Context) *mongo.Collection {
+	return r.dbManager.GetBaseDatabase(ctx).Collection(database.SettingsCollection)
+}
+
+// Get returns the overrides of a union; a union without any has an empty list
+func (r *MongoSettingsRepository) Get(ctx context.Context, unionID primitive.ObjectID) (*union.UnionSettings, error) {
+	settings := &union.UnionSettings{UnionID: unionID, Overrides: []*union.SettingOverride{}}
+	err := r.collection(ctx).FindOne(ctx, bson.M{"_id": unionID}).Decode(settings)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// Save replaces the overrides of a union
+func (r *MongoSettingsRepository) Save(ctx context.Context, settings *union.UnionSettings) error {
+	opts := options.Replace().SetUpsert(true)
+	_, err := r.collection(ctx).ReplaceOne(ctx, bson.M{"_id": settings.UnionID}, settings, opts)
+	return err
+}
@@ -0,0 +1,449 @@
+package repository
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	union "younified-backend/contracts/union/model"
+	userModel "younified-backend/contracts/user/model"
+	"younified-backend/providers/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// tenantIndexes are the indexes the services' queries rely on, by collection
+var tenantIndexes = map[string][]mongo.IndexModel{
+	"users": {
+		{Keys: bson.D{{Key: "username", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "employeeID", Value: 1}}},
+		{Keys: bson.D{{Key: "profile.email", Value: 1}}},
+		{Keys: bson.D{{Key: "deleted", Value: 1}, {Key: "lastLoginDate", Value: 1}}},
+	},
+	"members":             {{Keys: bson.D{{Key: "username", Value: 1}}}},
+	"login_events":        {{Keys: bson.D{{Key: "userID", Value: 1}, {Key: "at", Value: -1}}}},
+	"status_history":      {{Keys: bson.D{{Key: "userID", Value: 1}}}},
+	"dues_ledger":         {{Keys: bson.D{{Key: "userID", Value: 1}, {Key: "effectiveDate", Value: 1}}}},
+	"steward_assignments": {{Keys: bson.D{{Key: "stewardID", Value: 1}}}},
+	"picket_shifts":       {{Keys: bson.D{{Key: "start", Value: 1}}}},
+	"message_overrides": {
+		{Keys: bson.D{{Key: "locale", Value: 1}, {Key: "key", Value: 1}}, Options: options.Index().SetUnique(true)},
+	},
+}
+
+// tenantCollections are created empty in every new tenant database
+var tenantCollections = []string{
+	"users", "members", "news", "blog-post", "status_history", "privacy_requests",
+	"dues_schedules", "dues_ledger", "remittance_formats", "remittance_imports",
+	"picket_sites", "picket_shifts", "steward_assignments", "milestone_rules",
+	"message_overrides", "login_events",
+}
+
+// namespaceExists is the error code of creating a collection twice
+const namespaceExists = 48
+
+// MongoTenantRepository sets up and tears down the database of a union
+type MongoTenantRepository struct {
+	dbManager *database.DBManager
+}
+
+func NewMongoTenantRepository(dbManager *database.DBManager) *MongoTenantRepository {
+	return &MongoTenantRepository{
+		dbManager: dbManager,
+	}
+}
+
+func (r *MongoTenantRepository) database(ctx context.Context, name string) *mongo.Database {
+	return r.dbManager.GetBaseDatabase(ctx).Client().Database(name)
+}
+
+// DatabaseExists reports whether a database called name is already on the server
+func (r *MongoTenantRepository) DatabaseExists(ctx context.Context, name string) (bool, error) {
+	names, err := r.dbManager.GetBaseDatabase(ctx).Client().ListDatabaseNames(ctx, bson.M{"name": name})
+	if err != nil {
+		return false, err
+	}
+	return len(names) > 0, nil
+}
+
+// CreateDatabase creates the tenant database with its indexes; running it again is harmless
+func (r *MongoTenantRepository) CreateDatabase(ctx context.Context, name string) error {
+	db := r.database(ctx, name)
+	for collection, indexes := range tenantIndexes {
+		if _, err := db.Collection(collection).Indexes().CreateMany(ctx, indexes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SeedCollections creates the default collections that do not exist yet
+func (r *MongoTenantRepository) SeedCollections(ctx context.Context, name string) error {
+	db := r.database(ctx, name)
+	for _, collection := range tenantCollections {
+		err := db.CreateCollection(ctx, collection)
+		var cmdErr mongo.CommandError
+		if err != nil && !(errors.As(err, &cmdErr) && cmdErr.Code == namespaceExists) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *MongoTenantRepository) DropDatabase(ctx context.Context, name string) error {
+	return r.database(ctx, name).Drop(ctx)
+}
+
+// UserExists reports whether the tenant database already has a user called username
+func (r *MongoTenantRepository) UserExists(ctx context.Context, name string, username string) (bool, error) {
+	count, err := r.database(ctx, name).Collection("users").CountDocuments(ctx, bson.M{"username": username})
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// FindUser returns a user of a tenant database, or nil when there is none
+func (r *MongoTenantRepository) FindUser(ctx context.Context, name string, id primitive.ObjectID) (*userModel.User, error) {
+	var user userModel.User
+	err := r.database(ctx, name).Collection("users").FindOne(ctx, bson.M{"_id": id}).Decode(&user)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// AssignUnit puts members into a bargaining unit; their unit text follows the unit's
+// name so filters on it keep working
+func (r *MongoTenantRepository) AssignUnit(ctx context.Context, name string, userIDs []primitive.ObjectID, unit *union.BargainingUnit) (int64, error) {
+	filter := bson.M{"_id": bson.M{"$in": userIDs}}
+	update := bson.M{"$set": bson.M{"unitID": unit.ID, "unit": unit.Name}}
+	result, err := r.database(ctx, name).Collection("users").UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+// AssignUnitByName puts the members whose unit text matches the unit's name, ignoring
+// case, into it; members already assigned a unit are left alone
+func (r *MongoTenantRepository) AssignUnitByName(ctx context.Context, name string, text string, unit *union.BargainingUnit) (int64, error) {
+	filter := bson.M{"unit": text, "unitID": bson.M{"$exists": false}}
+	update := bson.M{"$set": bson.M{"unitID": unit.ID, "unit": unit.Name}}
+	opts := options.Update().SetCollation(&options.Collation{Locale: "en", Strength: 2})
+	result, err := r.database(ctx, name).Collection("users").UpdateMany(ctx, filter, update, opts)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+// RenameUnitMembers copies a unit's new name to the unit text of its members
+func (r *MongoTenantRepository) RenameUnitMembers(ctx context.Context, name string, unit *union.BargainingUnit) error {
+	filter := bson.M{"unitID": unit.ID}
+	update := bson.M{"$set": bson.M{"unit": unit.Name}}
+	_, err := r.database(ctx, name).Collection("users").UpdateMany(ctx, filter, update)
+	return err
+}
+
+// UnitMemberIDs returns the members assigned to a unit
+func (r *MongoTenantRepository) UnitMemberIDs(ctx context.Context, name string, unitID primitive.ObjectID) ([]primitive.ObjectID, error) {
+	values, err := r.database(ctx, name).Collection("users").Distinct(ctx, "_id", bson.M{"unitID": unitID})
+	if err != nil {
+		return nil, err
+	}
+	ids := []primitive.ObjectID{}
+	for _, value := range values {
+		if id, ok := value.(primitive.ObjectID); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// UnassignedUnitNames returns the unit texts of the members not assigned a unit yet
+func (r *MongoTenantRepository) UnassignedUnitNames(ctx context.Context, name string) ([]string, error) {
+	filter := bson.M{"unitID": bson.M{"$exists": false}, "unit": bson.M{"$nin": bson.A{"", nil}}}
+	values, err := r.database(ctx, name).Collection("users").Distinct(ctx, "unit", filter)
+	if err != nil {
+		return nil, err
+	}
+	return distinctStrings(values), nil
+}
+
+// UnitMemberCounts counts the members of every unit that has some
+func (r *MongoTenantRepository) UnitMemberCounts(ctx context.Context, name string) (map[primitive.ObjectID]int, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"deleted": bson.M{"$ne": true}, "unitID": bson.M{"$exists": true}}}},
+		{{Key: "$group", Value: bson.M{"_id": "$unitID", "count": bson.M{"$sum": 1}}}},
+	}
+	cursor, err := r.database(ctx, name).Collection("users").Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rows []struct {
+		ID    primitive.ObjectID `bson:"_id"`
+		Count int                `bson:"count"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, err
+	}
+	counts := map[primitive.ObjectID]int{}
+	for _, row := range rows {
+		counts[row.ID] = row.Count
+	}
+	return counts, nil
+}
+
+// NewsUnits returns the unit values news items are targeted at
+func (r *MongoTenantRepository) NewsUnits(ctx context.Context, name string) ([]string, error) {
+	values, err := r.database(ctx, name).Collection("news").Distinct(ctx, "unit", bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	return distinctStrings(values), nil
+}
+
+// ReplaceNewsUnit retargets the news aimed at from to to
+func (r *MongoTenantRepository) ReplaceNewsUnit(ctx context.Context, name string, from string, to string) (int64, error) {
+	filter := bson.M{"unit": from}
+	update := bson.M{"$set": bson.M{"unit.$[target]": to}}
+	opts := options.Update().SetArrayFilters(options.ArrayFilters{Filters: []interface{}{bson.M{"target": from}}})
+	result, err := r.database(ctx, name).Collection("news").UpdateMany(ctx, filter, update, opts)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+func distinctStrings(values []interface{}) []string {
+	texts := []string{}
+	for _, value := range values {
+		if text, ok := value.(string); ok && strings.TrimSpace(text) != "" {
+			texts = append(texts, text)
+		}
+	}
+	return texts
+}
+
+// archiveBatch is how many documents a restore inserts at once
+const archiveBatch = 500
+
+type archiveManifest struct {
+	Database    string           `json:"database"`
+	CreatedOn   time.Time        `json:"createdOn"`
+	Collections map[string]int64 `json:"collections"`
+}
+
+// archiveChunk bounds the documents of one <name>.bson entry, so an export never holds
+// more than that of a collection in memory
+const archiveChunk = 16 << 20
+
+// ExportDatabase streams a tenant database to w as a gzipped tar: each collection as
+// <name>.bson, its documents one after another as mongodump writes them, and its
+// indexes as <name>.indexes.json in extended JSON, with a manifest.json listing the
+// collections. A large collection spans several <name>.bson entries.
+func (r *MongoTenantRepository) ExportDatabase(ctx context.Context, name string, w io.Writer) (*union.UnionArchive, error) {
+	db := r.database(ctx, name)
+	collections, err := db.ListCollectionNames(ctx, bson.M{"name": bson.M{"$not": primitive.Regex{Pattern: "^system\\."}}})
+	if err != nil {
+		return nil, err
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	manifest := archiveManifest{Database: name, CreatedOn: time.Now(), Collections: map[string]int64{}}
+	stats := &union.UnionArchive{}
+	for _, collection := range collections {
+		count, err := exportCollection(ctx, db.Collection(collection), tw)
+		if err != nil {
+			return nil, fmt.Errorf("could not export %s: %v", collection, err)
+		}
+		indexes, err := exportIndexes(ctx, db.Collection(collection))
+		if err != nil {
+			return nil, fmt.Errorf("could not export indexes of %s: %v", collection, err)
+		}
+		if err := writeArchiveFile(tw, collection+".indexes.json", indexes); err != nil {
+			return nil, err
+		}
+		manifest.Collections[collection] = count
+		stats.Collections++
+		stats.Documents += count
+	}
+	manifestJSON, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeArchiveFile(tw, "manifest.json", manifestJSON); err != nil {
+		return nil, err
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// ImportDatabase rebuilds a tenant database from an ExportDatabase archive read from
+// archive. Whatever is left of the database is dropped first.
+func (r *MongoTenantRepository) ImportDatabase(ctx context.Context, name string, archive io.Reader) error {
+	db := r.database(ctx, name)
+	if err := db.Drop(ctx); err != nil {
+		return err
+	}
+	gz, err := gzip.NewReader(archive)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+		switch {
+		case strings.HasSuffix(header.Name, ".indexes.json"):
+			err = importIndexes(ctx, db, strings.TrimSuffix(header.Name, ".indexes.json"), data)
+		case strings.HasSuffix(header.Name, ".bson"):
+			err = importCollection(ctx, db, strings.TrimSuffix(header.Name, ".bson"), data)
+		}
+		if err != nil {
+			return fmt.Errorf("could not restore %s: %v", header.Name, err)
+		}
+	}
+}
+
+// exportCollection writes the documents of collection as <name>.bson entries of at
+// most archiveChunk bytes; an empty collection still gets one
+func exportCollection(ctx context.Context, collection *mongo.Collection, tw *tar.Writer) (int64, error) {
+	cursor, err := collection.Find(ctx, bson.M{})
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var buf bytes.Buffer
+	var count int64
+	written := false
+	for cursor.Next(ctx) {
+		if buf.Len() > 0 && buf.Len()+len(cursor.Current) > archiveChunk {
+			if err := writeArchiveFile(tw, collection.Name()+".bson", buf.Bytes()); err != nil {
+				return 0, err
+			}
+			buf.Reset()
+			written = true
+		}
+		buf.Write(cursor.Current)
+		count++
+	}
+	if err := cursor.Err(); err != nil {
+		return 0, err
+	}
+	if buf.Len() > 0 || !written {
+		if err := writeArchiveFile(tw, collection.Name()+".bson", buf.Bytes()); err != nil {
+			return 0, err
+		}
+	}
+	return count, nil
+}
+
+func exportIndexes(ctx context.Context, collection *mongo.Collection) ([]byte, error) {
+	cursor, err := collection.Indexes().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var indexes []bson.M
+	if err := cursor.All(ctx, &indexes); err != nil {
+		return nil, err
+	}
+	return bson.MarshalExtJSON(bson.M{"indexes": indexes}, true, false)
+}
+
+func importCollection(ctx context.Context, db *mongo.Database, name string, data []byte) error {
+	err := db.CreateCollection(ctx, name)
+	var cmdErr mongo.CommandError
+	if err != nil && !(errors.As(err, &cmdErr) && cmdErr.Code == namespaceExists) {
+		return err
+	}
+	batch := []interface{}{}
+	for len(data) > 0 {
+		if len(data) < 4 {
+			return errors.New("truncated document")
+		}
+		size := int(binary.LittleEndian.Uint32(data))
+		if size < 5 || size > len(data) {
+			return errors.New("truncated document")
+		}
+		batch = append(batch, bson.Raw(data[:size]))
+		data = data[size:]
+		if len(batch) == archiveBatch {
+			if _, err := db.Collection(name).InsertMany(ctx, batch); err != nil {
+				return err
+			}
+			batch = []interface{}{}
+		}
+	}
+	if len(batch) > 0 {
+		if _, err := db.Collection(name).InsertMany(ctx, batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func importIndexes(ctx context.Context, db *mongo.Database, name string, data []byte) error {
+	var doc struct {
+		Indexes []bson.M `bson:"indexes"`
+	}
+	if err := bson.UnmarshalExtJSON(data, true, &doc); err != nil {
+		return err
+	}
+	specs := []bson.M{}
+	for _, index := range doc.Indexes {
+		if index["name"] == "_id_" {
+			continue
+		}
+		delete(index, "v")
+		delete(index, "ns")
+		specs = append(specs, index)
+	}
+	if len(specs) == 0 {
+		return nil
+	}
+	return db.RunCommand(ctx, bson.D{{Key: "createIndexes", Value: name}, {Key: "indexes", Value: specs}}).Err()
+}
+
+func writeArchiveFile(tw *tar.Writer, name string, data []byte) error {
+	header := &tar.Header{Name: name, Mode: 0o600, Size: int64(len(data)), ModTime: time.Now()}
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+	return err
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+	union "younified-backend/contracts/union/model"
+	"younified-backend/providers/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ThemeCollection keeps every version of every union's theme in the base database
+const ThemeCollection = "union_themes"
+
+// ErrThemeVersionTaken is returned when another change saved the same version first
+var ErrThemeVersionTaken = errors.New("the theme was changed meanwhile, please try again")
+
+type MongoThemeRepository struct {
+	dbManager *database.DBManager
+}
+
+func NewMongoThemeRepository(dbManager *database.DBManager) *MongoThemeRepository {
+	return &MongoThemeRepository{
+		dbManager: dbManager,
+	}
+}
+
+func (r *MongoThemeRepository) collection(ctx context.Context) *mongo.Collection {
+	return r.dbManager.GetBaseDatabase(ctx).Collection(ThemeCollection)
+}
+
+// EnsureIndexes makes a version number unique per union, which is what keeps two
+// concurrent changes from both becoming the same version
+func (r *MongoThemeRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection(ctx).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "unionID", Value: 1}, {Key: "version", Value: -1}},
+		Options: options.Index().SetUnique(true),
+	})
+	return err
+}
+
+// Create saves theme as a new version
+func (r *MongoThemeRepository) Create(ctx context.Context, theme *union.UnionTheme) (*union.UnionTheme, error) {
+	theme.ID = primitive.NewObjectID()
+	theme.CreatedOn = time.Now()
+	if _, err := r.collection(ctx).InsertOne(ctx, theme); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, ErrThemeVersionTaken
+		}
+		return nil, err
+	}
+	return theme, nil
+}
+
+// Current returns the latest version of a union's theme, or nil when it has none
+func (r *MongoThemeRepository) Current(ctx context.Context, unionID primitive.ObjectID) (*union.UnionTheme, error) {
+	opts := options.FindOne().SetSort(bson.M{"version": -1})
+	return r.findOne(ctx, bson.M{"unionID": unionID}, opts)
+}
+
+// Version returns one version of a union's theme, or nil when it does not exist
+func (r *MongoThemeRepository) Version(ctx context.Context, unionID primitive.ObjectID, version int) (*union.UnionTheme, error) {
+	return r.findOne(ctx, bson.M{"unionID": unionID, "version": version})
+}
+
+// Versions returns every version of a union's theme, newest first
+func (r *MongoThemeRepository) Versions(ctx context.Context, unionID primitive.ObjectID) ([]*union.UnionTheme, error) {
+	opts := options.Find().SetSort(bson.M{"version": -1})
+	cursor, err := r.collection(ctx).Find(ctx, bson.M{"unionID": unionID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	themes := []*union.UnionTheme{}
+	if err := cursor.All(ctx, &themes); err != nil {
+		return nil, err
+	}
+	return themes, nil
+}
+
+func (r *MongoThemeRepository) findOne(ctx context.Context, filter bson.M, opts ...*options.FindOneOptions) (*union.UnionTheme, error) {
+	var theme union.UnionTheme
+	err := r.collection(ctx).FindOne(ctx, filter, opts...).Decode(&theme)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &theme, nil
+}
@@ -79,3 +79,61 @@ func (r *RedisUnionRepository) InvalidateCache(ctx context.Context, key string)
 func (r *RedisUnionRepository) CacheExists(ctx context.Context, key string) (bool, error) {
 	return r.client.Exists(ctx, key)
 }
+
+// ThemeKey is the cache key of the current theme of the union with slug
+func ThemeKey(slug string) string {
+	return "theme:" + slug
+}
+
+func (r *RedisUnionRepository) CacheTheme(ctx context.Context, slug string, theme *model.UnionTheme) error {
+	data, err := json.Marshal(theme)
+	if err != nil {
+		return err
+	}
+	return r.client.Set(ctx, ThemeKey(slug), data, 24*time.Hour)
+}
+
+// GetThemeFromCache returns the cached theme of a union, or nil on a cache miss
+func (r *RedisUnionRepository) GetThemeFromCache(ctx context.Context, slug string) (*model.UnionTheme, error) {
+	cached, err := r.client.Get(ctx, ThemeKey(slug))
+	if err == redis.Nil {
+		return nil, nil // Cache miss
+	} else if err != nil {
+		return nil, err
+	}
+	var theme model.UnionTheme
+	if err := json.Unmarshal([]byte(cached), &theme); err != nil {
+		return nil, err
+	}
+	return &theme, nil
+}
+
+// ProfileKey is the cache key of the public profile of the union with slug
+func ProfileKey(slug string) string {
+	return "public-profile:" + slug
+}
+
+// CacheProfile keeps a union's public profile briefly; news posted in cmsService
+// shows up once it expires
+func (r *RedisUnionRepository) CacheProfile(ctx context.Context, slug string, profile *model.PublicUnionProfile) error {
+	data, err := json.Marshal(profile)
+	if err != nil {
+		return err
+	}
+	return r.client.Set(ctx, ProfileKey(slug), data, 5*time.Minute)
+}
+
+// GetProfileFromCache returns the cached public profile of a union, or nil on a cache miss
+func (r *RedisUnionRepository) GetProfileFromCache(ctx context.Context, slug string) (*model.PublicUnionProfile, error) {
+	cached, err := r.client.Get(ctx, ProfileKey(slug))
+	if err == redis.Nil {
+		return nil, nil // Cache miss
+	} else if err != nil {
+		return nil, err
+	}
+	var profile model.PublicUnionProfile
+	if err := json.Unmarshal([]byte(cached), &profile); err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
@@ -0,0 +1,62 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"younified-backend/contracts/union/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Parent is the resolver for the parent field.
+func (r *bargainingUnitResolver) Parent(ctx context.Context, obj *model.BargainingUnit) (*model.BargainingUnit, error) {
+	return r.UnionController.UnitParent(ctx, obj)
+}
+
+// CreateBargainingUnit is the resolver for the createBargainingUnit field.
+func (r *mutationResolver) CreateBargainingUnit(ctx context.Context, unionID primitive.ObjectID, input model.BargainingUnitInput) (*model.BargainingUnit, error) {
+	return r.UnionController.CreateBargainingUnit(ctx, unionID, input)
+}
+
+// UpdateBargainingUnit is the resolver for the updateBargainingUnit field.
+func (r *mutationResolver) UpdateBargainingUnit(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID, input model.BargainingUnitInput) (*model.BargainingUnit, error) {
+	return r.UnionController.UpdateBargainingUnit(ctx, unionID, id, input)
+}
+
+// DeleteBargainingUnit is the resolver for the deleteBargainingUnit field.
+func (r *mutationResolver) DeleteBargainingUnit(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID) (*bool, error) {
+	return r.UnionController.DeleteBargainingUnit(ctx, unionID, id)
+}
+
+// AssignMembersToUnit is the resolver for the assignMembersToUnit field.
+func (r *mutationResolver) AssignMembersToUnit(ctx context.Context, unionID primitive.ObjectID, unitID primitive.ObjectID, memberIDs []primitive.ObjectID) (int, error) {
+	return r.UnionController.AssignMembersToUnit(ctx, unionID, unitID, memberIDs)
+}
+
+// MigrateBargainingUnits is the resolver for the migrateBargainingUnits field.
+func (r *mutationResolver) MigrateBargainingUnits(ctx context.Context, unionID *primitive.ObjectID) ([]*model.UnitMigrationReport, error) {
+	return r.UnionController.MigrateBargainingUnits(ctx, unionID)
+}
+
+// BargainingUnits is the resolver for the bargainingUnits field.
+func (r *queryResolver) BargainingUnits(ctx context.Context, unionID primitive.ObjectID) ([]*model.BargainingUnit, error) {
+	return r.UnionController.BargainingUnits(ctx, unionID)
+}
+
+// BargainingUnitCounts is the resolver for the bargainingUnitCounts field.
+func (r *queryResolver) BargainingUnitCounts(ctx context.Context, unionID primitive.ObjectID) ([]*model.BargainingUnitCount, error) {
+	return r.UnionController.BargainingUnitCounts(ctx, unionID)
+}
+
+// Units is the resolver for the units field.
+func (r *unionResolver) Units(ctx context.Context, obj *model.Union) ([]*model.BargainingUnit, error) {
+	return r.UnionController.BargainingUnits(ctx, obj.ID)
+}
+
+// BargainingUnit returns BargainingUnitResolver implementation.
+func (r *Resolver) BargainingUnit() BargainingUnitResolver { return &bargainingUnitResolver{r} }
+
+type bargainingUnitResolver struct{ *Resolver }
@@ -0,0 +1,22 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"younified-backend/contracts/union/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SetUnionDomains is the resolver for the setUnionDomains field.
+func (r *mutationResolver) SetUnionDomains(ctx context.Context, id primitive.ObjectID, input model.UnionDomainInput) (*model.Union, error) {
+	return r.UnionController.SetUnionDomains(ctx, id, input)
+}
+
+// CurrentUnion is the resolver for the currentUnion field.
+func (r *queryResolver) CurrentUnion(ctx context.Context) (*model.Union, error) {
+	return r.UnionController.CurrentUnion(ctx)
+}
@@ -0,0 +1,22 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"younified-backend/contracts/union/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// FindBargainingUnitByID is the resolver for the findBargainingUnitByID field.
+func (r *entityResolver) FindBargainingUnitByID(ctx context.Context, id primitive.ObjectID) (*model.BargainingUnit, error) {
+	return r.UnionController.BargainingUnitByID(ctx, id)
+}
+
+// Entity returns EntityResolver implementation.
+func (r *Resolver) Entity() EntityResolver { return &entityResolver{r} }
+
+type entityResolver struct{ *Resolver }
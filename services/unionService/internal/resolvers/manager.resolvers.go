@@ -0,0 +1,22 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"younified-backend/contracts/union/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SetUnionManagers is the resolver for the setUnionManagers field.
+func (r *mutationResolver) SetUnionManagers(ctx context.Context, id primitive.ObjectID, accountManagers []primitive.ObjectID, communicationReps []primitive.ObjectID) (*model.Union, error) {
+	return r.UnionController.SetUnionManagers(ctx, id, accountManagers, communicationReps)
+}
+
+// ManagerPortfolios is the resolver for the managerPortfolios field.
+func (r *queryResolver) ManagerPortfolios(ctx context.Context, managerID *primitive.ObjectID) ([]*model.ManagerPortfolio, error) {
+	return r.UnionController.ManagerPortfolios(ctx, managerID)
+}
@@ -0,0 +1,27 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"younified-backend/contracts/union/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// EnableModule is the resolver for the enableModule field.
+func (r *mutationResolver) EnableModule(ctx context.Context, id primitive.ObjectID, module string) (*model.Union, error) {
+	return r.UnionController.EnableModule(ctx, id, module)
+}
+
+// DisableModule is the resolver for the disableModule field.
+func (r *mutationResolver) DisableModule(ctx context.Context, id primitive.ObjectID, module string) (*model.Union, error) {
+	return r.UnionController.DisableModule(ctx, id, module)
+}
+
+// UnionModules is the resolver for the unionModules field.
+func (r *queryResolver) UnionModules(ctx context.Context, id primitive.ObjectID) ([]string, error) {
+	return r.UnionController.UnionModules(ctx, id)
+}
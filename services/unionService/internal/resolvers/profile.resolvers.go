@@ -0,0 +1,15 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"younified-backend/contracts/union/model"
+)
+
+// PublicUnionProfile is the resolver for the publicUnionProfile field.
+func (r *queryResolver) PublicUnionProfile(ctx context.Context, slug string) (*model.PublicUnionProfile, error) {
+	return r.UnionController.PublicUnionProfile(ctx, slug)
+}
@@ -0,0 +1,17 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"younified-backend/contracts/union/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ProvisioningStatus is the resolver for the provisioningStatus field.
+func (r *queryResolver) ProvisioningStatus(ctx context.Context, unionID primitive.ObjectID) (*model.ProvisioningWorkflow, error) {
+	return r.UnionController.ProvisioningStatus(ctx, unionID)
+}
@@ -0,0 +1,17 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"younified-backend/contracts/union/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SetRetentionPolicy is the resolver for the setRetentionPolicy field.
+func (r *mutationResolver) SetRetentionPolicy(ctx context.Context, id primitive.ObjectID, policy model.RetentionPolicy) (*model.Union, error) {
+	return r.UnionController.SetRetentionPolicy(ctx, id, policy)
+}
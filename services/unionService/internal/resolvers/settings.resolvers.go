@@ -0,0 +1,22 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"younified-backend/contracts/union/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// UpdateUnionSettings is the resolver for the updateUnionSettings field.
+func (r *mutationResolver) UpdateUnionSettings(ctx context.Context, id primitive.ObjectID, settings []*model.UnionSettingInput) ([]*model.UnionSetting, error) {
+	return r.UnionController.UpdateUnionSettings(ctx, id, settings)
+}
+
+// UnionSettings is the resolver for the unionSettings field.
+func (r *queryResolver) UnionSettings(ctx context.Context, id primitive.ObjectID) ([]*model.UnionSetting, error) {
+	return r.UnionController.UnionSettings(ctx, id)
+}
@@ -0,0 +1,32 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"younified-backend/contracts/union/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ChangeUnionSlug is the resolver for the changeUnionSlug field.
+func (r *mutationResolver) ChangeUnionSlug(ctx context.Context, id primitive.ObjectID, slug string) (*model.Union, error) {
+	return r.UnionController.ChangeUnionSlug(ctx, id, slug)
+}
+
+// RemoveUnionSlugAlias is the resolver for the removeUnionSlugAlias field.
+func (r *mutationResolver) RemoveUnionSlugAlias(ctx context.Context, id primitive.ObjectID, slug string) (*model.Union, error) {
+	return r.UnionController.RemoveUnionSlugAlias(ctx, id, slug)
+}
+
+// ResolveUnionSlug is the resolver for the resolveUnionSlug field.
+func (r *queryResolver) ResolveUnionSlug(ctx context.Context, slug string) (*model.SlugResolution, error) {
+	return r.UnionController.ResolveUnionSlug(ctx, slug)
+}
+
+// SlugAvailability is the resolver for the slugAvailability field.
+func (r *queryResolver) SlugAvailability(ctx context.Context, slug string) (*model.SlugAvailability, error) {
+	return r.UnionController.SlugAvailability(ctx, slug)
+}
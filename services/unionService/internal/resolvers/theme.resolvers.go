@@ -0,0 +1,43 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"younified-backend/contracts/union/model"
+
+	"github.com/99designs/gqlgen/graphql"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// UpdateUnionTheme is the resolver for the updateUnionTheme field.
+func (r *mutationResolver) UpdateUnionTheme(ctx context.Context, unionID primitive.ObjectID, input model.UnionThemeInput) (*model.UnionTheme, error) {
+	return r.UnionController.UpdateUnionTheme(ctx, unionID, input)
+}
+
+// UploadThemeImage is the resolver for the uploadThemeImage field.
+func (r *mutationResolver) UploadThemeImage(ctx context.Context, unionID primitive.ObjectID, slot string, file graphql.Upload) (*model.UnionTheme, error) {
+	return r.UnionController.UploadThemeImage(ctx, unionID, slot, file)
+}
+
+// RemoveThemeImage is the resolver for the removeThemeImage field.
+func (r *mutationResolver) RemoveThemeImage(ctx context.Context, unionID primitive.ObjectID, slot string) (*model.UnionTheme, error) {
+	return r.UnionController.RemoveThemeImage(ctx, unionID, slot)
+}
+
+// RollbackUnionTheme is the resolver for the rollbackUnionTheme field.
+func (r *mutationResolver) RollbackUnionTheme(ctx context.Context, unionID primitive.ObjectID, version int) (*model.UnionTheme, error) {
+	return r.UnionController.RollbackUnionTheme(ctx, unionID, version)
+}
+
+// UnionTheme is the resolver for the unionTheme field.
+func (r *queryResolver) UnionTheme(ctx context.Context, slug string) (*model.UnionTheme, error) {
+	return r.UnionController.UnionTheme(ctx, slug)
+}
+
+// UnionThemeVersions is the resolver for the unionThemeVersions field.
+func (r *queryResolver) UnionThemeVersions(ctx context.Context, unionID primitive.ObjectID) ([]*model.UnionTheme, error) {
+	return r.UnionController.UnionThemeVersions(ctx, unionID)
+}
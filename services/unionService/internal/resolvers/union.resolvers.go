@@ -41,13 +41,25 @@ func (r *queryResolver) Unions(ctx context.Context, page int, limit int) (*model
 	return r.UnionController.Unions(ctx, page, limit)
 }
 
+// AccountManager is the resolver for the accountManager field.
+func (r *unionResolver) AccountManager(ctx context.Context, obj *model.Union) ([]*model.Manager, error) {
+	return r.UnionController.AccountManagers(ctx, obj)
+}
+
+// CommunicationRep is the resolver for the communicationRep field.
+func (r *unionResolver) CommunicationRep(ctx context.Context, obj *model.Union) ([]*model.Manager, error) {
+	return r.UnionController.CommunicationReps(ctx, obj)
+}
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// Union returns UnionResolver implementation.
+func (r *Resolver) Union() UnionResolver { return &unionResolver{r} }
+
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
-
-
+type unionResolver struct{ *Resolver }
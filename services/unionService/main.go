@@ -6,9 +6,12 @@ import (
 	"net/http"
 	"os"
 	"strconv"
+	"time"
 
+	"younified-backend/providers/aws"
 	"younified-backend/providers/database"
 	"younified-backend/providers/graphqlclient"
+	"younified-backend/services/unionService/internal/auth"
 	controllers "younified-backend/services/unionService/internal/controller"
 	resolver "younified-backend/services/unionService/internal/resolvers"
 
@@ -23,6 +26,7 @@ const (
 	defaultRedisHost    = "localhost"
 	defaultEnvFile      = ".env"
 	defaultDatabaseName = "unified_base"
+	defaultArchiveGrace = 24
 )
 
 // Config holds the application configuration
@@ -33,6 +37,9 @@ type Config struct {
 	RedisPort     int
 	RedisPassword string
 	DatabaseName  string
+	awsRegion     string
+	awsAccessID   string
+	awsAccessKey  string
 }
 
 // loadConfiguration reads environment variables and returns a Config
@@ -65,6 +72,12 @@ func loadConfiguration() Config {
 
 	redisPassword := os.Getenv("REDIS_PASSWORD")
 
+	awsAccessKeyID := os.Getenv("AWS_ACCESS_KEY_ID")
+
+	awsAccessKey := os.Getenv("AWS_SECRET_ACCESS_KEY")
+
+	awsRegion := os.Getenv("AWS_REGION")
+
 	return Config{
 		Port:          port,
 		MongoURI:      mongoURI,
@@ -72,6 +85,9 @@ func loadConfiguration() Config {
 		RedisPort:     redisPort,
 		RedisPassword: redisPassword,
 		DatabaseName:  defaultDatabaseName,
+		awsRegion:     awsRegion,
+		awsAccessID:   awsAccessKeyID,
+		awsAccessKey:  awsAccessKey,
 	}
 }
 
@@ -92,6 +108,14 @@ func initializeRedis(config Config) *database.RedisClient {
 	return redisClient
 }
 
+func initializeAwsService(config Config) *aws.AWSProvider {
+	awsProvider, err := aws.NewAWSProvider(config.awsRegion, config.awsAccessID, config.awsAccessKey)
+	if err != nil {
+		log.Fatalf("Failed to create a session with aws : %v", err)
+	}
+	return &awsProvider
+}
+
 // initializeGraphQLManager creates a new GraphQL client
 func initializeGraphQLManager() *graphqlclient.Graph {
 	return graphqlclient.NewGraphql()
@@ -100,21 +124,57 @@ func initializeGraphQLManager() *graphqlclient.Graph {
 // createGraphQLServer sets up the GraphQL server with resolvers
 func createGraphQLServer(
 	dbManager *database.DBManager,
-	graphqlManager *graphqlclient.Graph,
-	redisClient *database.RedisClient,
+	unionController *controllers.UnionController,
 ) *handler.Server {
 	return handler.NewDefaultServer(resolver.NewExecutableSchema(resolver.Config{
 		Resolvers: &resolver.Resolver{
 			DBManager:       dbManager,
-			UnionController: controllers.NewUnionController(dbManager, graphqlManager, redisClient),
+			UnionController: unionController,
 		},
 	}))
 }
 
+// startProvisioningResume picks up provisioning left unfinished by a stopped
+// instance, at startup and then every minute
+func startProvisioningResume(ctx context.Context, unionController *controllers.UnionController) {
+	unionController.ResumeProvisioning(ctx)
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			unionController.ResumeProvisioning(ctx)
+		}
+	}
+}
+
 // setupRoutes configures HTTP routes
-func setupRoutes(srv *handler.Server) {
+func setupRoutes(srv *handler.Server, domains *database.DomainIndex) {
 	http.Handle("/", playground.Handler("GraphQL playground", "/graphql"))
-	http.Handle("/graphql", srv)
+	http.Handle("/graphql", domains.Middleware(graphqlclient.ServiceMiddleware(auth.Middleware(srv))))
+}
+
+// startUnionArchival archives the tenant databases of deleted unions. A union is
+// archived UNION_ARCHIVE_GRACE_HOURS after it was deleted, 24 by default, and can be
+// restored without an archive until then.
+func startUnionArchival(ctx context.Context, unionController *controllers.UnionController) {
+	hours, err := strconv.Atoi(os.Getenv("UNION_ARCHIVE_GRACE_HOURS"))
+	if err != nil || hours < 0 {
+		hours = defaultArchiveGrace
+	}
+	grace := time.Duration(hours) * time.Hour
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			unionController.RunUnionArchival(ctx, grace)
+		}
+	}
 }
 
 // startServer begins listening on the specified port
@@ -139,11 +199,32 @@ func main() {
 
 	graphqlManager := initializeGraphQLManager()
 
+	awsProvider := initializeAwsService(config)
+
+	unionController := controllers.NewUnionController(dbManager, graphqlManager, redisClient, awsProvider)
+	if err := unionController.EnsureThemeIndexes(ctx); err != nil {
+		log.Printf("could not create the theme indexes: %v", err)
+	}
+	if err := unionController.EnsureSlugIndexes(ctx); err != nil {
+		log.Printf("could not create the slug indexes: %v", err)
+	}
+	if err := unionController.MigrateSettings(ctx); err != nil {
+		log.Printf("could not migrate union settings: %v", err)
+	}
+	if err := unionController.BackfillModules(ctx); err != nil {
+		log.Printf("could not backfill union modules: %v", err)
+	}
+	if err := unionController.DropStoredPasswords(ctx); err != nil {
+		log.Printf("could not remove stored user passwords: %v", err)
+	}
+	go startProvisioningResume(ctx, unionController)
+	go startUnionArchival(ctx, unionController)
+
 	// Create GraphQL server
-	srv := createGraphQLServer(dbManager, graphqlManager, redisClient)
+	srv := createGraphQLServer(dbManager, unionController)
 
 	// Setup routes
-	setupRoutes(srv)
+	setupRoutes(srv, database.NewDomainIndex(dbManager, redisClient))
 
 	// Start server
 	startServer(config.Port)
@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const membershipCardSubject = "membership-card"
+
+// MembershipClaim is embedded in the QR code printed on a membership card
+type MembershipClaim struct {
+	UserID  primitive.ObjectID `json:"user_id"`
+	UnionID primitive.ObjectID `json:"union_id"`
+	jwt.StandardClaims
+}
+
+// membershipSecret derives a key distinct from the session key so a card token
+// can never be replayed as a login token and vice versa
+func membershipSecret() ([]byte, error) {
+	secret := os.Getenv("MEMBERSHIP_CARD_SECRET")
+	if secret == "" {
+		secret = os.Getenv("JWT_SECRET")
+	}
+	if secret == "" {
+		return nil, errors.New("membership card signing secret is not set")
+	}
+	return []byte(secret + ":" + membershipCardSubject), nil
+}
+
+// GenerateMembershipToken signs a card token that expires after ttl
+func GenerateMembershipToken(userID, unionID primitive.ObjectID, ttl time.Duration) (string, time.Time, error) {
+	secret, err := membershipSecret()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	expiresAt := time.Now().Add(ttl)
+	claims := MembershipClaim{
+		UserID:  userID,
+		UnionID: unionID,
+		StandardClaims: jwt.StandardClaims{
+			ExpiresAt: expiresAt.Unix(),
+			IssuedAt:  time.Now().Unix(),
+			Issuer:    "User-Service",
+			Subject:   membershipCardSubject,
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(secret)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token, expiresAt, nil
+}
+
+// ValidateMembershipToken verifies the signature, expiry and subject of a card token
+func ValidateMembershipToken(tokenString string) (*MembershipClaim, error) {
+	secret, err := membershipSecret()
+	if err != nil {
+		return nil, err
+	}
+	token, err := jwt.ParseWithClaims(tokenString, &MembershipClaim{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("invalid token signing method")
+		}
+		return secret, nil
+	})
+	if err != nil {
+		if ve, ok := err.(*jwt.ValidationError); ok && ve.Errors&jwt.ValidationErrorExpired != 0 {
+			return nil, ErrExpiredToken
+		}
+		return nil, ErrInvalidToken
+	}
+	claims, ok := token.Claims.(*MembershipClaim)
+	if !ok || !token.Valid || claims.Subject != membershipCardSubject {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
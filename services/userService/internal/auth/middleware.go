@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+type contextKey string
+
+const claimContextKey contextKey = "auth-claims"
+
+// SessionCheck reports whether the session of a validly signed token is still open
+type SessionCheck func(ctx context.Context, claims *TokenClaim) bool
+
+// Middleware puts the claims of a valid bearer token into the request context when
+// active confirms its session is still open, so tokens of suspended members or of
+// revoked sessions are ignored. Requests without a token pass through; resolvers
+// decide what needs one.
+func Middleware(active SessionCheck, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		token := strings.TrimSpace(strings.TrimPrefix(header, "Bearer "))
+		if header == "" || token == header {
+			next.ServeHTTP(w, r)
+			return
+		}
+		claims, err := ValidateJWTToken(token)
+		if err != nil || !active(r.Context(), claims) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ctx := context.WithValue(r.Context(), claimContextKey, claims)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// ForContext returns the claims of the caller, or nil when the request was not authenticated
+func ForContext(ctx context.Context) *TokenClaim {
+	claims, _ := ctx.Value(claimContextKey).(*TokenClaim)
+	return claims
+}
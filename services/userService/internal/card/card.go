@@ -0,0 +1,185 @@
+// Package card renders printable and on-screen membership cards.
+package card
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"strconv"
+	"strings"
+
+	"younified-backend/providers/imaging"
+
+	"github.com/go-pdf/fpdf"
+	qrcode "github.com/skip2/go-qrcode"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// Card dimensions follow the ISO/IEC 7810 ID-1 format at 300 dpi
+const (
+	width        = 1011
+	height       = 638
+	headerHeight = 170
+	margin       = 40
+	qrSize       = 330
+	logoSize     = 130
+	cardWidthMM  = 85.6
+	cardHeightMM = 53.98
+)
+
+var defaultPrimary = color.RGBA{R: 0x1F, G: 0x3A, B: 0x93, A: 0xFF}
+
+// Details is everything printed on a card
+type Details struct {
+	UnionName    string
+	PrimaryColor string
+	Logo         image.Image
+	MemberName   string
+	MemberNumber string
+	Status       string
+	ValidUntil   string
+	QRContent    string
+}
+
+// RenderPNG draws the card and returns it PNG encoded
+func RenderPNG(d Details) ([]byte, error) {
+	img, err := render(d)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("could not encode card: %v", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// RenderPDF wraps a rendered PNG card in a single card-sized PDF page
+func RenderPDF(cardPNG []byte) ([]byte, error) {
+	pdf := fpdf.NewCustom(&fpdf.InitType{
+		UnitStr: "mm",
+		Size:    fpdf.SizeType{Wd: cardWidthMM, Ht: cardHeightMM},
+	})
+	pdf.SetMargins(0, 0, 0)
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.AddPage()
+	pdf.RegisterImageOptionsReader("card", fpdf.ImageOptions{ImageType: "PNG"}, bytes.NewReader(cardPNG))
+	pdf.ImageOptions("card", 0, 0, cardWidthMM, cardHeightMM, false, fpdf.ImageOptions{ImageType: "PNG"}, 0, "")
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("could not render card pdf: %v", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func render(d Details) (*image.RGBA, error) {
+	regular, err := loadFont(goregular.TTF)
+	if err != nil {
+		return nil, err
+	}
+	bold, err := loadFont(gobold.TTF)
+	if err != nil {
+		return nil, err
+	}
+
+	primary := parseHexColor(d.PrimaryColor, defaultPrimary)
+	canvas := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(canvas, canvas.Bounds(), &image.Uniform{C: color.White}, image.Point{}, draw.Src)
+	draw.Draw(canvas, image.Rect(0, 0, width, headerHeight), &image.Uniform{C: primary}, image.Point{}, draw.Src)
+
+	textX := margin
+	if d.Logo != nil {
+		logo := imaging.Fit(d.Logo, logoSize)
+		lb := logo.Bounds()
+		top := (headerHeight - lb.Dy()) / 2
+		draw.Draw(canvas, image.Rect(margin, top, margin+lb.Dx(), top+lb.Dy()), logo, lb.Min, draw.Over)
+		textX = margin + logoSize + 30
+	}
+
+	headerText := contrastColor(primary)
+	drawText(canvas, face(bold, 46), headerText, textX, 80, d.UnionName)
+	drawText(canvas, face(regular, 28), headerText, textX, 130, "Membership Card")
+
+	dark := color.RGBA{R: 0x22, G: 0x22, B: 0x22, A: 0xFF}
+	muted := color.RGBA{R: 0x66, G: 0x66, B: 0x66, A: 0xFF}
+	y := headerHeight + 90
+	drawText(canvas, face(bold, 48), dark, margin, y, d.MemberName)
+	y += 80
+	drawText(canvas, face(regular, 26), muted, margin, y, "Member No.")
+	drawText(canvas, face(bold, 32), dark, margin+200, y, d.MemberNumber)
+	y += 60
+	drawText(canvas, face(regular, 26), muted, margin, y, "Status")
+	drawText(canvas, face(bold, 32), primary, margin+200, y, strings.ToUpper(d.Status))
+	y += 60
+	drawText(canvas, face(regular, 26), muted, margin, y, "Valid until")
+	drawText(canvas, face(bold, 32), dark, margin+200, y, d.ValidUntil)
+
+	qr, err := qrcode.New(d.QRContent, qrcode.Medium)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate qr code: %v", err)
+	}
+	qr.DisableBorder = true
+	qrImage := qr.Image(qrSize)
+	qrTop := headerHeight + (height-headerHeight-qrSize)/2
+	qrLeft := width - margin - qrSize
+	draw.Draw(canvas, image.Rect(qrLeft, qrTop, qrLeft+qrSize, qrTop+qrSize), qrImage, image.Point{}, draw.Src)
+
+	return canvas, nil
+}
+
+func loadFont(ttf []byte) (*opentype.Font, error) {
+	f, err := opentype.Parse(ttf)
+	if err != nil {
+		return nil, fmt.Errorf("could not load card font: %v", err)
+	}
+	return f, nil
+}
+
+func face(f *opentype.Font, size float64) font.Face {
+	// the embedded go fonts always produce a face, so the error is not actionable
+	fc, _ := opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+	return fc
+}
+
+func drawText(dst draw.Image, fc font.Face, c color.Color, x, y int, text string) {
+	d := &font.Drawer{
+		Dst:  dst,
+		Src:  image.NewUniform(c),
+		Face: fc,
+		Dot:  fixed.P(x, y),
+	}
+	d.DrawString(text)
+}
+
+// parseHexColor accepts "#rgb" or "#rrggbb" and falls back for anything else
+func parseHexColor(value string, fallback color.RGBA) color.RGBA {
+	hex := strings.TrimPrefix(strings.TrimSpace(value), "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 {
+		return fallback
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return fallback
+	}
+	return color.RGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 0xFF}
+}
+
+// contrastColor picks black or white text for the given background
+func contrastColor(bg color.RGBA) color.Color {
+	luminance := 0.299*float64(bg.R) + 0.587*float64(bg.G) + 0.114*float64(bg.B)
+	if luminance > 160 {
+		return color.Black
+	}
+	return color.White
+}
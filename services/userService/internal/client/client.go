@@ -0,0 +1,49 @@
+// Package client records where a request came from, for the login history
+package client
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+)
+
+type contextKey string
+
+const infoContextKey contextKey = "client"
+
+// Info describes the caller of a request
+type Info struct {
+	IP        string
+	UserAgent string
+}
+
+// Middleware puts the caller's address and user agent into the request context. The
+// gateway passes the address it was called from in X-Forwarded-For.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		info := &Info{IP: remoteIP(r), UserAgent: r.UserAgent()}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), infoContextKey, info)))
+	})
+}
+
+// FromContext returns the caller of the request, or an empty Info outside a request
+func FromContext(ctx context.Context) *Info {
+	if info, ok := ctx.Value(infoContextKey).(*Info); ok {
+		return info
+	}
+	return &Info{}
+}
+
+func remoteIP(r *http.Request) string {
+	// the gateway appends the address it saw last; anything before it came from the caller
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		entries := strings.Split(forwarded, ",")
+		return strings.TrimSpace(entries[len(entries)-1])
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
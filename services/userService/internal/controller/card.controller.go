@@ -0,0 +1,180 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"log"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"younified-backend/contracts/user/model"
+	"younified-backend/providers/database"
+	"younified-backend/providers/imaging"
+	"younified-backend/services/userService/internal/auth"
+	"younified-backend/services/userService/internal/card"
+	"younified-backend/services/userService/internal/i18n"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	defaultMembershipCardTTL = 7 * 24 * time.Hour
+	maxCardLogoBytes         = 5 << 20
+)
+
+// MembershipCard renders a branded card for an active member with a signed,
+// expiring token in its QR code
+func (c *UserController) MembershipCard(ctx context.Context, userID primitive.ObjectID, unionID primitive.ObjectID) (*model.MembershipCard, error) {
+	if userID.IsZero() || unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUserAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireSelfOrAdmin(ctx, userID, unionID); err != nil {
+		return nil, err
+	}
+
+	user, err := c.UserMongoRepository.GetByID(ctx, unionID.Hex(), userID)
+	if err != nil || user == nil {
+		return nil, i18n.Errorf(i18n.ErrUserNotFound)
+	}
+	if !isCardEligible(user) {
+		return nil, i18n.Errorf(i18n.ErrCardInactive)
+	}
+
+	union, err := c.UserMongoRepository.GetUnion(ctx, unionID)
+	if err != nil || union == nil {
+		return nil, i18n.Errorf(i18n.ErrUnionNotFound)
+	}
+
+	ttl := membershipCardTTL()
+	if hours, _ := c.settings.Int(ctx, unionID, database.SettingMembershipCardTTLHours); hours > 0 {
+		ttl = time.Duration(hours) * time.Hour
+	}
+	token, expiresAt, err := auth.GenerateMembershipToken(userID, unionID, ttl)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrCardSign)
+	}
+
+	verificationURL := membershipVerificationURL(token)
+	qrContent := token
+	if verificationURL != "" {
+		qrContent = verificationURL
+	}
+
+	cardPNG, err := card.RenderPNG(card.Details{
+		UnionName:    union.Name,
+		PrimaryColor: union.Theme,
+		Logo:         c.fetchCardLogo(ctx, union.ThemeImage),
+		MemberName:   strings.TrimSpace(user.FirstName + " " + user.LastName),
+		MemberNumber: memberNumber(user),
+		Status:       user.Status,
+		ValidUntil:   expiresAt.Format("2006-01-02"),
+		QRContent:    qrContent,
+	})
+	if err != nil {
+		return nil, err
+	}
+	cardPDF, err := card.RenderPDF(cardPNG)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.MembershipCard{
+		Token:           token,
+		ExpiresAt:       expiresAt,
+		VerificationURL: verificationURL,
+		Png:             base64.StdEncoding.EncodeToString(cardPNG),
+		Pdf:             base64.StdEncoding.EncodeToString(cardPDF),
+	}, nil
+}
+
+// VerifyMembership answers a scanned card. It never returns member details, only
+// whether the membership is currently valid and the union it belongs to.
+func (c *UserController) VerifyMembership(ctx context.Context, token string) (*model.MembershipVerification, error) {
+	invalid := &model.MembershipVerification{Valid: false}
+
+	claims, err := auth.ValidateMembershipToken(token)
+	if err != nil {
+		return invalid, nil
+	}
+	union, err := c.UserMongoRepository.GetUnion(ctx, claims.UnionID)
+	if err != nil || union == nil || union.Deleted {
+		return invalid, nil
+	}
+	user, err := c.UserMongoRepository.GetByID(ctx, claims.UnionID.Hex(), claims.UserID)
+	if err != nil || user == nil || !isCardEligible(user) {
+		return &model.MembershipVerification{Valid: false, UnionName: union.Name}, nil
+	}
+	return &model.MembershipVerification{Valid: true, UnionName: union.Name}, nil
+}
+
+func isCardEligible(user *model.User) bool {
+	return !user.Deleted && user.Status == model.StatusActive
+}
+
+func memberNumber(user *model.User) string {
+	switch {
+	case user.MemberID != "":
+		return user.MemberID
+	case user.EmployeeID != "":
+		return user.EmployeeID
+	default:
+		return user.ID.Hex()
+	}
+}
+
+func membershipCardTTL() time.Duration {
+	hours, err := strconv.Atoi(os.Getenv("MEMBERSHIP_CARD_TTL_HOURS"))
+	if err != nil || hours <= 0 {
+		return defaultMembershipCardTTL
+	}
+	return time.Duration(hours) * time.Hour
+}
+
+// membershipVerificationURL points the QR code at the public verification endpoint
+func membershipVerificationURL(token string) string {
+	base := os.Getenv("MEMBERSHIP_VERIFY_URL")
+	if base == "" {
+		return ""
+	}
+	return base + "?token=" + url.QueryEscape(token)
+}
+
+// fetchCardLogo loads the union theme image from our own bucket; a card is still
+// rendered without it. The URL is set by union admins, so it is never fetched as given:
+// only the key of an object in the bucket is taken from it.
+func (c *UserController) fetchCardLogo(ctx context.Context, imageURL string) image.Image {
+	if imageURL == "" || c.awsProvider == nil {
+		return nil
+	}
+	bucket := os.Getenv("AWS_S3_BUCKET")
+	prefix := fmt.Sprintf("https://%s.s3.%s.amazonaws.com/", bucket, os.Getenv("AWS_REGION"))
+	key := strings.TrimPrefix(imageURL, prefix)
+	if bucket == "" || key == imageURL || key == "" {
+		log.Printf("union theme image %s is not in our bucket, leaving it off the card", imageURL)
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	object, err := c.awsProvider.GetS3Object(ctx, bucket, key)
+	if err != nil {
+		log.Printf("could not fetch union theme image: %v", err)
+		return nil
+	}
+	data, err := imaging.Read(bytes.NewReader(object), maxCardLogoBytes)
+	if err != nil {
+		return nil
+	}
+	logo, _, err := imaging.Decode(data)
+	if err != nil {
+		log.Printf("could not decode union theme image: %v", err)
+		return nil
+	}
+	return logo
+}
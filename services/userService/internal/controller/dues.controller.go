@@ -0,0 +1,489 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"younified-backend/contracts/user/model"
+	"younified-backend/providers/graphqlclient"
+	"younified-backend/services/userService/internal/i18n"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (c *UserController) CreateDuesSchedule(ctx context.Context, unionID primitive.ObjectID, input model.DuesScheduleInput) (*model.DuesSchedule, error) {
+	if unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUnionRequired)
+		return nil, err
+	}
+	if input.Name == nil || strings.TrimSpace(*input.Name) == "" || input.Method == nil || input.EffectiveFrom == nil {
+		err := i18n.Errorf(i18n.ErrDuesScheduleFieldsRequired)
+		return nil, err
+	}
+	if err := c.requireAdminOrService(ctx, unionID); err != nil {
+		return nil, err
+	}
+	schedule := &model.DuesSchedule{UnionID: unionID, Active: true}
+	applyDuesScheduleInput(schedule, input)
+	if err := validateDuesSchedule(schedule); err != nil {
+		return nil, err
+	}
+	return c.DuesMongoRepository.CreateSchedule(ctx, unionID.Hex(), schedule)
+}
+
+// UpdateDuesSchedule changes a schedule for the periods assessed from now on; past
+// assessments stay on the ledger as they were
+func (c *UserController) UpdateDuesSchedule(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID, input model.DuesScheduleInput) (*model.DuesSchedule, error) {
+	if unionID.IsZero() || id.IsZero() {
+		err := i18n.Errorf(i18n.ErrIDAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireAdminOrService(ctx, unionID); err != nil {
+		return nil, err
+	}
+	schedule, err := c.DuesMongoRepository.GetSchedule(ctx, unionID.Hex(), id)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrDuesScheduleNotFound)
+	}
+	applyDuesScheduleInput(schedule, input)
+	if err := validateDuesSchedule(schedule); err != nil {
+		return nil, err
+	}
+	set := bson.M{
+		"name":           schedule.Name,
+		"membershipType": schedule.MembershipType,
+		"employmentType": schedule.EmploymentType,
+		"method":         schedule.Method,
+		"amount":         schedule.Amount,
+		"percentage":     schedule.Percentage,
+		"minAmount":      schedule.MinAmount,
+		"maxAmount":      schedule.MaxAmount,
+		"gracePeriods":   schedule.GracePeriods,
+		"effectiveFrom":  schedule.EffectiveFrom,
+		"effectiveTo":    schedule.EffectiveTo,
+		"active":         schedule.Active,
+	}
+	return c.DuesMongoRepository.UpdateSchedule(ctx, unionID.Hex(), id, set)
+}
+
+func (c *UserController) DuesSchedules(ctx context.Context, unionID primitive.ObjectID) ([]*model.DuesSchedule, error) {
+	if unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUnionRequired)
+		return nil, err
+	}
+	return c.DuesMongoRepository.Schedules(ctx, unionID.Hex())
+}
+
+// AssessDues charges every member the dues of their schedule for period. Members are
+// assessed once per period, so a run can be repeated after fixing what was skipped.
+func (c *UserController) AssessDues(ctx context.Context, unionID primitive.ObjectID, period string, earnings []*model.MemberEarningsInput) (*model.DuesAssessmentReport, error) {
+	if unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUnionRequired)
+		return nil, err
+	}
+	if err := c.requireAdminOrService(ctx, unionID); err != nil {
+		return nil, err
+	}
+	start, err := time.Parse(model.DuesPeriodLayout, period)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrPeriodFormat)
+	}
+	union := unionID.Hex()
+	schedules, err := c.DuesMongoRepository.Schedules(ctx, union)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrDuesSchedulesLoad, err)
+	}
+	members, err := c.DuesMongoRepository.DuesMembers(ctx, union)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrMembersLoad, err)
+	}
+	earned := map[primitive.ObjectID]float64{}
+	for _, e := range earnings {
+		earned[e.UserID] += e.Earnings
+	}
+
+	report := &model.DuesAssessmentReport{Period: period, Skipped: []*model.DuesSkip{}}
+	skip := func(userID primitive.ObjectID, reason string) {
+		report.Skipped = append(report.Skipped, &model.DuesSkip{UserID: userID, Reason: reason})
+	}
+	for _, user := range members {
+		if !owesDues(user, period) {
+			continue
+		}
+		schedule := duesScheduleFor(schedules, user, period)
+		if schedule == nil {
+			skip(user.ID, "no dues schedule matches the member")
+			continue
+		}
+		amount, reported := earned[user.ID]
+		if schedule.Method == model.DuesMethodPercentage && !reported {
+			skip(user.ID, "no earnings reported for a percentage schedule")
+			continue
+		}
+		entry := &model.DuesLedgerEntry{
+			UnionID:       unionID,
+			UserID:        user.ID,
+			Type:          model.DuesEntryAssessment,
+			Period:        period,
+			Amount:        schedule.Assess(amount),
+			Description:   fmt.Sprintf("%s dues for %s", schedule.Name, period),
+			ScheduleID:    schedule.ID,
+			EffectiveDate: start,
+		}
+		booked, err := c.DuesMongoRepository.AddAssessment(ctx, union, entry)
+		if err != nil {
+			return report, i18n.Errorf(i18n.ErrDuesAssess, err)
+		}
+		if !booked {
+			skip(user.ID, "already assessed for the period")
+			continue
+		}
+		report.Assessed++
+		report.Amount += entry.Amount
+	}
+	report.Amount = model.RoundCents(report.Amount)
+
+	// arrears grow with time, so every standing is refreshed, not only the assessed ones
+	for _, user := range members {
+		if _, err := c.refreshDuesStanding(ctx, user, schedules); err != nil {
+			log.Printf("could not refresh dues standing of user %s: %v", user.ID.Hex(), err)
+		}
+	}
+	go c.UserRedisRepository.InvalidateCache(context.Background(), "all-users-"+union)
+	return report, nil
+}
+
+// RecordDuesEntry books a payment or an adjustment on a member's ledger, recorded as
+// the signed in admin
+func (c *UserController) RecordDuesEntry(ctx context.Context, unionID primitive.ObjectID, input model.DuesEntryInput) (*model.DuesLedgerEntry, error) {
+	if unionID.IsZero() || input.UserID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUserAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireAdminOrService(ctx, unionID); err != nil {
+		return nil, err
+	}
+	user, err := c.UserMongoRepository.GetByID(ctx, unionID.Hex(), input.UserID)
+	if err != nil || user == nil {
+		return nil, i18n.Errorf(i18n.ErrUserNotFound)
+	}
+
+	entry := &model.DuesLedgerEntry{UnionID: unionID, UserID: user.ID, Type: input.Type, RecordedBy: actorID(ctx), EffectiveDate: time.Now()}
+	switch input.Type {
+	case model.DuesEntryPayment:
+		if input.Amount <= 0 {
+			return nil, i18n.Errorf(i18n.ErrPaymentPositive)
+		}
+		entry.Amount = -model.RoundCents(input.Amount)
+		entry.Description = "Payment"
+	case model.DuesEntryAdjustment:
+		if model.RoundCents(input.Amount) == 0 {
+			return nil, i18n.Errorf(i18n.ErrAdjustmentZero)
+		}
+		entry.Amount = model.RoundCents(input.Amount)
+		entry.Description = "Adjustment"
+	default:
+		return nil, i18n.Errorf(i18n.ErrDuesEntryType, model.DuesEntryPayment, model.DuesEntryAdjustment)
+	}
+	if input.EffectiveDate != nil && !input.EffectiveDate.IsZero() {
+		entry.EffectiveDate = *input.EffectiveDate
+	}
+	entry.Period = model.DuesPeriod(entry.EffectiveDate)
+	if input.Period != nil && *input.Period != "" {
+		if _, err := time.Parse(model.DuesPeriodLayout, *input.Period); err != nil {
+			return nil, i18n.Errorf(i18n.ErrPeriodFormat)
+		}
+		entry.Period = *input.Period
+	}
+	if input.Description != nil && *input.Description != "" {
+		entry.Description = *input.Description
+	}
+	if input.Reference != nil {
+		entry.Reference = *input.Reference
+	}
+
+	entry, err = c.DuesMongoRepository.AddEntry(ctx, unionID.Hex(), entry)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrDuesEntryRecord)
+	}
+	if _, err := c.refreshDuesStanding(ctx, user, nil); err != nil {
+		log.Printf("could not refresh dues standing of user %s: %v", user.ID.Hex(), err)
+	}
+	return entry, nil
+}
+
+func (c *UserController) DuesLedger(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID) ([]*model.DuesLedgerEntry, error) {
+	if unionID.IsZero() || userID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUserAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireDuesReader(ctx, userID, unionID); err != nil {
+		return nil, err
+	}
+	return c.DuesMongoRepository.Entries(ctx, unionID.Hex(), bson.M{"userID": userID})
+}
+
+// DuesStanding returns the current standing of a member; this is what other features
+// such as elections check for good standing
+func (c *UserController) DuesStanding(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID) (*model.DuesStanding, error) {
+	if unionID.IsZero() || userID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUserAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireDuesReader(ctx, userID, unionID); err != nil {
+		return nil, err
+	}
+	user, err := c.UserMongoRepository.GetByID(ctx, unionID.Hex(), userID)
+	if err != nil || user == nil {
+		return nil, i18n.Errorf(i18n.ErrUserNotFound)
+	}
+	return c.refreshDuesStanding(ctx, user, nil)
+}
+
+// DuesArrears lists the members with at least minPeriods periods in arrears, most
+// behind first
+func (c *UserController) DuesArrears(ctx context.Context, unionID primitive.ObjectID, minPeriods *int) ([]*model.DuesArrears, error) {
+	if unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUnionRequired)
+		return nil, err
+	}
+	if err := c.requireAdminOrService(ctx, unionID); err != nil {
+		return nil, err
+	}
+	minimum := 1
+	if minPeriods != nil && *minPeriods > 0 {
+		minimum = *minPeriods
+	}
+	users, err := c.DuesMongoRepository.UsersInArrears(ctx, unionID.Hex(), minimum)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrArrearsLoad, err)
+	}
+	rows := []*model.DuesArrears{}
+	for _, user := range users {
+		rows = append(rows, &model.DuesArrears{
+			UserID:             user.ID,
+			Name:               strings.TrimSpace(user.FirstName + " " + user.LastName),
+			EmployeeID:         user.EmployeeID,
+			Balance:            user.DuesStanding.Balance,
+			ArrearsPeriods:     user.DuesStanding.ArrearsPeriods,
+			OldestUnpaidPeriod: user.DuesStanding.OldestUnpaidPeriod,
+			GoodStanding:       user.DuesStanding.GoodStanding,
+		})
+	}
+	sort.SliceStable(rows, func(i, j int) bool { return rows[i].ArrearsPeriods > rows[j].ArrearsPeriods })
+	return rows, nil
+}
+
+// DuesStatement lists a member's ledger entries dated between from and to
+func (c *UserController) DuesStatement(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID, from time.Time, to time.Time) (*model.DuesStatement, error) {
+	if unionID.IsZero() || userID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUserAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireDuesReader(ctx, userID, unionID); err != nil {
+		return nil, err
+	}
+	if to.Before(from) {
+		return nil, i18n.Errorf(i18n.ErrRangeOrder)
+	}
+	user, err := c.UserMongoRepository.GetByID(ctx, unionID.Hex(), userID)
+	if err != nil || user == nil {
+		return nil, i18n.Errorf(i18n.ErrUserNotFound)
+	}
+	entries, err := c.DuesMongoRepository.Entries(ctx, unionID.Hex(), bson.M{"userID": userID})
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrDuesLedgerLoad)
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].EffectiveDate.Before(entries[j].EffectiveDate) })
+
+	statement := &model.DuesStatement{
+		UnionID:    unionID,
+		UserID:     userID,
+		Name:       strings.TrimSpace(user.FirstName + " " + user.LastName),
+		EmployeeID: user.EmployeeID,
+		From:       from,
+		To:         to,
+		Entries:    []*model.DuesLedgerEntry{},
+	}
+	for _, entry := range entries {
+		switch {
+		case entry.EffectiveDate.Before(from):
+			statement.OpeningBalance += entry.Amount
+		case !entry.EffectiveDate.After(to):
+			statement.Entries = append(statement.Entries, entry)
+			statement.ClosingBalance += entry.Amount
+		}
+	}
+	statement.OpeningBalance = model.RoundCents(statement.OpeningBalance)
+	statement.ClosingBalance = model.RoundCents(statement.OpeningBalance + statement.ClosingBalance)
+	statement.Standing, err = c.refreshDuesStanding(ctx, user, nil)
+	if err != nil {
+		log.Printf("could not refresh dues standing of user %s: %v", userID.Hex(), err)
+	}
+	return statement, nil
+}
+
+// EmailDuesStatement sends the statement to the member through communicationService
+func (c *UserController) EmailDuesStatement(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID, from time.Time, to time.Time) (*string, error) {
+	statement, err := c.DuesStatement(ctx, unionID, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	user, err := c.UserMongoRepository.GetByID(ctx, unionID.Hex(), userID)
+	if err != nil || user == nil || user.Profile.Email == "" {
+		return nil, i18n.Errorf(i18n.ErrMemberNoEmail)
+	}
+
+	const layout = "Jan 2, 2006"
+	text := c.mailTextFor(ctx, unionID.Hex(), user)
+	bodies := text.Bodies()
+	var rows strings.Builder
+	for _, entry := range statement.Entries {
+		rows.WriteString(bodies.DuesStatementRow(entry.EffectiveDate.Format(layout), entry.Description, formatMoney(entry.Amount)))
+	}
+	body := bodies.DuesStatement(user.FirstName, from.Format(layout), to.Format(layout),
+		formatMoney(statement.OpeningBalance), rows.String(), formatMoney(statement.ClosingBalance))
+	if _, err := c.sendMail(ctx, user.Profile.Email, text.Subject(i18n.SubjectDuesStatement), body, "dues"); err != nil {
+		return nil, i18n.Errorf(i18n.ErrStatementSend)
+	}
+	return &Response, nil
+}
+
+// requireDuesReader lets members read their own dues, and admins and backend services
+// anyone's
+func (c *UserController) requireDuesReader(ctx context.Context, userID primitive.ObjectID, unionID primitive.ObjectID) error {
+	if graphqlclient.IsService(ctx) {
+		return nil
+	}
+	return c.requireSelfOrAdmin(ctx, userID, unionID)
+}
+
+// refreshDuesStanding recomputes a member's standing from their ledger and stores it
+// on the user. schedules may be nil to load them.
+func (c *UserController) refreshDuesStanding(ctx context.Context, user *model.User, schedules []*model.DuesSchedule) (*model.DuesStanding, error) {
+	union := user.UnionID.Hex()
+	if schedules == nil {
+		var err error
+		if schedules, err = c.DuesMongoRepository.Schedules(ctx, union); err != nil {
+			return nil, err
+		}
+	}
+	entries, err := c.DuesMongoRepository.Entries(ctx, union, bson.M{"userID": user.ID})
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	current := model.DuesPeriod(now)
+	grace := 0
+	if schedule := duesScheduleFor(schedules, user, current); schedule != nil {
+		grace = schedule.GracePeriods
+	}
+	standing := model.ComputeDuesStanding(entries, current, grace, now)
+	if err := c.DuesMongoRepository.SetStanding(ctx, union, user.ID, standing); err != nil {
+		return nil, err
+	}
+	go c.UserRedisRepository.InvalidateCache(context.Background(), user.ID.Hex())
+	return standing, nil
+}
+
+// owesDues reports whether a member is assessed dues for period: applicants are not
+// yet, and members whose dues were stopped by a status change no longer are
+func owesDues(user *model.User, period string) bool {
+	if model.NormalizeStatus(user.Status) == model.StatusApplicant {
+		return false
+	}
+	return user.DuesStoppedAt.IsZero() || model.DuesPeriod(user.DuesStoppedAt) > period
+}
+
+// duesScheduleFor picks the most specific schedule matching the member in period
+func duesScheduleFor(schedules []*model.DuesSchedule, user *model.User, period string) *model.DuesSchedule {
+	var best *model.DuesSchedule
+	bestSpecificity := -1
+	for _, schedule := range schedules {
+		if ok, specificity := schedule.Matches(user, period); ok && specificity > bestSpecificity {
+			best, bestSpecificity = schedule, specificity
+		}
+	}
+	return best
+}
+
+func applyDuesScheduleInput(schedule *model.DuesSchedule, input model.DuesScheduleInput) {
+	if input.Name != nil && strings.TrimSpace(*input.Name) != "" {
+		schedule.Name = strings.TrimSpace(*input.Name)
+	}
+	if input.MembershipType != nil {
+		schedule.MembershipType = *input.MembershipType
+	}
+	if input.EmploymentType != nil {
+		schedule.EmploymentType = *input.EmploymentType
+	}
+	if input.Method != nil {
+		schedule.Method = *input.Method
+	}
+	if input.Amount != nil {
+		schedule.Amount = *input.Amount
+	}
+	if input.Percentage != nil {
+		schedule.Percentage = *input.Percentage
+	}
+	if input.MinAmount != nil {
+		schedule.MinAmount = *input.MinAmount
+	}
+	if input.MaxAmount != nil {
+		schedule.MaxAmount = *input.MaxAmount
+	}
+	if input.GracePeriods != nil {
+		schedule.GracePeriods = *input.GracePeriods
+	}
+	if input.EffectiveFrom != nil {
+		schedule.EffectiveFrom = *input.EffectiveFrom
+	}
+	if input.EffectiveTo != nil {
+		schedule.EffectiveTo = *input.EffectiveTo
+	}
+	if input.Active != nil {
+		schedule.Active = *input.Active
+	}
+}
+
+func validateDuesSchedule(schedule *model.DuesSchedule) error {
+	switch schedule.Method {
+	case model.DuesMethodFlat:
+		if schedule.Amount <= 0 {
+			return i18n.Errorf(i18n.ErrDuesFlatAmount)
+		}
+	case model.DuesMethodPercentage:
+		if schedule.Percentage <= 0 || schedule.Percentage > 100 {
+			return i18n.Errorf(i18n.ErrDuesPercentage)
+		}
+	default:
+		return i18n.Errorf(i18n.ErrDuesMethod, model.DuesMethodFlat, model.DuesMethodPercentage)
+	}
+	if schedule.MinAmount < 0 || schedule.MaxAmount < 0 || schedule.GracePeriods < 0 {
+		return i18n.Errorf(i18n.ErrDuesLimitsNegative)
+	}
+	if schedule.MaxAmount > 0 && schedule.MaxAmount < schedule.MinAmount {
+		return i18n.Errorf(i18n.ErrDuesMaxBelowMin)
+	}
+	if _, err := time.Parse(model.DuesPeriodLayout, schedule.EffectiveFrom); err != nil {
+		return i18n.Errorf(i18n.ErrEffectiveFromFormat)
+	}
+	if schedule.EffectiveTo != "" {
+		if _, err := time.Parse(model.DuesPeriodLayout, schedule.EffectiveTo); err != nil {
+			return i18n.Errorf(i18n.ErrEffectiveToFormat)
+		}
+		if schedule.EffectiveTo < schedule.EffectiveFrom {
+			return i18n.Errorf(i18n.ErrEffectiveOrder)
+		}
+	}
+	return nil
+}
+
+func formatMoney(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
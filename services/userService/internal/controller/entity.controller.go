@@ -0,0 +1,102 @@
+package controllers
+
+import (
+	"context"
+	"log"
+	"younified-backend/contracts/user/model"
+	"younified-backend/providers/graphqlclient"
+	"younified-backend/services/userService/internal/auth"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// UsersByIDs resolves the user references of other services for the gateway, in
+// the order of ids; unknown ids resolve to nil. A signed in caller only sees the users
+// of their union, and what any member may see of another; only backend services,
+// which references carry no union for, may search every union and see contact details.
+func (c *UserController) UsersByIDs(ctx context.Context, ids []primitive.ObjectID) ([]*model.User, error) {
+	found := map[primitive.ObjectID]*model.User{}
+	missing := func() []primitive.ObjectID {
+		rest := []primitive.ObjectID{}
+		for _, id := range ids {
+			if _, ok := found[id]; !ok {
+				rest = append(rest, id)
+			}
+		}
+		return rest
+	}
+	search := func(unionID string) error {
+		users, err := c.UserMongoRepository.GetByIDs(ctx, unionID, missing())
+		if err != nil {
+			return err
+		}
+		for _, user := range users {
+			found[user.ID] = user
+		}
+		return nil
+	}
+
+	service := graphqlclient.IsService(ctx)
+	if claims := auth.ForContext(ctx); claims != nil && !claims.UnionID.IsZero() && !service {
+		if err := search(claims.UnionID.Hex()); err != nil {
+			return nil, err
+		}
+	} else if service {
+		unions, err := c.dbManager.ListUnions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, union := range unions {
+			if err := search(union.ID.Hex()); err != nil {
+				log.Printf("could not resolve user references in union %s: %v", union.ID.Hex(), err)
+				continue
+			}
+			if len(missing()) == 0 {
+				break
+			}
+		}
+	}
+
+	users := make([]*model.User, len(ids))
+	for i, id := range ids {
+		user, ok := found[id]
+		switch {
+		case !ok:
+		case service:
+			users[i] = withoutSecrets(user)
+		default:
+			users[i] = userReference(user)
+		}
+	}
+	return users, nil
+}
+
+// userReference keeps the fields of user that any member of the union may see, such
+// as the author of a post
+func userReference(user *model.User) *model.User {
+	return &model.User{
+		ID:            user.ID,
+		UnionID:       user.UnionID,
+		Username:      user.Username,
+		FirstName:     user.FirstName,
+		LastName:      user.LastName,
+		CommonName:    user.CommonName,
+		UnionPosition: user.UnionPosition,
+		Status:        user.Status,
+		Profile: model.UserInfo{
+			ImageURL: user.Profile.ImageURL,
+			Photo:    user.Profile.Photo,
+		},
+	}
+}
+
+// withoutSecrets drops the credentials of user, which no other service needs
+func withoutSecrets(user *model.User) *model.User {
+	user.Password = ""
+	user.Token = ""
+	user.Tokens = nil
+	user.PasswordResetKey = ""
+	user.EmailPassword = ""
+	user.EmailVerification = nil
+	return user
+}
@@ -0,0 +1,313 @@
+package controllers
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+	"younified-backend/contracts/user/model"
+	"younified-backend/providers/graphqlclient"
+	"younified-backend/services/userService/internal/auth"
+	"younified-backend/services/userService/internal/i18n"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	emailVerificationTTL = time.Hour
+	// maxVerificationAttempts is how many codes can be tried before a new one is needed
+	maxVerificationAttempts = 5
+)
+
+// RequestEmailVerification mails a one-time code to the profile email. Only confirmed
+// addresses are used to link user records of different unions.
+func (c *UserController) RequestEmailVerification(ctx context.Context, userID primitive.ObjectID, unionID primitive.ObjectID) (*string, error) {
+	if userID.IsZero() || unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUserAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireSelf(ctx, userID, unionID); err != nil {
+		return nil, err
+	}
+	user, err := c.UserMongoRepository.GetByID(ctx, unionID.Hex(), userID)
+	if err != nil || user == nil {
+		return nil, i18n.Errorf(i18n.ErrUserNotFound)
+	}
+	address := normalizeEmail(user.Profile.Email)
+	if address == "" {
+		return nil, i18n.Errorf(i18n.ErrNoEmailToVerify)
+	}
+
+	code, err := verificationCode()
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrVerificationCode)
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"emailVerification": &model.EmailVerification{
+				Email:     address,
+				CodeHash:  hashVerificationCode(code, userID),
+				ExpiresAt: time.Now().Add(emailVerificationTTL),
+			},
+		},
+	}
+	if _, err := c.UserMongoRepository.UpdateUser(ctx, unionID.Hex(), bson.M{"_id": userID}, update); err != nil {
+		return nil, i18n.Errorf(i18n.ErrVerificationStart)
+	}
+
+	text := c.mailTextFor(ctx, unionID.Hex(), user)
+	if _, err := c.sendMail(ctx, address, text.Subject(i18n.SubjectEmailVerification), text.Bodies().EmailVerification(user.Username, code), "verification"); err != nil {
+		return nil, i18n.Errorf(i18n.ErrVerificationSend)
+	}
+	return &Response, nil
+}
+
+// VerifyEmail confirms the address the code was sent to and links the user record
+// to the identity of that address
+func (c *UserController) VerifyEmail(ctx context.Context, userID primitive.ObjectID, unionID primitive.ObjectID, code string) (*model.User, error) {
+	if userID.IsZero() || unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUserAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireSelf(ctx, userID, unionID); err != nil {
+		return nil, err
+	}
+	user, err := c.UserMongoRepository.GetByID(ctx, unionID.Hex(), userID)
+	if err != nil || user == nil {
+		return nil, i18n.Errorf(i18n.ErrUserNotFound)
+	}
+	pending := user.EmailVerification
+	if pending == nil || time.Now().After(pending.ExpiresAt) {
+		return nil, i18n.Errorf(i18n.ErrVerificationExpired)
+	}
+	// count the attempt before checking the code, so parallel guesses are counted too
+	attempt := bson.M{"$inc": bson.M{"emailVerification.attempts": 1}}
+	limit := bson.M{"_id": userID, "emailVerification.attempts": bson.M{"$lt": maxVerificationAttempts}}
+	if pending.Attempts >= maxVerificationAttempts {
+		return nil, i18n.Errorf(i18n.ErrVerificationLocked)
+	}
+	if _, err := c.UserMongoRepository.UpdateUser(ctx, unionID.Hex(), limit, attempt); err != nil {
+		return nil, i18n.Errorf(i18n.ErrVerificationLocked)
+	}
+	if pending.CodeHash != hashVerificationCode(strings.TrimSpace(code), userID) {
+		return nil, i18n.Errorf(i18n.ErrVerificationInvalid)
+	}
+	if pending.Email != normalizeEmail(user.Profile.Email) {
+		return nil, i18n.Errorf(i18n.ErrVerificationChanged)
+	}
+
+	update := bson.M{
+		"$set":   bson.M{"verifiedEmail": pending.Email, "emailVerifiedAt": time.Now()},
+		"$unset": bson.M{"emailVerification": ""},
+	}
+	updatedUser, err := c.UserMongoRepository.UpdateUser(ctx, unionID.Hex(), bson.M{"_id": userID}, update)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrVerificationFailed)
+	}
+
+	_, err = c.UserMongoRepository.LinkIdentity(ctx, pending.Email, &model.IdentityMembership{
+		UnionID:    unionID,
+		UserID:     userID,
+		Username:   user.Username,
+		EmployeeID: user.EmployeeID,
+	})
+	if err != nil {
+		log.Printf("could not link identity of user %s: %v", userID.Hex(), err)
+	}
+	go c.UserRedisRepository.InvalidateCache(context.Background(), userID.Hex())
+	return updatedUser, nil
+}
+
+// MyMemberships lists the memberships of the signed in person for the union switcher
+func (c *UserController) MyMemberships(ctx context.Context) ([]*model.UnionMembership, error) {
+	claims := auth.ForContext(ctx)
+	if claims == nil {
+		return nil, i18n.Errorf(i18n.ErrAuthRequired)
+	}
+	links, err := c.linkedMemberships(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	memberships := []*model.UnionMembership{}
+	for _, link := range links {
+		user, err := c.UserMongoRepository.GetByID(ctx, link.UnionID.Hex(), link.UserID)
+		if err != nil || user == nil || user.Deleted || !canSignIn(user) {
+			continue
+		}
+		membership := &model.UnionMembership{
+			UnionID:  link.UnionID,
+			UserID:   user.ID,
+			Username: user.Username,
+			Status:   user.Status,
+			Current:  link.UnionID == claims.UnionID,
+		}
+		if union, err := c.UserMongoRepository.GetUnion(ctx, link.UnionID); err == nil && union != nil {
+			membership.UnionName = union.Name
+		}
+		memberships = append(memberships, membership)
+	}
+	return memberships, nil
+}
+
+// SwitchUnion issues a token for the caller's membership in unionID
+func (c *UserController) SwitchUnion(ctx context.Context, unionID primitive.ObjectID) (*model.SingleUserAuth, error) {
+	claims := auth.ForContext(ctx)
+	if claims == nil {
+		return nil, i18n.Errorf(i18n.ErrAuthRequired)
+	}
+	if unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUnionRequired)
+		return nil, err
+	}
+	links, err := c.linkedMemberships(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, link := range links {
+		if link.UnionID != unionID {
+			continue
+		}
+		user, err := c.UserMongoRepository.GetByID(ctx, unionID.Hex(), link.UserID)
+		if err != nil || user == nil || user.Deleted || !canSignIn(user) {
+			break
+		}
+		token, err := auth.GenerateJWTToken(user.Username, user.ID, unionID, 24)
+		if err != nil {
+			return nil, err
+		}
+		return &model.SingleUserAuth{User: *user, Token: token}, nil
+	}
+	return nil, i18n.Errorf(i18n.ErrNoMembership)
+}
+
+// IdentitiesByEmployeeID looks an employee ID up across unions. Only admins of the
+// staff union set in STAFF_UNION_ID may do this.
+func (c *UserController) IdentitiesByEmployeeID(ctx context.Context, employeeID string) ([]*model.Identity, error) {
+	if err := c.requireStaff(ctx); err != nil {
+		return nil, err
+	}
+	employeeID = strings.TrimSpace(employeeID)
+	if employeeID == "" {
+		err := i18n.Errorf(i18n.ErrEmployeeIDRequired)
+		return nil, err
+	}
+	identities, err := c.UserMongoRepository.IdentitiesByEmployeeID(ctx, employeeID)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrEmployeeIDLookup)
+	}
+	return identities, nil
+}
+
+// linkedMemberships returns every membership linked to the caller's identity; a
+// caller without a verified email only has the membership of their token
+func (c *UserController) linkedMemberships(ctx context.Context, claims *auth.TokenClaim) ([]*model.IdentityMembership, error) {
+	current := &model.IdentityMembership{UnionID: claims.UnionID, UserID: claims.UserID, Username: claims.Username}
+	identity, err := c.UserMongoRepository.IdentityForUser(ctx, claims.UnionID, claims.UserID)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrMembershipsLoad)
+	}
+	if identity == nil {
+		return []*model.IdentityMembership{current}, nil
+	}
+	return identity.Memberships, nil
+}
+
+func (c *UserController) requireStaff(ctx context.Context) error {
+	claims := auth.ForContext(ctx)
+	if claims == nil {
+		return i18n.Errorf(i18n.ErrAuthRequired)
+	}
+	staffUnion := os.Getenv("STAFF_UNION_ID")
+	if staffUnion == "" || claims.UnionID.Hex() != staffUnion {
+		return i18n.Errorf(i18n.ErrStaffOnly)
+	}
+	user, err := c.UserMongoRepository.GetByID(ctx, claims.UnionID.Hex(), claims.UserID)
+	if err != nil || user == nil || !user.IsAdmin || !canSignIn(user) || sessionRevoked(user, claims.IssuedAt) {
+		return i18n.Errorf(i18n.ErrStaffOnly)
+	}
+	return nil
+}
+
+// requireUnionAdmin lets only the admins of unionID through
+func (c *UserController) requireUnionAdmin(ctx context.Context, unionID primitive.ObjectID) error {
+	claims := auth.ForContext(ctx)
+	if claims == nil {
+		return i18n.Errorf(i18n.ErrAuthRequired)
+	}
+	if claims.UnionID != unionID {
+		return i18n.Errorf(i18n.ErrUnionAdminOnly)
+	}
+	user, err := c.UserMongoRepository.GetByID(ctx, unionID.Hex(), claims.UserID)
+	if err != nil || user == nil || !user.IsAdmin || !canSignIn(user) || sessionRevoked(user, claims.IssuedAt) {
+		return i18n.Errorf(i18n.ErrUnionAdminOnly)
+	}
+	return nil
+}
+
+// requireSelf lets only the signed in user act on their own account, as long as their
+// session was not revoked
+func (c *UserController) requireSelf(ctx context.Context, userID primitive.ObjectID, unionID primitive.ObjectID) error {
+	claims := auth.ForContext(ctx)
+	if claims == nil {
+		return i18n.Errorf(i18n.ErrAuthRequired)
+	}
+	if claims.UserID != userID || claims.UnionID != unionID {
+		return i18n.Errorf(i18n.ErrOwnAccountOnly)
+	}
+	if !c.SessionActive(ctx, claims) {
+		return i18n.Errorf(i18n.ErrSessionExpired)
+	}
+	return nil
+}
+
+// requireSelfOrAdmin lets the signed in user act on their own account, and the union's
+// admins on any member's
+func (c *UserController) requireSelfOrAdmin(ctx context.Context, userID primitive.ObjectID, unionID primitive.ObjectID) error {
+	err := c.requireSelf(ctx, userID, unionID)
+	if err == nil || c.requireUnionAdmin(ctx, unionID) == nil {
+		return nil
+	}
+	return err
+}
+
+// requireAdminOrService lets the union's admins and backend services through
+func (c *UserController) requireAdminOrService(ctx context.Context, unionID primitive.ObjectID) error {
+	if graphqlclient.IsService(ctx) {
+		return nil
+	}
+	return c.requireUnionAdmin(ctx, unionID)
+}
+
+// actorID is the signed in user, or the nil id for a backend service
+func actorID(ctx context.Context) primitive.ObjectID {
+	if claims := auth.ForContext(ctx); claims != nil {
+		return claims.UserID
+	}
+	return primitive.NilObjectID
+}
+
+func normalizeEmail(address string) string {
+	return strings.ToLower(strings.TrimSpace(address))
+}
+
+func verificationCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+func hashVerificationCode(code string, userID primitive.ObjectID) string {
+	sum := sha256.Sum256([]byte(code + userID.Hex()))
+	return hex.EncodeToString(sum[:])
+}
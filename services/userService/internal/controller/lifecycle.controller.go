@@ -0,0 +1,174 @@
+package controllers
+
+import (
+	"context"
+	"log"
+	"time"
+	"younified-backend/contracts/user/model"
+	"younified-backend/services/userService/internal/auth"
+	"younified-backend/services/userService/internal/i18n"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// statusHook adds the side effects of entering a status to the transition update,
+// so they are applied atomically with the status itself
+type statusHook func(change *model.StatusChange, set bson.M, unset bson.M)
+
+var statusHooks = map[string][]statusHook{
+	model.StatusActive:    {resumeDues},
+	model.StatusSuspended: {revokeSessions},
+	model.StatusRetired:   {stopDues},
+	model.StatusWithdrawn: {revokeSessions, stopDues},
+	model.StatusDeceased:  {revokeSessions, stopDues},
+}
+
+// statuses whose members cannot sign in
+var signInBlocked = map[string]bool{
+	model.StatusSuspended:  true,
+	model.StatusWithdrawn:  true,
+	model.StatusDeceased:   true,
+	model.UserStatusErased: true,
+}
+
+func revokeSessions(change *model.StatusChange, set bson.M, unset bson.M) {
+	set["tokens"] = []string{}
+	set["token"] = ""
+	set["loggedIn"] = false
+	set["sessionsRevokedAt"] = change.ChangedOn
+}
+
+func stopDues(change *model.StatusChange, set bson.M, unset bson.M) {
+	set["duesStoppedAt"] = change.EffectiveDate
+}
+
+func resumeDues(change *model.StatusChange, set bson.M, unset bson.M) {
+	unset["duesStoppedAt"] = ""
+}
+
+// ChangeMemberStatus moves a member through the lifecycle, enforcing the allowed
+// transitions and the reason and effective date each status requires
+func (c *UserController) ChangeMemberStatus(ctx context.Context, userID primitive.ObjectID, unionID primitive.ObjectID, input model.StatusTransitionInput) (*model.User, error) {
+	if userID.IsZero() || unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUserAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	user, err := c.UserMongoRepository.GetByID(ctx, unionID.Hex(), userID)
+	if err != nil || user == nil {
+		return nil, i18n.Errorf(i18n.ErrUserNotFound)
+	}
+	return c.transitionStatus(ctx, user, input)
+}
+
+func (c *UserController) StatusTimeline(ctx context.Context, userID primitive.ObjectID, unionID primitive.ObjectID) ([]*model.StatusChange, error) {
+	if userID.IsZero() || unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUserAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireSelfOrAdmin(ctx, userID, unionID); err != nil {
+		return nil, err
+	}
+	timeline, err := c.UserMongoRepository.StatusTimeline(ctx, unionID.Hex(), userID)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrStatusTimelineLoad)
+	}
+	return timeline, nil
+}
+
+func (c *UserController) AllowedStatusTransitions(ctx context.Context, userID primitive.ObjectID, unionID primitive.ObjectID) ([]string, error) {
+	if userID.IsZero() || unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUserAndUnionRequired)
+		return nil, err
+	}
+	user, err := c.UserMongoRepository.GetByID(ctx, unionID.Hex(), userID)
+	if err != nil || user == nil {
+		return nil, i18n.Errorf(i18n.ErrUserNotFound)
+	}
+	next := model.StatusRules[model.NormalizeStatus(user.Status)].Next
+	if next == nil {
+		next = []string{}
+	}
+	return next, nil
+}
+
+func (c *UserController) transitionStatus(ctx context.Context, user *model.User, input model.StatusTransitionInput) (*model.User, error) {
+	from := model.NormalizeStatus(user.Status)
+	to := input.Status
+	if !model.CanTransition(from, to) {
+		return nil, i18n.Errorf(i18n.ErrStatusTransition, from, to)
+	}
+
+	rule := model.StatusRules[to]
+	change := &model.StatusChange{
+		UnionID:   user.UnionID,
+		UserID:    user.ID,
+		From:      from,
+		To:        to,
+		ChangedBy: actorID(ctx),
+		ChangedOn: time.Now(),
+	}
+	if input.Reason != nil {
+		change.Reason = *input.Reason
+	}
+	if rule.ReasonRequired && change.Reason == "" {
+		return nil, i18n.Errorf(i18n.ErrStatusReason, to)
+	}
+	if input.EffectiveDate != nil && !input.EffectiveDate.IsZero() {
+		change.EffectiveDate = *input.EffectiveDate
+	} else if rule.EffectiveDateRequired {
+		return nil, i18n.Errorf(i18n.ErrStatusEffectiveDate, to)
+	} else {
+		change.EffectiveDate = change.ChangedOn
+	}
+
+	set := bson.M{"status": to, "statusChangedAt": change.EffectiveDate}
+	unset := bson.M{}
+	for _, hook := range statusHooks[to] {
+		hook(change, set, unset)
+	}
+	update := bson.M{"$set": set}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+
+	// matching on the old status keeps two concurrent transitions from both applying
+	filter := bson.M{"_id": user.ID, "status": user.Status}
+	if user.Status == "" {
+		filter["status"] = bson.M{"$in": bson.A{"", nil}}
+	}
+	union := user.UnionID.Hex()
+	updatedUser, err := c.UserMongoRepository.UpdateUser(ctx, union, filter, update)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrStatusConflict)
+	}
+	if _, err := c.UserMongoRepository.RecordStatusChange(ctx, union, change); err != nil {
+		log.Printf("could not record status change of user %s: %v", user.ID.Hex(), err)
+	}
+
+	go c.UserRedisRepository.InvalidateCache(context.Background(), user.ID.Hex())
+	return updatedUser, nil
+}
+
+// canSignIn reports whether a member's status allows new sessions
+func canSignIn(user *model.User) bool {
+	return !signInBlocked[model.NormalizeStatus(user.Status)]
+}
+
+// SessionActive reports whether the token behind claims still opens a session: its user
+// exists, may sign in and had no sessions revoked after the token was issued
+func (c *UserController) SessionActive(ctx context.Context, claims *auth.TokenClaim) bool {
+	user, err := c.UserMongoRepository.GetByID(ctx, claims.UnionID.Hex(), claims.UserID)
+	if err != nil || user == nil || user.Deleted {
+		return false
+	}
+	return canSignIn(user) && !sessionRevoked(user, claims.IssuedAt)
+}
+
+// sessionRevoked reports whether a token issued at issuedAt predates a revocation
+func sessionRevoked(user *model.User, issuedAt int64) bool {
+	return !user.SessionsRevokedAt.IsZero() && issuedAt <= user.SessionsRevokedAt.Unix()
+}
@@ -0,0 +1,178 @@
+package controllers
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+	"younified-backend/contracts/user/model"
+	"younified-backend/services/userService/internal/auth"
+	"younified-backend/services/userService/internal/client"
+	"younified-backend/services/userService/internal/i18n"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	defaultLoginHistory = 50
+	maxLoginHistory     = 500
+)
+
+func (c *UserController) LoginHistory(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID, limit *int) ([]*model.LoginEvent, error) {
+	if userID.IsZero() || unionID.IsZero() {
+		return nil, i18n.Errorf(i18n.ErrUserAndUnionRequired)
+	}
+	// members see their own sign ins, the union's admins everyone's
+	if c.requireSelf(ctx, userID, unionID) != nil {
+		if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+			return nil, err
+		}
+	}
+	n := defaultLoginHistory
+	if limit != nil && *limit > 0 {
+		n = min(*limit, maxLoginHistory)
+	}
+	return c.LoginMongoRepository.History(ctx, unionID.Hex(), userID, int64(n))
+}
+
+// InactiveMembers lists the members who can sign in but have not for days days,
+// those who never did first
+func (c *UserController) InactiveMembers(ctx context.Context, unionID primitive.ObjectID, days *int) (*model.InactiveMemberReport, error) {
+	if unionID.IsZero() {
+		return nil, i18n.Errorf(i18n.ErrUnionRequired)
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	n := model.DefaultInactiveDays
+	if days != nil {
+		n = *days
+	}
+	if n <= 0 {
+		err := i18n.Errorf(i18n.ErrDaysPositive)
+		return nil, err
+	}
+	now := time.Now()
+	since := now.AddDate(0, 0, -n)
+	users, err := c.LoginMongoRepository.Inactive(ctx, unionID.Hex(), since)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrInactiveMembersLoad, err)
+	}
+
+	report := &model.InactiveMemberReport{Days: n, Since: since, Members: []*model.InactiveMember{}}
+	for _, user := range users {
+		if !canSignIn(user) {
+			continue
+		}
+		member := &model.InactiveMember{
+			UserID:      user.ID,
+			Name:        strings.TrimSpace(user.FirstName + " " + user.LastName),
+			EmployeeID:  user.EmployeeID,
+			Unit:        user.Unit,
+			Email:       user.Profile.Email,
+			EmailOptOut: user.EmailOpOut,
+		}
+		if !user.LastLoginDate.IsZero() {
+			inactive := int(now.Sub(user.LastLoginDate).Hours() / 24)
+			member.LastLoginDate = &user.LastLoginDate
+			member.DaysInactive = &inactive
+		}
+		report.Members = append(report.Members, member)
+	}
+	report.Total = len(report.Members)
+	return report, nil
+}
+
+func (c *UserController) ReengagementCampaigns(ctx context.Context, unionID primitive.ObjectID) ([]*model.ReengagementCampaign, error) {
+	if unionID.IsZero() {
+		return nil, i18n.Errorf(i18n.ErrUnionRequired)
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	return c.LoginMongoRepository.Campaigns(ctx, unionID.Hex())
+}
+
+// StartReengagementCampaign emails the inactive members who have an address and did
+// not opt out of email. The emails go out in the background; the campaign records
+// how many were sent once it is done.
+func (c *UserController) StartReengagementCampaign(ctx context.Context, unionID primitive.ObjectID, days *int, subject string, content string) (*model.ReengagementCampaign, error) {
+	if unionID.IsZero() {
+		return nil, i18n.Errorf(i18n.ErrUnionRequired)
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(subject) == "" || strings.TrimSpace(content) == "" {
+		err := i18n.Errorf(i18n.ErrSubjectAndContentRequired)
+		return nil, err
+	}
+	report, err := c.InactiveMembers(ctx, unionID, days)
+	if err != nil {
+		return nil, err
+	}
+	recipients := []string{}
+	for _, member := range report.Members {
+		if member.Email != "" && !member.EmailOptOut {
+			recipients = append(recipients, member.Email)
+		}
+	}
+
+	campaign := &model.ReengagementCampaign{
+		UnionID:    unionID,
+		Days:       report.Days,
+		Subject:    strings.TrimSpace(subject),
+		Content:    content,
+		Status:     model.CampaignSending,
+		Recipients: len(recipients),
+	}
+	if claims := auth.ForContext(ctx); claims != nil {
+		campaign.StartedBy = claims.Username
+	}
+	campaign, err = c.LoginMongoRepository.CreateCampaign(ctx, unionID.Hex(), campaign)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrCampaignCreate, err)
+	}
+	go c.sendCampaign(context.Background(), unionID.Hex(), campaign, recipients)
+	return campaign, nil
+}
+
+func (c *UserController) sendCampaign(ctx context.Context, union string, campaign *model.ReengagementCampaign, recipients []string) {
+	sent, failed := 0, 0
+	for _, recipient := range recipients {
+		if _, err := c.sendMail(ctx, recipient, campaign.Subject, campaign.Content, "reengagement"); err != nil {
+			log.Printf("could not send campaign %s to %s: %v", campaign.ID.Hex(), recipient, err)
+			failed++
+			continue
+		}
+		sent++
+	}
+	if err := c.LoginMongoRepository.CompleteCampaign(ctx, union, campaign.ID, sent, failed); err != nil {
+		log.Printf("could not complete campaign %s: %v", campaign.ID.Hex(), err)
+	}
+	log.Printf("campaign %s of union %s sent %d emails, %d failed", campaign.ID.Hex(), union, sent, failed)
+}
+
+// recordLogin stores a sign in with where it came from and keeps the member's last
+// login date current; a failure is logged, it never blocks the sign in
+func (c *UserController) recordLogin(ctx context.Context, union string, user *model.User, method string, device string) {
+	info := client.FromContext(ctx)
+	event := &model.LoginEvent{
+		UserID:    user.ID,
+		At:        time.Now(),
+		Method:    method,
+		Device:    device,
+		IP:        info.IP,
+		UserAgent: info.UserAgent,
+	}
+	if err := c.LoginMongoRepository.RecordLogin(ctx, union, event); err != nil {
+		log.Printf("could not record login of user %s: %v", user.ID.Hex(), err)
+		return
+	}
+	user.LastLoginDate = event.At
+	user.LoggedIn = true
+	if device != "" {
+		user.Device = device
+	}
+	go c.UserRedisRepository.InvalidateCache(context.Background(), user.ID.Hex())
+}
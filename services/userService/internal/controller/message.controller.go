@@ -0,0 +1,185 @@
+package controllers
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strings"
+	"younified-backend/contracts/user/model"
+	email "younified-backend/providers/emailBodyProvider"
+	"younified-backend/services/userService/internal/auth"
+	"younified-backend/services/userService/internal/i18n"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const emailOverridePrefix = "email."
+
+func (c *UserController) SupportedLocales(ctx context.Context) ([]string, error) {
+	return i18n.Locales(), nil
+}
+
+// MessageCatalogue lists every message and email template in locale as the union's
+// members receive them
+func (c *UserController) MessageCatalogue(ctx context.Context, unionID primitive.ObjectID, locale string) ([]*model.CatalogueMessage, error) {
+	if unionID.IsZero() {
+		return nil, i18n.Errorf(i18n.ErrUnionRequired)
+	}
+	supported := i18n.Normalize(locale)
+	if supported == "" {
+		return nil, i18n.Errorf(i18n.ErrUnknownLocale, locale)
+	}
+	overrides, err := c.MessageMongoRepository.Overrides(ctx, unionID.Hex(), supported)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrMessageOverridesLoad, err)
+	}
+
+	messages := []*model.CatalogueMessage{}
+	for _, key := range i18n.Keys() {
+		messages = append(messages, catalogueMessage(string(key), i18n.Text(supported, key, nil), overrides))
+	}
+	for _, name := range email.Templates() {
+		body, _ := email.Template(supported, name)
+		messages = append(messages, catalogueMessage(emailOverridePrefix+name, body, overrides))
+	}
+	sort.Slice(messages, func(i, j int) bool { return messages[i].Key < messages[j].Key })
+	return messages, nil
+}
+
+// SetMessageOverride replaces a message for the union. The text must keep the
+// placeholders of the message it replaces, they are filled in the same order.
+func (c *UserController) SetMessageOverride(ctx context.Context, unionID primitive.ObjectID, locale string, key string, text string) (*model.MessageOverride, error) {
+	if unionID.IsZero() {
+		return nil, i18n.Errorf(i18n.ErrUnionRequired)
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	supported := i18n.Normalize(locale)
+	if supported == "" {
+		return nil, i18n.Errorf(i18n.ErrUnknownLocale, locale)
+	}
+	original, ok := catalogueText(supported, key)
+	if !ok {
+		err := i18n.Errorf(i18n.ErrMessageKeyUnknown, key)
+		return nil, err
+	}
+	if strings.TrimSpace(text) == "" {
+		err := i18n.Errorf(i18n.ErrTextRequired)
+		return nil, err
+	}
+	if i18n.Placeholders(text) != i18n.Placeholders(original) {
+		err := i18n.Errorf(i18n.ErrMessagePlaceholders, i18n.Placeholders(original))
+		return nil, err
+	}
+
+	override := &model.MessageOverride{Locale: supported, Key: key, Text: text}
+	if claims := auth.ForContext(ctx); claims != nil {
+		override.UpdatedBy = claims.Username
+	}
+	return c.MessageMongoRepository.SetOverride(ctx, unionID.Hex(), override)
+}
+
+func (c *UserController) RemoveMessageOverride(ctx context.Context, unionID primitive.ObjectID, locale string, key string) (string, error) {
+	if unionID.IsZero() {
+		return "", i18n.Errorf(i18n.ErrUnionRequired)
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return "", err
+	}
+	if err := c.MessageMongoRepository.RemoveOverride(ctx, unionID.Hex(), i18n.Normalize(locale), key); err != nil {
+		return "", i18n.Errorf(i18n.ErrMessageOverrideRemove, err)
+	}
+	return Response, nil
+}
+
+// SetPreferredLanguage picks the language emails and API messages are sent to a member in
+func (c *UserController) SetPreferredLanguage(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID, locale string) (*model.User, error) {
+	if userID.IsZero() || unionID.IsZero() {
+		return nil, i18n.Errorf(i18n.ErrUserAndUnionRequired)
+	}
+	if err := c.requireSelf(ctx, userID, unionID); err != nil {
+		return nil, err
+	}
+	supported := i18n.Normalize(locale)
+	if supported == "" {
+		return nil, i18n.Errorf(i18n.ErrUnknownLocale, locale)
+	}
+	user, err := c.UserMongoRepository.UpdateUser(ctx, unionID.Hex(), bson.M{"_id": userID}, bson.M{"$set": bson.M{"preferredLanguage": supported}})
+	if err != nil || user == nil {
+		return nil, i18n.Errorf(i18n.ErrUserUpdate)
+	}
+	go c.UserRedisRepository.InvalidateCache(context.Background(), userID.Hex())
+	return user, nil
+}
+
+// LocalizeError renders a catalogue error in the caller's locale: the signed in
+// member's preferred language, then the Accept-Language of the request
+func (c *UserController) LocalizeError(ctx context.Context, e *i18n.Error) string {
+	claims := auth.ForContext(ctx)
+	if claims == nil || claims.UnionID.IsZero() {
+		return e.Localize(i18n.Pick(i18n.FromContext(ctx)), nil)
+	}
+	preferred := ""
+	if user, err := c.UserMongoRepository.GetByID(ctx, claims.UnionID.Hex(), claims.UserID); err == nil && user != nil {
+		preferred = user.PreferredLanguage
+	}
+	locale := i18n.Pick(preferred, i18n.FromContext(ctx))
+	return e.Localize(locale, c.messageOverrides(ctx, claims.UnionID.Hex(), locale))
+}
+
+// mailText writes an email in the language of its recipient, with the union's wording
+type mailText struct {
+	locale    string
+	overrides map[string]string
+}
+
+func (m mailText) Bodies() email.Bodies {
+	return email.For(m.locale, m.overrides)
+}
+
+func (m mailText) Subject(key i18n.Key, args ...interface{}) string {
+	return i18n.Message(m.locale, key, m.overrides, args...)
+}
+
+// mailTextFor picks the locale of an email to user, or to staff when user is nil:
+// the member's preferred language, then the Accept-Language of the request
+func (c *UserController) mailTextFor(ctx context.Context, unionID string, user *model.User) mailText {
+	preferred := ""
+	if user != nil {
+		preferred = user.PreferredLanguage
+	}
+	locale := i18n.Pick(preferred, i18n.FromContext(ctx))
+	return mailText{locale: locale, overrides: c.messageOverrides(ctx, unionID, locale)}
+}
+
+func (c *UserController) messageOverrides(ctx context.Context, unionID string, locale string) map[string]string {
+	overrides, err := c.MessageMongoRepository.Overrides(ctx, unionID, locale)
+	if err != nil {
+		log.Printf("could not load message overrides of union %s: %v", unionID, err)
+		return nil
+	}
+	return overrides
+}
+
+// catalogueText returns the translation a key overrides, for catalogue messages and
+// email templates alike
+func catalogueText(locale string, key string) (string, bool) {
+	if name, ok := strings.CutPrefix(key, emailOverridePrefix); ok {
+		return email.Template(locale, name)
+	}
+	if !i18n.Known(i18n.Key(key)) {
+		return "", false
+	}
+	return i18n.Text(locale, i18n.Key(key), nil), true
+}
+
+func catalogueMessage(key string, text string, overrides map[string]string) *model.CatalogueMessage {
+	message := &model.CatalogueMessage{Key: key, Text: text, Default: text}
+	if override, ok := overrides[key]; ok && override != "" {
+		message.Text = override
+		message.Overridden = true
+	}
+	return message
+}
@@ -0,0 +1,315 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"younified-backend/contracts/user/model"
+	"younified-backend/services/userService/internal/i18n"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// staff are told about members qualifying this many days ahead unless a rule says otherwise
+const defaultMilestoneNotifyDays = 30
+
+var milestoneExportHeader = []string{
+	"user_id", "member_id", "name", "unit", "status",
+	"date_of_birth", "start_date", "qualifies_on", "age", "service_years",
+}
+
+func (c *UserController) CreateMilestoneRule(ctx context.Context, unionID primitive.ObjectID, input model.MilestoneRuleInput) (*model.MilestoneRule, error) {
+	if unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUnionRequired)
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	rule := &model.MilestoneRule{UnionID: unionID, Statuses: []string{}, Recipients: []string{}}
+	applyMilestoneRuleInput(rule, input)
+	if err := validateMilestoneRule(rule); err != nil {
+		return nil, err
+	}
+	return c.MilestoneMongoRepository.CreateRule(ctx, unionID.Hex(), rule)
+}
+
+func (c *UserController) UpdateMilestoneRule(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID, input model.MilestoneRuleInput) (*model.MilestoneRule, error) {
+	if unionID.IsZero() || id.IsZero() {
+		err := i18n.Errorf(i18n.ErrIDAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	rule, err := c.MilestoneMongoRepository.GetRule(ctx, unionID.Hex(), id)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrMilestoneRuleNotFound)
+	}
+	applyMilestoneRuleInput(rule, input)
+	if err := validateMilestoneRule(rule); err != nil {
+		return nil, err
+	}
+	return c.MilestoneMongoRepository.ReplaceRule(ctx, unionID.Hex(), rule)
+}
+
+func (c *UserController) DeleteMilestoneRule(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID) (string, error) {
+	if unionID.IsZero() || id.IsZero() {
+		err := i18n.Errorf(i18n.ErrIDAndUnionRequired)
+		return "", err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return "", err
+	}
+	if err := c.MilestoneMongoRepository.DeleteRule(ctx, unionID.Hex(), id); err != nil {
+		return "", i18n.Errorf(i18n.ErrMilestoneRuleDelete, err)
+	}
+	return Response, nil
+}
+
+func (c *UserController) MilestoneRules(ctx context.Context, unionID primitive.ObjectID) ([]*model.MilestoneRule, error) {
+	if unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUnionRequired)
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	return c.MilestoneMongoRepository.Rules(ctx, unionID.Hex())
+}
+
+// MilestoneReport lists the members qualifying for a rule between from and to, by
+// the day they qualify
+func (c *UserController) MilestoneReport(ctx context.Context, unionID primitive.ObjectID, ruleID primitive.ObjectID, from time.Time, to time.Time) (*model.MilestoneReport, error) {
+	if unionID.IsZero() || ruleID.IsZero() {
+		err := i18n.Errorf(i18n.ErrRuleAndUnionRequired)
+		return nil, err
+	}
+	if to.Before(from) {
+		err := i18n.Errorf(i18n.ErrRangeOrder)
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	rule, err := c.MilestoneMongoRepository.GetRule(ctx, unionID.Hex(), ruleID)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrMilestoneRuleNotFound)
+	}
+	return c.milestoneReport(ctx, unionID.Hex(), rule, from, to)
+}
+
+// ExportMilestoneReport writes a milestone report to a CSV file in the private export
+// bucket and returns a short-lived link to it
+func (c *UserController) ExportMilestoneReport(ctx context.Context, unionID primitive.ObjectID, ruleID primitive.ObjectID, from time.Time, to time.Time) (*string, error) {
+	report, err := c.MilestoneReport(ctx, unionID, ruleID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if c.awsProvider == nil {
+		return nil, i18n.Errorf(i18n.ErrFileStorageMissing)
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write(milestoneExportHeader)
+	for _, m := range report.Matches {
+		w.Write([]string{m.UserID.Hex(), m.EmployeeID, m.Name, m.Unit, m.Status,
+			formatOptionalDate(m.DateOfBirth), formatOptionalDate(m.StartDate), m.QualifiesOn.Format("2006-01-02"),
+			strconv.Itoa(m.Age), strconv.Itoa(m.ServiceYears)})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("%s/milestones/%s-%s-%s.csv", unionID.Hex(), ruleID.Hex(), from.Format("20060102"), to.Format("20060102"))
+	if err := c.storePrivateExport(ctx, key, buf.Bytes()); err != nil {
+		return nil, i18n.Errorf(i18n.ErrExportUpload, err)
+	}
+	url, err := c.privateExportURL(ctx, key)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrExportUpload, err)
+	}
+	return &url, nil
+}
+
+// RunMilestoneNotifications emails the recipients of every union's rules, once a
+// month, the members qualifying within the rule's notice period; it is what the
+// scheduled job calls
+func (c *UserController) RunMilestoneNotifications(ctx context.Context) {
+	unions, err := c.dbManager.ListUnions(ctx)
+	if err != nil {
+		log.Printf("milestone notifications: %v", err)
+		return
+	}
+	now := time.Now()
+	for _, union := range unions {
+		tenant := union.ID.Hex()
+		rules, err := c.MilestoneMongoRepository.Rules(ctx, tenant)
+		if err != nil {
+			log.Printf("milestone notifications of union %s: %v", union.UnionID, err)
+			continue
+		}
+		for _, rule := range rules {
+			if len(rule.Recipients) == 0 {
+				continue
+			}
+			if rule.LastNotifiedOn != nil && rule.LastNotifiedOn.Format("2006-01") == now.Format("2006-01") {
+				continue
+			}
+			sent := c.notifyMilestone(ctx, tenant, rule, now)
+			if err := c.MilestoneMongoRepository.SetNotified(ctx, tenant, rule.ID, now); err != nil {
+				log.Printf("could not record notification of milestone rule %s: %v", rule.ID.Hex(), err)
+			}
+			log.Printf("sent %d notifications for milestone rule %s of union %s", sent, rule.ID.Hex(), union.UnionID)
+		}
+	}
+}
+
+func (c *UserController) notifyMilestone(ctx context.Context, union string, rule *model.MilestoneRule, now time.Time) int {
+	days := rule.NotifyDaysAhead
+	if days <= 0 {
+		days = defaultMilestoneNotifyDays
+	}
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, days)
+	report, err := c.milestoneReport(ctx, union, rule, from, to)
+	if err != nil {
+		log.Printf("could not report milestone rule %s: %v", rule.ID.Hex(), err)
+		return 0
+	}
+	if len(report.Matches) == 0 {
+		return 0
+	}
+
+	text := c.mailTextFor(ctx, union, nil)
+	bodies := text.Bodies()
+	var rows strings.Builder
+	for _, m := range report.Matches {
+		rows.WriteString(bodies.MilestoneReportRow(m.Name, m.EmployeeID, m.Unit, m.QualifiesOn.Format("Jan 2, 2006")))
+	}
+	body := bodies.MilestoneReport(rule.Name, from.Format("Jan 2, 2006"), to.Format("Jan 2, 2006"), rows.String())
+	subject := text.Subject(i18n.SubjectMilestoneReport, rule.Name, len(report.Matches))
+	sent := 0
+	for _, recipient := range rule.Recipients {
+		if _, err := c.sendMail(ctx, recipient, subject, body, "milestones"); err != nil {
+			log.Printf("could not send milestone report to %s: %v", recipient, err)
+			continue
+		}
+		sent++
+	}
+	return sent
+}
+
+func (c *UserController) milestoneReport(ctx context.Context, union string, rule *model.MilestoneRule, from time.Time, to time.Time) (*model.MilestoneReport, error) {
+	members, err := c.MilestoneMongoRepository.Candidates(ctx, union)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrMembersLoad, err)
+	}
+	report := &model.MilestoneReport{RuleID: rule.ID, RuleName: rule.Name, From: from, To: to, Matches: []*model.MilestoneMatch{}}
+	for _, user := range members {
+		if !rule.ReportsOn(user.Status) {
+			continue
+		}
+		on, ok := rule.QualifiesOn(user)
+		if !ok || on.Before(from) || on.After(to) {
+			continue
+		}
+		match := &model.MilestoneMatch{
+			UserID:       user.ID,
+			Name:         strings.TrimSpace(user.FirstName + " " + user.LastName),
+			EmployeeID:   user.EmployeeID,
+			Unit:         user.Unit,
+			Status:       model.NormalizeStatus(user.Status),
+			QualifiesOn:  on,
+			Age:          model.YearsBetween(user.DateOfBirth, on),
+			ServiceYears: model.YearsBetween(user.StartDate, on),
+		}
+		if !user.DateOfBirth.IsZero() {
+			match.DateOfBirth = &user.DateOfBirth
+		}
+		if !user.StartDate.IsZero() {
+			match.StartDate = &user.StartDate
+		}
+		report.Matches = append(report.Matches, match)
+	}
+	sort.SliceStable(report.Matches, func(i, j int) bool {
+		if !report.Matches[i].QualifiesOn.Equal(report.Matches[j].QualifiesOn) {
+			return report.Matches[i].QualifiesOn.Before(report.Matches[j].QualifiesOn)
+		}
+		return report.Matches[i].Name < report.Matches[j].Name
+	})
+	return report, nil
+}
+
+func applyMilestoneRuleInput(rule *model.MilestoneRule, input model.MilestoneRuleInput) {
+	if input.Name != nil && strings.TrimSpace(*input.Name) != "" {
+		rule.Name = strings.TrimSpace(*input.Name)
+	}
+	if input.Description != nil {
+		rule.Description = strings.TrimSpace(*input.Description)
+	}
+	if input.MinAge != nil {
+		rule.MinAge = *input.MinAge
+	}
+	if input.MinServiceYears != nil {
+		rule.MinServiceYears = *input.MinServiceYears
+	}
+	if input.Statuses != nil {
+		rule.Statuses = []string{}
+		for _, status := range input.Statuses {
+			rule.Statuses = append(rule.Statuses, model.NormalizeStatus(strings.TrimSpace(status)))
+		}
+	}
+	if input.Recipients != nil {
+		rule.Recipients = []string{}
+		for _, recipient := range input.Recipients {
+			if recipient = strings.TrimSpace(recipient); recipient != "" {
+				rule.Recipients = append(rule.Recipients, recipient)
+			}
+		}
+	}
+	if input.NotifyDaysAhead != nil {
+		rule.NotifyDaysAhead = *input.NotifyDaysAhead
+	}
+}
+
+func validateMilestoneRule(rule *model.MilestoneRule) error {
+	if rule.Name == "" {
+		return i18n.Errorf(i18n.ErrNameRequired)
+	}
+	if rule.MinAge < 0 || rule.MinServiceYears < 0 {
+		return i18n.Errorf(i18n.ErrMilestoneNegative)
+	}
+	if rule.MinAge == 0 && rule.MinServiceYears == 0 {
+		return i18n.Errorf(i18n.ErrMilestoneCriteria)
+	}
+	for _, status := range rule.Statuses {
+		if _, ok := model.StatusRules[status]; !ok {
+			return i18n.Errorf(i18n.ErrMemberStatusUnknown, status)
+		}
+	}
+	for _, recipient := range rule.Recipients {
+		if !strings.Contains(recipient, "@") {
+			return i18n.Errorf(i18n.ErrEmailInvalid, recipient)
+		}
+	}
+	if rule.NotifyDaysAhead < 0 || rule.NotifyDaysAhead > 366 {
+		return i18n.Errorf(i18n.ErrNotifyDaysAhead)
+	}
+	return nil
+}
+
+func formatOptionalDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format("2006-01-02")
+}
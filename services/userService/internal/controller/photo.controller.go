@@ -0,0 +1,148 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+	"younified-backend/contracts/user/model"
+	"younified-backend/providers/imaging"
+	"younified-backend/services/userService/internal/i18n"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// profilePhotoFullSize bounds the "full" rendition kept alongside the thumbnails
+const profilePhotoFullSize = 1024
+
+// UploadProfilePhoto validates the uploaded image, stores a metadata-free full
+// rendition plus the standard thumbnails and points the profile at them
+func (c *UserController) UploadProfilePhoto(ctx context.Context, userID primitive.ObjectID, unionID primitive.ObjectID, file graphql.Upload) (*model.User, error) {
+	if userID.IsZero() || unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUserAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireSelfOrAdmin(ctx, userID, unionID); err != nil {
+		return nil, err
+	}
+	if c.awsProvider == nil {
+		return nil, i18n.Errorf(i18n.ErrPhotoStorageMissing)
+	}
+
+	user, err := c.UserMongoRepository.GetByID(ctx, unionID.Hex(), userID)
+	if err != nil || user == nil {
+		return nil, i18n.Errorf(i18n.ErrUserNotFound)
+	}
+
+	data, err := imaging.Read(file.File, imaging.DefaultMaxBytes)
+	if err != nil {
+		return nil, err
+	}
+	img, _, err := imaging.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	renditions := append([]imaging.Variant{{Name: "full", Size: profilePhotoFullSize}}, imaging.StandardVariants...)
+	prefix := fmt.Sprintf("%s/profile/%s/%s", unionID.Hex(), userID.Hex(), uuid.New().String())
+	photo := &model.ProfilePhoto{UploadedAt: time.Now()}
+	for _, rendition := range renditions {
+		resized := imaging.Thumbnail(img, rendition.Size)
+		if rendition.Name == "full" {
+			resized = imaging.Fit(img, rendition.Size)
+		}
+		encoded, err := imaging.EncodeJPEG(resized)
+		if err != nil {
+			c.deletePhotoObjects(ctx, photo)
+			return nil, err
+		}
+		key := fmt.Sprintf("%s-%s.jpg", prefix, rendition.Name)
+		url, err := c.awsProvider.UploadToS3(ctx, os.Getenv("AWS_S3_BUCKET"), os.Getenv("AWS_REGION"), key, encoded)
+		if err != nil {
+			c.deletePhotoObjects(ctx, photo)
+			return nil, err
+		}
+		photo.Variants = append(photo.Variants, &model.PhotoVariant{
+			Name: rendition.Name,
+			Size: rendition.Size,
+			URL:  *url,
+			Key:  key,
+		})
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"profile.photo":    photo,
+			"profile.imageURL": photoURL(photo, "large"),
+		},
+	}
+	updatedUser, err := c.UserMongoRepository.UpdateUser(ctx, unionID.Hex(), bson.M{"_id": userID}, update)
+	if err != nil {
+		c.deletePhotoObjects(ctx, photo)
+		return nil, i18n.Errorf(i18n.ErrPhotoUpdate)
+	}
+
+	// the new photo is stored, the previous renditions are no longer referenced
+	c.deletePhotoObjects(ctx, user.Profile.Photo)
+	go c.UserRedisRepository.InvalidateCache(context.Background(), userID.Hex())
+	return updatedUser, nil
+}
+
+// RemoveProfilePhoto clears the profile photo and deletes its stored renditions
+func (c *UserController) RemoveProfilePhoto(ctx context.Context, userID primitive.ObjectID, unionID primitive.ObjectID) (*model.User, error) {
+	if userID.IsZero() || unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUserAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireSelfOrAdmin(ctx, userID, unionID); err != nil {
+		return nil, err
+	}
+
+	user, err := c.UserMongoRepository.GetByID(ctx, unionID.Hex(), userID)
+	if err != nil || user == nil {
+		return nil, i18n.Errorf(i18n.ErrUserNotFound)
+	}
+
+	update := bson.M{
+		"$unset": bson.M{
+			"profile.photo":    "",
+			"profile.imageURL": "",
+		},
+	}
+	updatedUser, err := c.UserMongoRepository.UpdateUser(ctx, unionID.Hex(), bson.M{"_id": userID}, update)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrPhotoRemove)
+	}
+
+	c.deletePhotoObjects(ctx, user.Profile.Photo)
+	go c.UserRedisRepository.InvalidateCache(context.Background(), userID.Hex())
+	return updatedUser, nil
+}
+
+// deletePhotoObjects removes every stored rendition of photo from the bucket
+func (c *UserController) deletePhotoObjects(ctx context.Context, photo *model.ProfilePhoto) {
+	if photo == nil || c.awsProvider == nil {
+		return
+	}
+	for _, variant := range photo.Variants {
+		if variant == nil || variant.Key == "" {
+			continue
+		}
+		if err := c.awsProvider.DeleteS3Object(ctx, os.Getenv("AWS_S3_BUCKET"), variant.Key); err != nil {
+			log.Printf("could not delete photo object %s: %v", variant.Key, err)
+		}
+	}
+}
+
+func photoURL(photo *model.ProfilePhoto, name string) string {
+	for _, variant := range photo.Variants {
+		if variant.Name == name {
+			return variant.URL
+		}
+	}
+	return ""
+}
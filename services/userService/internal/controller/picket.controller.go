@@ -0,0 +1,581 @@
+package controllers
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+	"younified-backend/contracts/user/model"
+	"younified-backend/services/userService/internal/auth"
+	"younified-backend/services/userService/internal/i18n"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// members may check in this long before their shift starts
+const shiftCheckInWindow = time.Hour
+
+func (c *UserController) CreatePicketSite(ctx context.Context, unionID primitive.ObjectID, input model.PicketSiteInput) (*model.PicketSite, error) {
+	if unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUnionRequired)
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	if input.Name == nil || strings.TrimSpace(*input.Name) == "" {
+		err := i18n.Errorf(i18n.ErrNameRequired)
+		return nil, err
+	}
+	site := &model.PicketSite{UnionID: unionID, Name: strings.TrimSpace(*input.Name), Active: true}
+	if input.Address != nil {
+		site.Address = *input.Address
+	}
+	if input.Zone != nil {
+		site.Zone = strings.TrimSpace(*input.Zone)
+	}
+	if input.StrikeID != nil {
+		site.StrikeID = *input.StrikeID
+	}
+	if input.Active != nil {
+		site.Active = *input.Active
+	}
+	return c.PicketMongoRepository.CreateSite(ctx, unionID.Hex(), site)
+}
+
+// UpdatePicketSite changes a site. Shifts already created keep the zone and strike
+// they were created with.
+func (c *UserController) UpdatePicketSite(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID, input model.PicketSiteInput) (*model.PicketSite, error) {
+	if unionID.IsZero() || id.IsZero() {
+		err := i18n.Errorf(i18n.ErrIDAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	set := bson.M{}
+	if input.Name != nil && strings.TrimSpace(*input.Name) != "" {
+		set["name"] = strings.TrimSpace(*input.Name)
+	}
+	if input.Address != nil {
+		set["address"] = *input.Address
+	}
+	if input.Zone != nil {
+		set["zone"] = strings.TrimSpace(*input.Zone)
+	}
+	if input.StrikeID != nil {
+		set["strikeID"] = *input.StrikeID
+	}
+	if input.Active != nil {
+		set["active"] = *input.Active
+	}
+	if len(set) == 0 {
+		return c.PicketMongoRepository.GetSite(ctx, unionID.Hex(), id)
+	}
+	site, err := c.PicketMongoRepository.UpdateSite(ctx, unionID.Hex(), id, set)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrPicketSiteUpdate)
+	}
+	return site, nil
+}
+
+func (c *UserController) PicketSites(ctx context.Context, unionID primitive.ObjectID, strikeID *primitive.ObjectID) ([]*model.PicketSite, error) {
+	if unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUnionRequired)
+		return nil, err
+	}
+	filter := bson.M{}
+	if strikeID != nil {
+		filter["strikeID"] = *strikeID
+	}
+	return c.PicketMongoRepository.Sites(ctx, unionID.Hex(), filter)
+}
+
+func (c *UserController) CreatePicketShift(ctx context.Context, unionID primitive.ObjectID, input model.PicketShiftInput) (*model.PicketShift, error) {
+	if unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUnionRequired)
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	if input.SiteID == nil || input.Start == nil || input.End == nil || input.Capacity == nil {
+		err := i18n.Errorf(i18n.ErrShiftFieldsRequired)
+		return nil, err
+	}
+	site, err := c.PicketMongoRepository.GetSite(ctx, unionID.Hex(), *input.SiteID)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrPicketSiteNotFound)
+	}
+	if !site.Active {
+		return nil, i18n.Errorf(i18n.ErrPicketSiteInactive, site.Name)
+	}
+	shift := &model.PicketShift{
+		UnionID:    unionID,
+		SiteID:     site.ID,
+		StrikeID:   site.StrikeID,
+		Zone:       site.Zone,
+		Start:      *input.Start,
+		End:        *input.End,
+		Capacity:   *input.Capacity,
+		CaptainIDs: input.CaptainIDs,
+	}
+	if shift.CaptainIDs == nil {
+		shift.CaptainIDs = []primitive.ObjectID{}
+	}
+	if input.Shift != nil {
+		shift.Shift = strings.TrimSpace(*input.Shift)
+	}
+	if err := validatePicketShift(shift); err != nil {
+		return nil, err
+	}
+	return c.PicketMongoRepository.CreateShift(ctx, unionID.Hex(), shift)
+}
+
+// UpdatePicketShift moves a shift or changes its capacity and captains. Capacity
+// cannot drop below the members already signed up.
+func (c *UserController) UpdatePicketShift(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID, input model.PicketShiftInput) (*model.PicketShift, error) {
+	if unionID.IsZero() || id.IsZero() {
+		err := i18n.Errorf(i18n.ErrIDAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	shift, err := c.PicketMongoRepository.GetShift(ctx, unionID.Hex(), id)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrShiftNotFound)
+	}
+
+	set := bson.M{}
+	update := bson.M{"$set": set}
+	if input.Start != nil {
+		shift.Start = *input.Start
+		set["start"] = shift.Start
+		// a moved shift is reminded again
+		update["$unset"] = bson.M{"reminderSentAt": ""}
+	}
+	if input.End != nil {
+		shift.End = *input.End
+		set["end"] = shift.End
+	}
+	if input.Capacity != nil {
+		shift.Capacity = *input.Capacity
+		set["capacity"] = shift.Capacity
+	}
+	if input.CaptainIDs != nil {
+		set["captainIDs"] = input.CaptainIDs
+	}
+	if input.Shift != nil {
+		set["shift"] = strings.TrimSpace(*input.Shift)
+	}
+	if err := validatePicketShift(shift); err != nil {
+		return nil, err
+	}
+	if len(set) == 0 {
+		return shift, nil
+	}
+
+	filter := bson.M{"_id": id}
+	if input.Capacity != nil {
+		filter["$expr"] = bson.M{"$lte": bson.A{bson.M{"$size": "$signups"}, shift.Capacity}}
+	}
+	updated, err := c.PicketMongoRepository.UpdateShift(ctx, unionID.Hex(), filter, update)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrShiftCapacity)
+	}
+	return updated, nil
+}
+
+func (c *UserController) PicketShifts(ctx context.Context, unionID primitive.ObjectID, siteID *primitive.ObjectID, from *time.Time, to *time.Time) ([]*model.PicketShift, error) {
+	if unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUnionRequired)
+		return nil, err
+	}
+	filter := bson.M{}
+	if siteID != nil {
+		filter["siteID"] = *siteID
+	}
+	if from != nil {
+		filter["end"] = bson.M{"$gt": *from}
+	}
+	if to != nil {
+		filter["start"] = bson.M{"$lt": *to}
+	}
+	return c.PicketMongoRepository.Shifts(ctx, unionID.Hex(), filter)
+}
+
+func (c *UserController) MyPicketShifts(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID) ([]*model.PicketShift, error) {
+	if unionID.IsZero() || userID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUserAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireSelfOrAdmin(ctx, userID, unionID); err != nil {
+		return nil, err
+	}
+	filter := bson.M{
+		"$or": bson.A{bson.M{"signups.userID": userID}, bson.M{"captainIDs": userID}},
+		"end": bson.M{"$gt": time.Now()},
+	}
+	return c.PicketMongoRepository.Shifts(ctx, unionID.Hex(), filter)
+}
+
+// SignUpForShift books a member on a shift of their zone and shift pattern
+func (c *UserController) SignUpForShift(ctx context.Context, unionID primitive.ObjectID, shiftID primitive.ObjectID, userID primitive.ObjectID) (*model.PicketShift, error) {
+	if unionID.IsZero() || shiftID.IsZero() || userID.IsZero() {
+		err := i18n.Errorf(i18n.ErrShiftMemberRequired)
+		return nil, err
+	}
+	if err := c.requireSelfOrAdmin(ctx, userID, unionID); err != nil {
+		return nil, err
+	}
+	shift, err := c.PicketMongoRepository.GetShift(ctx, unionID.Hex(), shiftID)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrShiftNotFound)
+	}
+	if err := c.checkShiftEligibility(ctx, shift, userID); err != nil {
+		return nil, err
+	}
+	updated, err := c.PicketMongoRepository.AddSignup(ctx, unionID.Hex(), shiftID, &model.ShiftSignup{UserID: userID, SignedUpOn: time.Now()})
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrShiftFull)
+	}
+	return updated, nil
+}
+
+func (c *UserController) WithdrawFromShift(ctx context.Context, unionID primitive.ObjectID, shiftID primitive.ObjectID, userID primitive.ObjectID) (*model.PicketShift, error) {
+	if unionID.IsZero() || shiftID.IsZero() || userID.IsZero() {
+		err := i18n.Errorf(i18n.ErrShiftMemberRequired)
+		return nil, err
+	}
+	if err := c.requireSelfOrAdmin(ctx, userID, unionID); err != nil {
+		return nil, err
+	}
+	shift, err := c.PicketMongoRepository.GetShift(ctx, unionID.Hex(), shiftID)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrShiftNotFound)
+	}
+	if signup := shift.Signup(userID); signup == nil || signup.CheckedInAt != nil {
+		return nil, i18n.Errorf(i18n.ErrWithdrawNotAllowed)
+	}
+	return c.PicketMongoRepository.RemoveSignup(ctx, unionID.Hex(), shiftID, userID)
+}
+
+// RequestShiftSwap asks toUserID to take over the sign-up of fromUserID
+func (c *UserController) RequestShiftSwap(ctx context.Context, unionID primitive.ObjectID, shiftID primitive.ObjectID, fromUserID primitive.ObjectID, toUserID primitive.ObjectID) (*model.ShiftSwap, error) {
+	if unionID.IsZero() || shiftID.IsZero() || fromUserID.IsZero() || toUserID.IsZero() {
+		err := i18n.Errorf(i18n.ErrSwapRequired)
+		return nil, err
+	}
+	if err := c.requireSelfOrAdmin(ctx, fromUserID, unionID); err != nil {
+		return nil, err
+	}
+	shift, err := c.PicketMongoRepository.GetShift(ctx, unionID.Hex(), shiftID)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrShiftNotFound)
+	}
+	if signup := shift.Signup(fromUserID); signup == nil || signup.CheckedInAt != nil {
+		return nil, i18n.Errorf(i18n.ErrSwapNotAllowed)
+	}
+	if err := c.checkShiftEligibility(ctx, shift, toUserID); err != nil {
+		return nil, err
+	}
+	return c.PicketMongoRepository.CreateSwap(ctx, unionID.Hex(), &model.ShiftSwap{
+		UnionID:    unionID,
+		ShiftID:    shiftID,
+		FromUserID: fromUserID,
+		ToUserID:   toUserID,
+		Status:     model.ShiftSwapPending,
+	})
+}
+
+// RespondToShiftSwap lets the asked member accept or decline a swap
+func (c *UserController) RespondToShiftSwap(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID, userID primitive.ObjectID, accept bool) (*model.ShiftSwap, error) {
+	if unionID.IsZero() || id.IsZero() || userID.IsZero() {
+		err := i18n.Errorf(i18n.ErrSwapResponseRequired)
+		return nil, err
+	}
+	if err := c.requireSelf(ctx, userID, unionID); err != nil {
+		return nil, err
+	}
+	union := unionID.Hex()
+	swap, err := c.PicketMongoRepository.GetSwap(ctx, union, id)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrSwapNotFound)
+	}
+	if swap.ToUserID != userID {
+		return nil, i18n.Errorf(i18n.ErrSwapRecipientOnly)
+	}
+	if swap.Status != model.ShiftSwapPending {
+		return nil, i18n.Errorf(i18n.ErrSwapAnswered, swap.Status)
+	}
+	if !accept {
+		return c.PicketMongoRepository.RespondToSwap(ctx, union, id, model.ShiftSwapDeclined)
+	}
+
+	shift, err := c.PicketMongoRepository.GetShift(ctx, union, swap.ShiftID)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrShiftNotFound)
+	}
+	if err := c.checkShiftEligibility(ctx, shift, userID); err != nil {
+		return nil, err
+	}
+	signup := &model.ShiftSignup{UserID: userID, SignedUpOn: time.Now()}
+	if _, err := c.PicketMongoRepository.ReplaceSignup(ctx, union, swap.ShiftID, swap.FromUserID, signup); err != nil {
+		c.PicketMongoRepository.RespondToSwap(ctx, union, id, model.ShiftSwapDeclined)
+		return nil, i18n.Errorf(i18n.ErrSwapUnavailable)
+	}
+	return c.PicketMongoRepository.RespondToSwap(ctx, union, id, model.ShiftSwapAccepted)
+}
+
+func (c *UserController) ShiftSwapRequests(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID) ([]*model.ShiftSwap, error) {
+	if unionID.IsZero() || userID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUserAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireSelfOrAdmin(ctx, userID, unionID); err != nil {
+		return nil, err
+	}
+	filter := bson.M{"$or": bson.A{bson.M{"fromUserID": userID}, bson.M{"toUserID": userID}}}
+	return c.PicketMongoRepository.Swaps(ctx, unionID.Hex(), filter)
+}
+
+// CheckInToShift records the arrival of a member, by themselves, a captain of the
+// shift or a union admin
+func (c *UserController) CheckInToShift(ctx context.Context, unionID primitive.ObjectID, shiftID primitive.ObjectID, userID primitive.ObjectID) (*model.PicketShift, error) {
+	shift, _, err := c.shiftForCheck(ctx, unionID, shiftID, userID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if now.Before(shift.Start.Add(-shiftCheckInWindow)) || now.After(shift.End) {
+		return nil, i18n.Errorf(i18n.ErrCheckInWindow)
+	}
+	updated, err := c.PicketMongoRepository.CheckIn(ctx, unionID.Hex(), shiftID, userID, now)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrAlreadyCheckedIn)
+	}
+	return updated, nil
+}
+
+// CheckOutOfShift records the departure of a member. Check-outs after the shift ended
+// count until its end. When the site pickets for a strike the day's hours are
+// recorded as picket attendance for strike pay.
+func (c *UserController) CheckOutOfShift(ctx context.Context, unionID primitive.ObjectID, shiftID primitive.ObjectID, userID primitive.ObjectID) (*model.PicketShift, error) {
+	shift, by, err := c.shiftForCheck(ctx, unionID, shiftID, userID)
+	if err != nil {
+		return nil, err
+	}
+	at := time.Now()
+	if at.After(shift.End) {
+		at = shift.End
+	}
+	updated, err := c.PicketMongoRepository.CheckOut(ctx, unionID.Hex(), shiftID, userID, at)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrNotCheckedIn)
+	}
+	if !updated.StrikeID.IsZero() {
+		c.recordShiftAttendance(ctx, updated, userID, by)
+	}
+	return updated, nil
+}
+
+// ShiftCoverage reports the staffing of the shifts between from and to; gapsOnly keeps
+// the shifts with open places or without a captain
+func (c *UserController) ShiftCoverage(ctx context.Context, unionID primitive.ObjectID, from time.Time, to time.Time, siteID *primitive.ObjectID, gapsOnly *bool) ([]*model.ShiftCoverage, error) {
+	shifts, err := c.PicketShifts(ctx, unionID, siteID, &from, &to)
+	if err != nil {
+		return nil, err
+	}
+	sites, err := c.PicketMongoRepository.Sites(ctx, unionID.Hex(), bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	siteNames := map[primitive.ObjectID]string{}
+	for _, site := range sites {
+		siteNames[site.ID] = site.Name
+	}
+
+	coverage := []*model.ShiftCoverage{}
+	for _, shift := range shifts {
+		row := &model.ShiftCoverage{
+			ShiftID:   shift.ID,
+			SiteID:    shift.SiteID,
+			SiteName:  siteNames[shift.SiteID],
+			Start:     shift.Start,
+			End:       shift.End,
+			Capacity:  shift.Capacity,
+			SignedUp:  len(shift.Signups),
+			NoCaptain: len(shift.CaptainIDs) == 0,
+		}
+		for _, signup := range shift.Signups {
+			if signup.CheckedInAt != nil {
+				row.CheckedIn++
+			}
+		}
+		if row.SignedUp < row.Capacity {
+			row.Gap = row.Capacity - row.SignedUp
+		}
+		if gapsOnly != nil && *gapsOnly && row.Gap == 0 && !row.NoCaptain {
+			continue
+		}
+		coverage = append(coverage, row)
+	}
+	return coverage, nil
+}
+
+// RunShiftReminders emails the members of every union's shifts starting within lead
+// that were not reminded yet; it is what the scheduled job calls
+func (c *UserController) RunShiftReminders(ctx context.Context, lead time.Duration) {
+	unions, err := c.dbManager.ListUnions(ctx)
+	if err != nil {
+		log.Printf("shift reminders: %v", err)
+		return
+	}
+	now := time.Now()
+	for _, union := range unions {
+		tenant := union.ID.Hex()
+		shifts, err := c.PicketMongoRepository.Shifts(ctx, tenant, bson.M{
+			"start":          bson.M{"$gt": now, "$lte": now.Add(lead)},
+			"reminderSentAt": bson.M{"$exists": false},
+			"signups.0":      bson.M{"$exists": true},
+		})
+		if err != nil {
+			log.Printf("shift reminders of union %s: %v", union.UnionID, err)
+			continue
+		}
+		for _, shift := range shifts {
+			sent := c.remindShift(ctx, tenant, shift)
+			c.PicketMongoRepository.UpdateShift(ctx, tenant, bson.M{"_id": shift.ID}, bson.M{"$set": bson.M{"reminderSentAt": now}})
+			log.Printf("sent %d reminders for shift %s of union %s", sent, shift.ID.Hex(), union.UnionID)
+		}
+	}
+}
+
+func (c *UserController) remindShift(ctx context.Context, union string, shift *model.PicketShift) int {
+	site, err := c.PicketMongoRepository.GetSite(ctx, union, shift.SiteID)
+	if err != nil {
+		log.Printf("shift %s has no site: %v", shift.ID.Hex(), err)
+		return 0
+	}
+	const layout = "Mon Jan 2 15:04 MST"
+	sent := 0
+	for _, signup := range shift.Signups {
+		user, err := c.UserMongoRepository.GetByID(ctx, union, signup.UserID)
+		if err != nil || user == nil || user.EmailOpOut || user.Profile.Email == "" {
+			continue
+		}
+		text := c.mailTextFor(ctx, union, user)
+		body := text.Bodies().ShiftReminder(user.FirstName, site.Name, site.Address, shift.Start.Format(layout), shift.End.Format(layout))
+		if _, err := c.sendMail(ctx, user.Profile.Email, text.Subject(i18n.SubjectShiftReminder), body, "picket"); err != nil {
+			log.Printf("could not send shift reminder to %s: %v", user.ID.Hex(), err)
+			continue
+		}
+		sent++
+	}
+	return sent
+}
+
+// checkShiftEligibility enforces who may take a place on a shift: members in good
+// standing of the shift's zone and shift pattern, free at that time
+func (c *UserController) checkShiftEligibility(ctx context.Context, shift *model.PicketShift, userID primitive.ObjectID) error {
+	if !time.Now().Before(shift.Start) {
+		return i18n.Errorf(i18n.ErrShiftStarted)
+	}
+	union := shift.UnionID.Hex()
+	user, err := c.UserMongoRepository.GetByID(ctx, union, userID)
+	if err != nil || user == nil || user.Deleted {
+		return i18n.Errorf(i18n.ErrUserNotFound)
+	}
+	if !canSignIn(user) {
+		return i18n.Errorf(i18n.ErrPicketStatus, user.Status)
+	}
+	if shift.Zone != "" && !strings.EqualFold(shift.Zone, user.Zone) {
+		return i18n.Errorf(i18n.ErrShiftZone, shift.Zone)
+	}
+	if shift.Shift != "" && !strings.EqualFold(shift.Shift, user.Shift) {
+		return i18n.Errorf(i18n.ErrShiftWorkShift, shift.Shift)
+	}
+	overlapping, err := c.PicketMongoRepository.Shifts(ctx, union, bson.M{
+		"_id":            bson.M{"$ne": shift.ID},
+		"signups.userID": userID,
+		"start":          bson.M{"$lt": shift.End},
+		"end":            bson.M{"$gt": shift.Start},
+	})
+	if err != nil {
+		return err
+	}
+	if len(overlapping) > 0 {
+		return i18n.Errorf(i18n.ErrShiftOverlap)
+	}
+	return nil
+}
+
+// shiftForCheck loads a shift for a check-in or check-out of userID and returns the
+// signed in user doing it: the member, a captain of the shift or a union admin
+func (c *UserController) shiftForCheck(ctx context.Context, unionID primitive.ObjectID, shiftID primitive.ObjectID, userID primitive.ObjectID) (*model.PicketShift, primitive.ObjectID, error) {
+	if unionID.IsZero() || shiftID.IsZero() || userID.IsZero() {
+		err := i18n.Errorf(i18n.ErrShiftMemberRequired)
+		return nil, primitive.NilObjectID, err
+	}
+	claims := auth.ForContext(ctx)
+	if claims == nil {
+		return nil, primitive.NilObjectID, i18n.Errorf(i18n.ErrAuthRequired)
+	}
+	shift, err := c.PicketMongoRepository.GetShift(ctx, unionID.Hex(), shiftID)
+	if err != nil {
+		return nil, primitive.NilObjectID, i18n.Errorf(i18n.ErrShiftNotFound)
+	}
+	allowed := claims.UnionID == unionID && (claims.UserID == userID || shift.IsCaptain(claims.UserID))
+	if !allowed && c.requireUnionAdmin(ctx, unionID) != nil {
+		return nil, primitive.NilObjectID, i18n.Errorf(i18n.ErrCheckInNotAllowed)
+	}
+	if shift.Signup(userID) == nil {
+		return nil, primitive.NilObjectID, i18n.Errorf(i18n.ErrShiftNotSignedUp)
+	}
+	return shift, claims.UserID, nil
+}
+
+// recordShiftAttendance records the hours a member picketed on the shift's day across
+// all of the strike's shifts; by is the user who checked the member out
+func (c *UserController) recordShiftAttendance(ctx context.Context, shift *model.PicketShift, userID primitive.ObjectID, by primitive.ObjectID) {
+	union := shift.UnionID.Hex()
+	day := model.StrikeDay(shift.Start)
+	shifts, err := c.PicketMongoRepository.Shifts(ctx, union, bson.M{
+		"strikeID":       shift.StrikeID,
+		"signups.userID": userID,
+		"start":          bson.M{"$gte": day, "$lt": day.AddDate(0, 0, 1)},
+	})
+	if err != nil {
+		log.Printf("could not load shifts of user %s: %v", userID.Hex(), err)
+		return
+	}
+	hours := 0.0
+	for _, s := range shifts {
+		if signup := s.Signup(userID); signup != nil {
+			hours += signup.Hours()
+		}
+	}
+
+	entry := &model.PicketAttendanceInput{UserID: userID, Date: day, Hours: hours}
+	if site, err := c.PicketMongoRepository.GetSite(ctx, union, shift.SiteID); err == nil {
+		entry.Location = &site.Name
+	}
+	if len(shift.CaptainIDs) > 0 {
+		entry.CaptainID = &shift.CaptainIDs[0]
+	}
+	if _, err := c.recordPicketAttendance(ctx, shift.UnionID, shift.StrikeID, []*model.PicketAttendanceInput{entry}, by); err != nil {
+		log.Printf("could not record picket attendance of user %s: %v", userID.Hex(), err)
+	}
+}
+
+func validatePicketShift(shift *model.PicketShift) error {
+	if !shift.End.After(shift.Start) {
+		return i18n.Errorf(i18n.ErrShiftOrder)
+	}
+	if shift.Capacity < 1 {
+		return i18n.Errorf(i18n.ErrCapacityMin)
+	}
+	return nil
+}
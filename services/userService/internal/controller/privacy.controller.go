@@ -0,0 +1,414 @@
+package controllers
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+	"younified-backend/contracts/user/model"
+	"younified-backend/providers/graphqlclient"
+	"younified-backend/services/userService/internal/auth"
+	"younified-backend/services/userService/internal/i18n"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const privacyRequestTimeout = 10 * time.Minute
+
+// exportLinkTTL is how long the download link of an export holding member data works
+const exportLinkTTL = 15 * time.Minute
+
+// fields that never leave the service, not even in the member's own export
+var privacySecretFields = []string{"password", "token", "tokens", "passwordResetKey", "passwordResetExpireTime", "emailPassword", "emailVerification"}
+
+// personal fields removed on erasure; categorical fields such as unit, membershipType
+// or classification are kept so union-wide counts stay correct
+var erasedMemberFields = []string{
+	"memberID", "middleName", "maidenName", "commonName", "gender", "profile", "token", "tokens",
+	"dateOfBirth", "startDate", "location", "signature", "device", "seniorityNumber", "preferredLanguage",
+	"emailPassword", "familyMembersData", "jobLocation", "courses", "badgeNumber", "driversLicense",
+	"indigenousStatus", "notes", "zoomID", "PDFunds", "PDSent", "workshop", "stewardEmail", "cb_email",
+	"etfo_number", "scdsb_number", "realMemberID", "passwordResetKey", "passwordResetExpireTime", "email",
+	"verifiedEmail", "emailVerifiedAt", "emailVerification",
+}
+
+// privacyExportSection is one file of a member data export. Anything new that stores
+// member data adds a section here so exports stay complete.
+type privacyExportSection struct {
+	file    string
+	collect func(ctx context.Context, c *UserController, unionID primitive.ObjectID, userID primitive.ObjectID) ([]byte, error)
+}
+
+var privacyExportSections = []privacyExportSection{
+	{file: "user.json", collect: collectRecords("users")},
+	{file: "membership_application.json", collect: collectRecords("members")},
+	{file: "privacy_requests.json", collect: collectOwnedRecords("privacy_requests")},
+	{file: "picket_attendance.json", collect: collectOwnedRecords("picket_attendance")},
+	{file: "strike_payments.json", collect: collectOwnedRecords("strike_payments")},
+	{file: "dues_ledger.json", collect: collectOwnedRecords("dues_ledger")},
+	{file: "status_history.json", collect: collectOwnedRecords("status_history")},
+	{file: "login_events.json", collect: collectOwnedRecords("login_events")},
+	{file: "cms_content.json", collect: collectCmsContent},
+}
+
+// RequestDataExport records an export request and builds the archive in the background
+func (c *UserController) RequestDataExport(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID, reason *string) (*model.PrivacyRequest, error) {
+	return c.createPrivacyRequest(ctx, model.PrivacyRequestExport, unionID, userID, reason)
+}
+
+// RequestErasure records an erasure request and anonymises the member in the background
+func (c *UserController) RequestErasure(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID, reason *string) (*model.PrivacyRequest, error) {
+	return c.createPrivacyRequest(ctx, model.PrivacyRequestErasure, unionID, userID, reason)
+}
+
+func (c *UserController) PrivacyRequests(ctx context.Context, unionID primitive.ObjectID, userID *primitive.ObjectID) ([]*model.PrivacyRequest, error) {
+	if unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUnionRequired)
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	filter := bson.M{}
+	if userID != nil && !userID.IsZero() {
+		filter["userID"] = *userID
+	}
+	requests, err := c.PrivacyMongoRepository.Find(ctx, unionID.Hex(), filter)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrPrivacyRequestsLoad)
+	}
+	for _, request := range requests {
+		c.signArchiveURL(ctx, request)
+	}
+	return requests, nil
+}
+
+func (c *UserController) PrivacyRequest(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID) (*model.PrivacyRequest, error) {
+	if unionID.IsZero() || id.IsZero() {
+		err := i18n.Errorf(i18n.ErrIDAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	request, err := c.PrivacyMongoRepository.GetByID(ctx, unionID.Hex(), id)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrPrivacyRequestNotFound)
+	}
+	c.signArchiveURL(ctx, request)
+	return request, nil
+}
+
+// signArchiveURL gives a completed export a download link that soon stops working
+func (c *UserController) signArchiveURL(ctx context.Context, request *model.PrivacyRequest) {
+	if request == nil || request.ArchiveKey == "" {
+		return
+	}
+	url, err := c.privateExportURL(ctx, request.ArchiveKey)
+	if err != nil {
+		log.Printf("could not sign the export of privacy request %s: %v", request.ID.Hex(), err)
+		return
+	}
+	request.ArchiveURL = url
+}
+
+func (c *UserController) createPrivacyRequest(ctx context.Context, requestType string, unionID primitive.ObjectID, userID primitive.ObjectID, reason *string) (*model.PrivacyRequest, error) {
+	if unionID.IsZero() || userID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUserAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	requestedBy := auth.ForContext(ctx).UserID
+	user, err := c.UserMongoRepository.GetByID(ctx, unionID.Hex(), userID)
+	if err != nil || user == nil {
+		return nil, i18n.Errorf(i18n.ErrUserNotFound)
+	}
+	if requestType == model.PrivacyRequestErasure && user.Status == model.UserStatusErased {
+		return nil, i18n.Errorf(i18n.ErrUserErased)
+	}
+
+	request := &model.PrivacyRequest{
+		UnionID:     unionID,
+		UserID:      userID,
+		Type:        requestType,
+		Status:      model.PrivacyStatusReceived,
+		RequestedBy: requestedBy,
+		History: []*model.PrivacyRequestEvent{
+			{Status: model.PrivacyStatusReceived, At: time.Now()},
+		},
+	}
+	if reason != nil {
+		request.Reason = *reason
+	}
+	request, err = c.PrivacyMongoRepository.Create(ctx, unionID.Hex(), request)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrPrivacyRequestRecord)
+	}
+
+	go c.processPrivacyRequest(request)
+	return request, nil
+}
+
+// processPrivacyRequest runs detached from the request context; its outcome is only
+// visible through the stored request
+func (c *UserController) processPrivacyRequest(request *model.PrivacyRequest) {
+	ctx, cancel := context.WithTimeout(context.Background(), privacyRequestTimeout)
+	defer cancel()
+	union := request.UnionID.Hex()
+
+	if err := c.PrivacyMongoRepository.SetStatus(ctx, union, request.ID, model.PrivacyStatusInProgress, "", nil); err != nil {
+		log.Printf("privacy request %s: %v", request.ID.Hex(), err)
+	}
+
+	var fields bson.M
+	var note string
+	var err error
+	switch request.Type {
+	case model.PrivacyRequestExport:
+		fields, note, err = c.exportMemberData(ctx, request)
+	case model.PrivacyRequestErasure:
+		fields, note, err = c.eraseMember(ctx, request)
+	default:
+		err = i18n.Errorf(i18n.ErrPrivacyRequestType, request.Type)
+	}
+
+	if err != nil {
+		log.Printf("privacy request %s failed: %v", request.ID.Hex(), err)
+		fields = bson.M{"error": err.Error(), "completedOn": time.Now()}
+		if err := c.PrivacyMongoRepository.SetStatus(ctx, union, request.ID, model.PrivacyStatusFailed, err.Error(), fields); err != nil {
+			log.Printf("privacy request %s: %v", request.ID.Hex(), err)
+		}
+		return
+	}
+	fields["completedOn"] = time.Now()
+	if err := c.PrivacyMongoRepository.SetStatus(ctx, union, request.ID, model.PrivacyStatusCompleted, note, fields); err != nil {
+		log.Printf("privacy request %s: %v", request.ID.Hex(), err)
+	}
+}
+
+// exportMemberData zips every export section and stores the archive in the union's bucket prefix
+func (c *UserController) exportMemberData(ctx context.Context, request *model.PrivacyRequest) (bson.M, string, error) {
+	if c.awsProvider == nil || os.Getenv("AWS_EXPORT_BUCKET") == "" {
+		return nil, "", i18n.Errorf(i18n.ErrArchiveStorageMissing)
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	files := []string{}
+	for _, section := range privacyExportSections {
+		data, err := section.collect(ctx, c, request.UnionID, request.UserID)
+		if err != nil {
+			return nil, "", i18n.Errorf(i18n.ErrExportCollect, section.file, err)
+		}
+		w, err := archive.Create(section.file)
+		if err != nil {
+			return nil, "", err
+		}
+		if _, err := w.Write(data); err != nil {
+			return nil, "", err
+		}
+		files = append(files, section.file)
+	}
+
+	manifest, _ := json.MarshalIndent(map[string]interface{}{
+		"requestID":   request.ID.Hex(),
+		"unionID":     request.UnionID.Hex(),
+		"userID":      request.UserID.Hex(),
+		"generatedAt": time.Now().UTC(),
+		"files":       files,
+	}, "", "  ")
+	w, err := archive.Create("manifest.json")
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := w.Write(manifest); err != nil {
+		return nil, "", err
+	}
+	if err := archive.Close(); err != nil {
+		return nil, "", i18n.Errorf(i18n.ErrExportBuild, err)
+	}
+
+	key := fmt.Sprintf("%s/privacy/%s.zip", request.UnionID.Hex(), request.ID.Hex())
+	if err := c.storePrivateExport(ctx, key, buf.Bytes()); err != nil {
+		return nil, "", i18n.Errorf(i18n.ErrExportStore, err)
+	}
+	return bson.M{"archiveKey": key}, fmt.Sprintf("%d files exported", len(files)), nil
+}
+
+// storePrivateExport keeps an export holding member data in AWS_EXPORT_BUCKET, a
+// private bucket encrypted at rest; it is only handed out through privateExportURL
+func (c *UserController) storePrivateExport(ctx context.Context, key string, data []byte) error {
+	bucket := os.Getenv("AWS_EXPORT_BUCKET")
+	if c.awsProvider == nil || bucket == "" {
+		return i18n.Errorf(i18n.ErrArchiveStorageMissing)
+	}
+	return c.awsProvider.UploadPrivate(ctx, bucket, key, data)
+}
+
+// privateExportURL is a download link of a stored export that works for exportLinkTTL
+func (c *UserController) privateExportURL(ctx context.Context, key string) (string, error) {
+	bucket := os.Getenv("AWS_EXPORT_BUCKET")
+	if c.awsProvider == nil || bucket == "" {
+		return "", i18n.Errorf(i18n.ErrArchiveStorageMissing)
+	}
+	return c.awsProvider.PresignGet(ctx, bucket, key, exportLinkTTL)
+}
+
+// eraseMember anonymises the member in cmsService first, so a failure there leaves
+// the profile intact and the request can be raised again
+func (c *UserController) eraseMember(ctx context.Context, request *model.PrivacyRequest) (bson.M, string, error) {
+	union := request.UnionID.Hex()
+	user, err := c.UserMongoRepository.GetByID(ctx, union, request.UserID)
+	if err != nil || user == nil {
+		return nil, "", i18n.Errorf(i18n.ErrUserNotFound)
+	}
+
+	replacementID := primitive.NewObjectID()
+	report, err := c.anonymiseCmsContent(ctx, request.UnionID, request.UserID, replacementID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+	unset := bson.M{}
+	for _, field := range erasedMemberFields {
+		unset[field] = ""
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"username":  "erased-" + request.UserID.Hex(),
+			"firstName": "Erased",
+			"lastName":  "Member",
+			"password":  "",
+			"status":    model.UserStatusErased,
+			"loggedIn":  false,
+			"deleted":   true,
+			"deletedAt": now,
+			"erasedAt":  now,
+		},
+		"$unset": unset,
+	}
+	if _, err := c.PrivacyMongoRepository.Scrub(ctx, union, "users", request.UserID, update); err != nil {
+		return nil, "", i18n.Errorf(i18n.ErrUserErase, err)
+	}
+	if _, err := c.PrivacyMongoRepository.Scrub(ctx, union, "members", request.UserID, update); err != nil {
+		return nil, "", i18n.Errorf(i18n.ErrApplicationErase, err)
+	}
+
+	// the status history stays for the union's counts, without the reasons given
+	if _, err := c.PrivacyMongoRepository.ScrubOwned(ctx, union, "status_history", request.UserID, bson.M{"$unset": bson.M{"reason": ""}}); err != nil {
+		return nil, "", i18n.Errorf(i18n.ErrStatusHistoryErase, err)
+	}
+	if _, err := c.PrivacyMongoRepository.DeleteOwned(ctx, union, "login_events", request.UserID); err != nil {
+		return nil, "", i18n.Errorf(i18n.ErrLoginEventsErase, err)
+	}
+
+	if err := c.UserMongoRepository.UnlinkIdentity(ctx, request.UnionID, request.UserID); err != nil {
+		log.Printf("could not unlink identity of erased user %s: %v", request.UserID.Hex(), err)
+	}
+	if err := c.StewardMongoRepository.DeleteAssignments(ctx, union, bson.M{"stewardID": request.UserID}); err != nil {
+		log.Printf("could not remove steward assignments of erased user %s: %v", request.UserID.Hex(), err)
+	}
+	c.deletePhotoObjects(ctx, user.Profile.Photo)
+	go c.UserRedisRepository.InvalidateCache(context.Background(), request.UserID.Hex())
+	go c.UserRedisRepository.InvalidateCache(context.Background(), "all-users-"+union)
+
+	note := fmt.Sprintf("anonymised %d news, %d comments and %d likes", report.News, report.Comments, report.Likes)
+	return bson.M{}, note, nil
+}
+
+type cmsAnonymiseReport struct {
+	News     int `json:"news"`
+	Comments int `json:"comments"`
+	Likes    int `json:"likes"`
+}
+
+func (c *UserController) anonymiseCmsContent(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID, replacementID primitive.ObjectID) (*cmsAnonymiseReport, error) {
+	mutation, vars := graphqlclient.NewMutationBuilder().
+		SetMutationName("anonymiseMemberContent").
+		SetInputName("AnonymiseMemberInput").
+		SetInput(map[string]interface{}{
+			"unionID":       unionID.Hex(),
+			"userID":        userID.Hex(),
+			"replacementID": replacementID.Hex(),
+		}).
+		AddField("news comments likes").
+		Build()
+
+	var result struct {
+		Report cmsAnonymiseReport `json:"anonymiseMemberContent"`
+	}
+	cms := c.graphqlManager.Endpoint(os.Getenv("CMS_GRAPHQL_ENDPOINT")).AsService()
+	if err := cms.Execute(ctx, mutation, vars, &result); err != nil {
+		return nil, i18n.Errorf(i18n.ErrContentAnonymise, err)
+	}
+	return &result.Report, nil
+}
+
+const memberContentQuery = `
+	query memberContent($unionID: ObjectID!, $userID: ObjectID!) {
+		memberContent(unionID: $unionID, userID: $userID) {
+			news { id content createdOn unit }
+			comments { id newsID content createdOn likes dislikes }
+			likedNews
+			likedComments
+		}
+	}
+`
+
+func collectCmsContent(ctx context.Context, c *UserController, unionID primitive.ObjectID, userID primitive.ObjectID) ([]byte, error) {
+	vars := map[string]interface{}{
+		"unionID": unionID.Hex(),
+		"userID":  userID.Hex(),
+	}
+	var result struct {
+		MemberContent json.RawMessage `json:"memberContent"`
+	}
+	cms := c.graphqlManager.Endpoint(os.Getenv("CMS_GRAPHQL_ENDPOINT")).AsService()
+	if err := cms.Execute(ctx, memberContentQuery, vars, &result); err != nil {
+		return nil, err
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, result.MemberContent, "", "  "); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// collectRecords exports the member's document from a tenant collection as relaxed extended JSON
+func collectRecords(collection string) func(ctx context.Context, c *UserController, unionID primitive.ObjectID, userID primitive.ObjectID) ([]byte, error) {
+	return func(ctx context.Context, c *UserController, unionID primitive.ObjectID, userID primitive.ObjectID) ([]byte, error) {
+		docs, err := c.PrivacyMongoRepository.FindRaw(ctx, unionID.Hex(), collection, bson.M{"_id": userID})
+		if err != nil {
+			return nil, err
+		}
+		return privacyExtJSON(docs)
+	}
+}
+
+// collectOwnedRecords exports the documents of collection that belong to the member
+func collectOwnedRecords(collection string) func(ctx context.Context, c *UserController, unionID primitive.ObjectID, userID primitive.ObjectID) ([]byte, error) {
+	return func(ctx context.Context, c *UserController, unionID primitive.ObjectID, userID primitive.ObjectID) ([]byte, error) {
+		docs, err := c.PrivacyMongoRepository.FindRaw(ctx, unionID.Hex(), collection, bson.M{"userID": userID})
+		if err != nil {
+			return nil, err
+		}
+		return privacyExtJSON(docs)
+	}
+}
+
+func privacyExtJSON(docs []bson.M) ([]byte, error) {
+	for _, doc := range docs {
+		for _, field := range privacySecretFields {
+			delete(doc, field)
+		}
+	}
+	return bson.MarshalExtJSONIndent(bson.M{"records": docs}, false, false, "", "  ")
+}
@@ -0,0 +1,128 @@
+package controllers
+
+import (
+	"context"
+	"log"
+	"time"
+	unionModel "younified-backend/contracts/union/model"
+	"younified-backend/contracts/user/model"
+	"younified-backend/services/userService/internal/i18n"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PurgeDeletedUsers hard-deletes users that have been soft-deleted for longer than
+// the union's retention policy allows. A dry run only reports what would go. Only the
+// union's admins and backend services can run it.
+func (c *UserController) PurgeDeletedUsers(ctx context.Context, unionID primitive.ObjectID, dryRun bool) (*model.PurgeReport, error) {
+	if unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUnionRequired)
+		return nil, err
+	}
+	if err := c.requireAdminOrService(ctx, unionID); err != nil {
+		return nil, err
+	}
+	return c.purgeDeletedUsers(ctx, unionID, dryRun)
+}
+
+func (c *UserController) purgeDeletedUsers(ctx context.Context, unionID primitive.ObjectID, dryRun bool) (*model.PurgeReport, error) {
+	union, err := c.UserMongoRepository.GetUnion(ctx, unionID)
+	if err != nil || union == nil {
+		return nil, i18n.Errorf(i18n.ErrUnionNotFound)
+	}
+
+	now := time.Now()
+	report := &model.PurgeReport{UnionID: unionID, DryRun: dryRun, RanAt: now, Items: []*model.PurgeItem{}}
+	if union.RetentionPolicy == nil {
+		return report, nil
+	}
+	cutoff, ok := unionModel.Cutoff(union.RetentionPolicy.DeletedUsersDays, now)
+	if !ok {
+		return report, nil
+	}
+
+	tenant := unionID.Hex()
+	if !dryRun {
+		if _, err := c.UserMongoRepository.StampDeletedAt(ctx, tenant, now); err != nil {
+			return nil, i18n.Errorf(i18n.ErrDeletedUsersStamp, err)
+		}
+	}
+	users, err := c.UserMongoRepository.FindDeletedBefore(ctx, tenant, cutoff)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrDeletedUsersFind, err)
+	}
+
+	item := &model.PurgeItem{
+		Collection:    "users",
+		RetentionDays: union.RetentionPolicy.DeletedUsersDays,
+		Cutoff:        cutoff,
+		Matched:       len(users),
+	}
+	report.Items = append(report.Items, item)
+	if len(users) == 0 {
+		return report, nil
+	}
+
+	ids := make([]primitive.ObjectID, 0, len(users))
+	for _, user := range users {
+		ids = append(ids, user.ID)
+	}
+	// the sign ins of a purged user go with them
+	events, err := c.LoginMongoRepository.CountEvents(ctx, tenant, ids)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrLoginEventsCount, err)
+	}
+	eventItem := &model.PurgeItem{
+		Collection:    "login_events",
+		RetentionDays: item.RetentionDays,
+		Cutoff:        cutoff,
+		Matched:       int(events),
+	}
+	report.Items = append(report.Items, eventItem)
+	if dryRun {
+		return report, nil
+	}
+
+	purged, err := c.UserMongoRepository.PurgeUsers(ctx, tenant, ids)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrDeletedUsersPurge, err)
+	}
+	item.Purged = int(purged)
+	eventsPurged, err := c.LoginMongoRepository.DeleteEvents(ctx, tenant, ids)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrLoginEventsPurge, err)
+	}
+	eventItem.Purged = int(eventsPurged)
+
+	for _, user := range users {
+		if user.Profile.Photo != nil {
+			c.deletePhotoObjects(ctx, user.Profile.Photo)
+			item.MediaDeleted += len(user.Profile.Photo.Variants)
+		}
+		go c.UserRedisRepository.InvalidateCache(context.Background(), user.ID.Hex())
+		log.Printf("purged user %s of union %s, deleted at %s", user.ID.Hex(), tenant, user.DeletedAt.Format(time.RFC3339))
+	}
+	go c.UserRedisRepository.InvalidateCache(context.Background(), "all-users-"+tenant)
+	return report, nil
+}
+
+// RunRetentionPurge applies every union's retention policy; it is what the
+// scheduled job calls
+func (c *UserController) RunRetentionPurge(ctx context.Context, dryRun bool) {
+	unions, err := c.dbManager.ListUnions(ctx)
+	if err != nil {
+		log.Printf("retention purge: %v", err)
+		return
+	}
+	for _, union := range unions {
+		report, err := c.purgeDeletedUsers(ctx, union.ID, dryRun)
+		if err != nil {
+			log.Printf("retention purge of union %s failed: %v", union.UnionID, err)
+			continue
+		}
+		for _, item := range report.Items {
+			log.Printf("retention purge of union %s: %s matched %d, purged %d, media deleted %d (dry run %t)",
+				union.UnionID, item.Collection, item.Matched, item.Purged, item.MediaDeleted, dryRun)
+		}
+	}
+}
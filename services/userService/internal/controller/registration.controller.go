@@ -0,0 +1,146 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	unionModel "younified-backend/contracts/union/model"
+	"younified-backend/contracts/user/model"
+	"younified-backend/providers/database"
+	"younified-backend/services/userService/internal/i18n"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// registrationResendInterval is how long an applicant waits before another code is mailed
+const registrationResendInterval = time.Minute
+
+// tenantUnionID is unionID, or the union of the custom domain the request came through
+// when the caller left it out
+func tenantUnionID(ctx context.Context, unionID primitive.ObjectID) primitive.ObjectID {
+	if !unionID.IsZero() {
+		return unionID
+	}
+	return database.TenantFromContext(ctx)
+}
+
+// checkRegistrationEmail refuses emails of banned domains, and emails outside the
+// union's domains when it requires one
+func checkRegistrationEmail(union *unionModel.Union, address string) error {
+	if union.BansEmail(address) {
+		return i18n.Errorf(i18n.ErrEmailDomainBanned, unionModel.EmailDomain(address))
+	}
+	if !union.AcceptsEmail(address) {
+		return i18n.Errorf(i18n.ErrEmailDomainRequired, strings.Join(union.EmailDomains, ", "))
+	}
+	return nil
+}
+
+// sendRegistrationCode mails a one-time code the applicant confirms their email with
+func (c *UserController) sendRegistrationCode(ctx context.Context, unionID primitive.ObjectID, member *model.User) error {
+	code, err := verificationCode()
+	if err != nil {
+		return i18n.Errorf(i18n.ErrVerificationCode)
+	}
+	address := normalizeEmail(member.Profile.Email)
+	now := time.Now()
+	update := bson.M{
+		"$set": bson.M{
+			"emailVerification": &model.EmailVerification{
+				Email:     address,
+				CodeHash:  hashVerificationCode(code, member.ID),
+				ExpiresAt: now.Add(emailVerificationTTL),
+				SentAt:    now,
+			},
+		},
+	}
+	// only replace a code mailed long enough ago, so the endpoint can't be used to flood inboxes
+	filter := bson.M{
+		"_id":                      member.ID,
+		"emailVerification.sentAt": bson.M{"$not": bson.M{"$gt": now.Add(-registrationResendInterval)}},
+	}
+	if _, err := c.UserMongoRepository.UpdateMember(ctx, unionID.Hex(), filter, update); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return i18n.Errorf(i18n.ErrVerificationThrottled)
+		}
+		return i18n.Errorf(i18n.ErrVerificationStart)
+	}
+
+	text := c.mailTextFor(ctx, unionID.Hex(), member)
+	if _, err := c.sendMail(ctx, address, text.Subject(i18n.SubjectEmailVerification), text.Bodies().EmailVerification(member.Username, code), "verification"); err != nil {
+		return i18n.Errorf(i18n.ErrVerificationSend)
+	}
+	return nil
+}
+
+// RequestRegistrationCode mails a new code to an applicant whose code expired
+func (c *UserController) RequestRegistrationCode(ctx context.Context, unionID *primitive.ObjectID, memberID primitive.ObjectID) (*string, error) {
+	member, union, err := c.pendingRegistration(ctx, unionID, memberID)
+	if err != nil {
+		return nil, err
+	}
+	if member.EmailVerification == nil {
+		return &Response, nil
+	}
+	if err := c.sendRegistrationCode(ctx, union, member); err != nil {
+		return nil, err
+	}
+	return &Response, nil
+}
+
+// VerifyRegistration confirms the email of an applicant. Unions requiring an email
+// domain only approve applicants who did.
+func (c *UserController) VerifyRegistration(ctx context.Context, unionID *primitive.ObjectID, memberID primitive.ObjectID, code string) (*model.User, error) {
+	member, union, err := c.pendingRegistration(ctx, unionID, memberID)
+	if err != nil {
+		return nil, err
+	}
+	pending := member.EmailVerification
+	if pending == nil {
+		return member, nil
+	}
+	if time.Now().After(pending.ExpiresAt) {
+		return nil, i18n.Errorf(i18n.ErrVerificationExpired)
+	}
+	// count the attempt before checking the code, so parallel guesses are counted too
+	if pending.Attempts >= maxVerificationAttempts {
+		return nil, i18n.Errorf(i18n.ErrVerificationLocked)
+	}
+	attempt := bson.M{"$inc": bson.M{"emailVerification.attempts": 1}}
+	limit := bson.M{"_id": member.ID, "emailVerification.attempts": bson.M{"$lt": maxVerificationAttempts}}
+	if _, err := c.UserMongoRepository.UpdateMember(ctx, union.Hex(), limit, attempt); err != nil {
+		return nil, i18n.Errorf(i18n.ErrVerificationLocked)
+	}
+	if pending.CodeHash != hashVerificationCode(strings.TrimSpace(code), member.ID) {
+		return nil, i18n.Errorf(i18n.ErrVerificationInvalid)
+	}
+
+	update := bson.M{
+		"$set":   bson.M{"verifiedEmail": pending.Email, "emailVerifiedAt": time.Now()},
+		"$unset": bson.M{"emailVerification": ""},
+	}
+	verified, err := c.UserMongoRepository.UpdateMember(ctx, union.Hex(), bson.M{"_id": member.ID}, update)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrVerificationFailed)
+	}
+	return verified, nil
+}
+
+func (c *UserController) pendingRegistration(ctx context.Context, unionID *primitive.ObjectID, memberID primitive.ObjectID) (*model.User, primitive.ObjectID, error) {
+	union := primitive.NilObjectID
+	if unionID != nil {
+		union = *unionID
+	}
+	union = tenantUnionID(ctx, union)
+	if union.IsZero() || memberID.IsZero() {
+		return nil, union, i18n.Errorf(i18n.ErrMemberAndUnionRequired)
+	}
+	member, _ := c.UserMongoRepository.GetMemberByID(ctx, union.Hex(), memberID)
+	if member == nil {
+		return nil, union, i18n.Errorf(i18n.ErrMemberNotFound)
+	}
+	return member, union, nil
+}
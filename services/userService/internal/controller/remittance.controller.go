@@ -0,0 +1,414 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"strings"
+	"time"
+	"younified-backend/contracts/user/model"
+	"younified-backend/services/userService/internal/auth"
+	"younified-backend/services/userService/internal/i18n"
+	"younified-backend/services/userService/internal/remittance"
+
+	"github.com/99designs/gqlgen/graphql"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// remittanceKeys read the member value a remittance line is matched on
+var remittanceKeys = map[string]func(*model.User) string{
+	model.RemittanceMatchEmployeeID:  func(u *model.User) string { return u.EmployeeID },
+	model.RemittanceMatchBadgeNumber: func(u *model.User) string { return u.BadgeNumber },
+	model.RemittanceMatchMemberID:    func(u *model.User) string { return u.MemberID },
+}
+
+// remittanceScopes read the member fields that select who an employer deducts for
+var remittanceScopes = map[string]func(*model.User) string{
+	"unit":           func(u *model.User) string { return u.Unit },
+	"location":       func(u *model.User) string { return u.Location },
+	"department":     func(u *model.User) string { return u.Department },
+	"employmentType": func(u *model.User) string { return u.EmploymentType },
+}
+
+func (c *UserController) CreateRemittanceFormat(ctx context.Context, unionID primitive.ObjectID, input model.RemittanceFormatInput) (*model.RemittanceFormat, error) {
+	if unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUnionRequired)
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	format := &model.RemittanceFormat{UnionID: unionID, Kind: model.RemittanceCSV, MatchField: model.RemittanceMatchEmployeeID}
+	applyRemittanceFormatInput(format, input)
+	if err := validateRemittanceFormat(format); err != nil {
+		return nil, err
+	}
+	return c.RemittanceMongoRepository.CreateFormat(ctx, unionID.Hex(), format)
+}
+
+func (c *UserController) UpdateRemittanceFormat(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID, input model.RemittanceFormatInput) (*model.RemittanceFormat, error) {
+	if unionID.IsZero() || id.IsZero() {
+		err := i18n.Errorf(i18n.ErrIDAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	format, err := c.RemittanceMongoRepository.GetFormat(ctx, unionID.Hex(), id)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrRemittanceFormatNotFound)
+	}
+	applyRemittanceFormatInput(format, input)
+	if err := validateRemittanceFormat(format); err != nil {
+		return nil, err
+	}
+	return c.RemittanceMongoRepository.ReplaceFormat(ctx, unionID.Hex(), format)
+}
+
+func (c *UserController) RemittanceFormats(ctx context.Context, unionID primitive.ObjectID) ([]*model.RemittanceFormat, error) {
+	if unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUnionRequired)
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	return c.RemittanceMongoRepository.Formats(ctx, unionID.Hex())
+}
+
+func (c *UserController) RemittanceImports(ctx context.Context, unionID primitive.ObjectID, period *string) ([]*model.RemittanceImport, error) {
+	if unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUnionRequired)
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	filter := bson.M{}
+	if period != nil && *period != "" {
+		filter["period"] = *period
+	}
+	return c.RemittanceMongoRepository.Imports(ctx, unionID.Hex(), filter)
+}
+
+func (c *UserController) RemittanceImport(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID) (*model.RemittanceImport, error) {
+	if unionID.IsZero() || id.IsZero() {
+		err := i18n.Errorf(i18n.ErrIDAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	return c.RemittanceMongoRepository.GetImport(ctx, unionID.Hex(), id)
+}
+
+// ImportRemittance reads an employer's deduction file, posts each member's deductions
+// as one dues payment for period and reconciles the file against the dues assessed.
+// Percentage dues missing for the period are assessed on the earnings in the file.
+func (c *UserController) ImportRemittance(ctx context.Context, unionID primitive.ObjectID, formatID primitive.ObjectID, period string, file graphql.Upload, dryRun *bool) (*model.RemittanceImport, error) {
+	if unionID.IsZero() || formatID.IsZero() {
+		err := i18n.Errorf(i18n.ErrFormatAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	start, err := time.Parse(model.DuesPeriodLayout, period)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrPeriodFormat)
+	}
+	union := unionID.Hex()
+	format, err := c.RemittanceMongoRepository.GetFormat(ctx, union, formatID)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrRemittanceFormatNotFound)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(file.File, remittance.MaxFileBytes+1))
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrFileRead, err)
+	}
+	if len(data) > remittance.MaxFileBytes {
+		return nil, i18n.Errorf(i18n.ErrFileTooLarge, remittance.MaxFileBytes>>20)
+	}
+	sum := sha256.Sum256(data)
+	record := &model.RemittanceImport{
+		ID:          primitive.NewObjectID(),
+		UnionID:     unionID,
+		FormatID:    formatID,
+		Employer:    format.Employer,
+		Period:      period,
+		FileName:    file.Filename,
+		FileHash:    hex.EncodeToString(sum[:]),
+		DryRun:      dryRun != nil && *dryRun,
+		Unmatched:   []*model.RemittanceLine{},
+		NoDeduction: []*model.RemittanceMember{},
+		Mismatches:  []*model.RemittanceMismatch{},
+		ImportedBy:  auth.ForContext(ctx).UserID,
+		ImportedOn:  time.Now(),
+	}
+	if !record.DryRun {
+		earlier, err := c.RemittanceMongoRepository.FindPostedImport(ctx, union, formatID, period, record.FileHash)
+		if err != nil {
+			return nil, err
+		}
+		if earlier != nil {
+			return nil, i18n.Errorf(i18n.ErrRemittanceImported, period, earlier.ImportedOn.Format("Jan 2, 2006"))
+		}
+	}
+
+	lines, lineErrors, err := remittance.Parse(bytes.NewReader(data), format)
+	if err != nil {
+		return nil, err
+	}
+	record.Lines = len(lines) + len(lineErrors)
+	record.Errors = lineErrors
+
+	members, err := c.DuesMongoRepository.DuesMembers(ctx, union)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrMembersLoad, err)
+	}
+	schedules, err := c.DuesMongoRepository.Schedules(ctx, union)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrDuesSchedulesLoad, err)
+	}
+	assessments, err := c.DuesMongoRepository.Entries(ctx, union, bson.M{"type": model.DuesEntryAssessment, "period": period})
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrDuesAssessedLoad, err)
+	}
+	assessed := map[primitive.ObjectID]float64{}
+	for _, entry := range assessments {
+		assessed[entry.UserID] += entry.Amount
+	}
+
+	byKey := map[string]*model.User{}
+	keyOf := remittanceKeys[format.MatchField]
+	for _, user := range members {
+		if key := normalizeRemittanceKey(keyOf(user)); key != "" {
+			byKey[key] = user
+		}
+	}
+
+	// a member may have several lines, e.g. one per job
+	deducted := map[primitive.ObjectID]*model.RemittanceLine{}
+	matched := []*model.User{}
+	for _, line := range lines {
+		record.TotalAmount += line.Amount
+		user, ok := byKey[normalizeRemittanceKey(line.Key)]
+		if !ok {
+			record.Unmatched = append(record.Unmatched, line)
+			continue
+		}
+		total, seen := deducted[user.ID]
+		if !seen {
+			total = &model.RemittanceLine{Line: line.Line, Key: line.Key, Name: line.Name}
+			deducted[user.ID] = total
+			matched = append(matched, user)
+		}
+		total.Amount += line.Amount
+		total.Earnings += line.Earnings
+	}
+	record.TotalAmount = model.RoundCents(record.TotalAmount)
+	record.Matched = len(matched)
+
+	for _, user := range matched {
+		line := deducted[user.ID]
+		line.Amount = model.RoundCents(line.Amount)
+		expected, ok := assessed[user.ID]
+		if !ok {
+			expected = c.assessFromRemittance(ctx, user, schedules, period, start, line.Earnings, record.DryRun)
+		}
+		if math.Abs(line.Amount-expected) > 0.005 {
+			record.Mismatches = append(record.Mismatches, &model.RemittanceMismatch{
+				UserID:     user.ID,
+				Name:       strings.TrimSpace(user.FirstName + " " + user.LastName),
+				Key:        line.Key,
+				Expected:   expected,
+				Deducted:   line.Amount,
+				Difference: model.RoundCents(line.Amount - expected),
+			})
+		}
+		if record.DryRun || line.Amount == 0 {
+			continue
+		}
+		description := "Payroll deduction"
+		if format.Employer != "" {
+			description = fmt.Sprintf("Payroll deduction from %s", format.Employer)
+		}
+		_, err := c.DuesMongoRepository.AddEntry(ctx, union, &model.DuesLedgerEntry{
+			UnionID:       unionID,
+			UserID:        user.ID,
+			Type:          model.DuesEntryPayment,
+			Period:        period,
+			Amount:        -line.Amount,
+			Description:   description,
+			Reference:     "remittance " + record.ID.Hex(),
+			RecordedBy:    record.ImportedBy,
+			EffectiveDate: record.ImportedOn,
+		})
+		if err != nil {
+			log.Printf("could not post remittance of user %s: %v", user.ID.Hex(), err)
+			continue
+		}
+		record.Posted++
+		record.PostedAmount += line.Amount
+		if _, err := c.refreshDuesStanding(ctx, user, schedules); err != nil {
+			log.Printf("could not refresh dues standing of user %s: %v", user.ID.Hex(), err)
+		}
+	}
+	record.PostedAmount = model.RoundCents(record.PostedAmount)
+
+	inScope := remittanceScopes[format.ScopeField]
+	for _, user := range members {
+		if _, ok := deducted[user.ID]; ok || !owesDues(user, period) {
+			continue
+		}
+		if inScope != nil && !strings.EqualFold(inScope(user), format.ScopeValue) {
+			continue
+		}
+		expected, ok := assessed[user.ID]
+		if !ok {
+			schedule := duesScheduleFor(schedules, user, period)
+			if schedule == nil || schedule.Method != model.DuesMethodFlat {
+				continue
+			}
+			expected = schedule.Assess(0)
+		}
+		record.NoDeduction = append(record.NoDeduction, &model.RemittanceMember{
+			UserID:     user.ID,
+			Name:       strings.TrimSpace(user.FirstName + " " + user.LastName),
+			EmployeeID: user.EmployeeID,
+			Expected:   expected,
+		})
+	}
+
+	if _, err := c.RemittanceMongoRepository.CreateImport(ctx, union, record); err != nil {
+		return nil, i18n.Errorf(i18n.ErrRemittanceRecord, err)
+	}
+	if record.Posted > 0 {
+		go c.UserRedisRepository.InvalidateCache(context.Background(), "all-users-"+union)
+	}
+	return record, nil
+}
+
+// assessFromRemittance returns the dues a member owes for a period that was not
+// assessed yet, booking the assessment unless this is a dry run
+func (c *UserController) assessFromRemittance(ctx context.Context, user *model.User, schedules []*model.DuesSchedule, period string, start time.Time, earnings float64, dryRun bool) float64 {
+	schedule := duesScheduleFor(schedules, user, period)
+	if schedule == nil || !owesDues(user, period) {
+		return 0
+	}
+	if schedule.Method == model.DuesMethodPercentage && earnings == 0 {
+		return 0
+	}
+	amount := schedule.Assess(earnings)
+	if dryRun {
+		return amount
+	}
+	_, err := c.DuesMongoRepository.AddAssessment(ctx, user.UnionID.Hex(), &model.DuesLedgerEntry{
+		UnionID:       user.UnionID,
+		UserID:        user.ID,
+		Type:          model.DuesEntryAssessment,
+		Period:        period,
+		Amount:        amount,
+		Description:   fmt.Sprintf("%s dues for %s", schedule.Name, period),
+		ScheduleID:    schedule.ID,
+		EffectiveDate: start,
+	})
+	if err != nil {
+		log.Printf("could not assess dues of user %s: %v", user.ID.Hex(), err)
+	}
+	return amount
+}
+
+func applyRemittanceFormatInput(format *model.RemittanceFormat, input model.RemittanceFormatInput) {
+	if input.Name != nil && strings.TrimSpace(*input.Name) != "" {
+		format.Name = strings.TrimSpace(*input.Name)
+	}
+	if input.Employer != nil {
+		format.Employer = strings.TrimSpace(*input.Employer)
+	}
+	if input.Kind != nil {
+		format.Kind = *input.Kind
+	}
+	if input.Delimiter != nil {
+		format.Delimiter = *input.Delimiter
+	}
+	if input.HeaderRows != nil {
+		format.HeaderRows = *input.HeaderRows
+	}
+	if input.MatchField != nil {
+		format.MatchField = *input.MatchField
+	}
+	if input.Key != nil {
+		format.Key = input.Key
+	}
+	if input.Amount != nil {
+		format.Amount = input.Amount
+	}
+	if input.Earnings != nil {
+		format.Earnings = input.Earnings
+	}
+	if input.MemberName != nil {
+		format.MemberName = input.MemberName
+	}
+	if input.ImpliedDecimals != nil {
+		format.ImpliedDecimals = *input.ImpliedDecimals
+	}
+	if input.ScopeField != nil {
+		format.ScopeField = *input.ScopeField
+	}
+	if input.ScopeValue != nil {
+		format.ScopeValue = *input.ScopeValue
+	}
+}
+
+func validateRemittanceFormat(format *model.RemittanceFormat) error {
+	if format.Name == "" {
+		return i18n.Errorf(i18n.ErrNameRequired)
+	}
+	if format.Kind != model.RemittanceCSV && format.Kind != model.RemittanceFixedWidth {
+		return i18n.Errorf(i18n.ErrSiteKind, model.RemittanceCSV, model.RemittanceFixedWidth)
+	}
+	if _, ok := remittanceKeys[format.MatchField]; !ok {
+		return i18n.Errorf(i18n.ErrMatchField, model.RemittanceMatchEmployeeID, model.RemittanceMatchBadgeNumber, model.RemittanceMatchMemberID)
+	}
+	if format.Key == nil || format.Amount == nil {
+		return i18n.Errorf(i18n.ErrRemittanceColumnsRequired)
+	}
+	if len([]rune(format.Delimiter)) > 1 {
+		return i18n.Errorf(i18n.ErrDelimiter)
+	}
+	if format.HeaderRows < 0 || format.ImpliedDecimals < 0 || format.ImpliedDecimals > 4 {
+		return i18n.Errorf(i18n.ErrRemittanceLayout)
+	}
+	if format.ScopeField != "" {
+		if _, ok := remittanceScopes[format.ScopeField]; !ok {
+			return i18n.Errorf(i18n.ErrScopeField)
+		}
+	}
+	for _, column := range []*model.RemittanceColumn{format.Key, format.Amount, format.Earnings, format.MemberName} {
+		if column == nil {
+			continue
+		}
+		if format.Kind == model.RemittanceFixedWidth && (column.Start < 1 || column.Length < 1) {
+			return i18n.Errorf(i18n.ErrFixedWidthColumn)
+		}
+		if format.Kind == model.RemittanceCSV && column.Header != "" && format.HeaderRows < 1 {
+			return i18n.Errorf(i18n.ErrHeaderRowRequired)
+		}
+		if format.Kind == model.RemittanceCSV && column.Header == "" && column.Index < 0 {
+			return i18n.Errorf(i18n.ErrCSVColumn)
+		}
+	}
+	return nil
+}
+
+func normalizeRemittanceKey(key string) string {
+	return strings.ToLower(strings.TrimLeft(strings.TrimSpace(key), "0"))
+}
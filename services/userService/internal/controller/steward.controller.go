@@ -0,0 +1,411 @@
+package controllers
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strings"
+	"younified-backend/contracts/user/model"
+	"younified-backend/services/userService/internal/i18n"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (c *UserController) AssignSteward(ctx context.Context, unionID primitive.ObjectID, input model.StewardAssignmentInput) (*model.StewardAssignment, error) {
+	if unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUnionRequired)
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	if input.StewardID == nil || input.StewardID.IsZero() {
+		err := i18n.Errorf(i18n.ErrStewardRequired)
+		return nil, err
+	}
+	union := unionID.Hex()
+	if err := c.checkSteward(ctx, union, *input.StewardID); err != nil {
+		return nil, err
+	}
+	coverage, err := stewardCoverage(input.Coverage)
+	if err != nil {
+		return nil, err
+	}
+	assignment := &model.StewardAssignment{UnionID: unionID, StewardID: *input.StewardID, Coverage: coverage}
+	if input.Backup != nil {
+		assignment.Backup = *input.Backup
+	}
+	if input.Notes != nil {
+		assignment.Notes = strings.TrimSpace(*input.Notes)
+	}
+	assignment, err = c.StewardMongoRepository.CreateAssignment(ctx, union, assignment)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrStewardAssign, err)
+	}
+	c.syncStewardRole(ctx, union, assignment.StewardID)
+	return assignment, nil
+}
+
+// UpdateStewardAssignment changes the coverage of an assignment or hands it to
+// another steward
+func (c *UserController) UpdateStewardAssignment(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID, input model.StewardAssignmentInput) (*model.StewardAssignment, error) {
+	if unionID.IsZero() || id.IsZero() {
+		err := i18n.Errorf(i18n.ErrIDAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	union := unionID.Hex()
+	current, err := c.StewardMongoRepository.GetAssignment(ctx, union, id)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrStewardAssignmentNotFound)
+	}
+	set := bson.M{}
+	if input.StewardID != nil && *input.StewardID != current.StewardID {
+		if err := c.checkSteward(ctx, union, *input.StewardID); err != nil {
+			return nil, err
+		}
+		set["stewardID"] = *input.StewardID
+	}
+	if input.Coverage != nil {
+		coverage, err := stewardCoverage(input.Coverage)
+		if err != nil {
+			return nil, err
+		}
+		set["coverage"] = coverage
+	}
+	if input.Backup != nil {
+		set["backup"] = *input.Backup
+	}
+	if input.Notes != nil {
+		set["notes"] = strings.TrimSpace(*input.Notes)
+	}
+	if len(set) == 0 {
+		return current, nil
+	}
+	assignment, err := c.StewardMongoRepository.UpdateAssignment(ctx, union, id, set)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrStewardAssignmentUpdate)
+	}
+	if assignment.StewardID != current.StewardID {
+		c.syncStewardRole(ctx, union, current.StewardID)
+		c.syncStewardRole(ctx, union, assignment.StewardID)
+	}
+	return assignment, nil
+}
+
+// RemoveStewardAssignment deletes an assignment; the steward role is withdrawn with
+// the steward's last assignment
+func (c *UserController) RemoveStewardAssignment(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID) (string, error) {
+	if unionID.IsZero() || id.IsZero() {
+		err := i18n.Errorf(i18n.ErrIDAndUnionRequired)
+		return "", err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return "", err
+	}
+	union := unionID.Hex()
+	assignment, err := c.StewardMongoRepository.GetAssignment(ctx, union, id)
+	if err != nil {
+		return "", i18n.Errorf(i18n.ErrStewardAssignmentNotFound)
+	}
+	if err := c.StewardMongoRepository.DeleteAssignments(ctx, union, bson.M{"_id": id}); err != nil {
+		return "", i18n.Errorf(i18n.ErrStewardAssignmentRemove, err)
+	}
+	c.syncStewardRole(ctx, union, assignment.StewardID)
+	return Response, nil
+}
+
+func (c *UserController) StewardAssignments(ctx context.Context, unionID primitive.ObjectID, stewardID *primitive.ObjectID) ([]*model.StewardAssignment, error) {
+	if unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUnionRequired)
+		return nil, err
+	}
+	filter := bson.M{}
+	if stewardID != nil {
+		filter["stewardID"] = *stewardID
+	}
+	return c.StewardMongoRepository.Assignments(ctx, unionID.Hex(), filter)
+}
+
+// MyStewards resolves the stewards of a member from their unit, department, zone and
+// shift. Members not covered by any assignment fall back to their stewardEmail.
+func (c *UserController) MyStewards(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID) (*model.MyStewards, error) {
+	if unionID.IsZero() || userID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUserAndUnionRequired)
+		return nil, err
+	}
+	union := unionID.Hex()
+	member, err := c.UserMongoRepository.GetByID(ctx, union, userID)
+	if err != nil || member == nil {
+		return nil, i18n.Errorf(i18n.ErrUserNotFound)
+	}
+	assignments, stewards, err := c.loadStewards(ctx, union)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.MyStewards{Backups: []*model.StewardContact{}}
+	primary, backups := coveringStewards(member, assignments, stewards)
+	if primary != nil {
+		result.Steward = stewardContact(stewards[primary.StewardID], coveringAssignments(member, assignments, primary.StewardID))
+	}
+	for _, backup := range backups {
+		result.Backups = append(result.Backups, stewardContact(stewards[backup], coveringAssignments(member, assignments, backup)))
+	}
+
+	if result.Steward == nil && len(result.Backups) == 0 && member.StewardEmail != "" {
+		steward, err := c.UserMongoRepository.GetUser(ctx, union, bson.M{"profile.email": strings.TrimSpace(member.StewardEmail), "deleted": bson.M{"$ne": true}})
+		if err == nil {
+			result.Steward = stewardContact(steward, []*model.StewardAssignment{})
+		}
+	}
+	return result, nil
+}
+
+// StewardDirectory lists the stewards able to serve members, each with their
+// assignments. With field set only assignments covering it are listed, and with value
+// too only those covering field = value.
+func (c *UserController) StewardDirectory(ctx context.Context, unionID primitive.ObjectID, field *string, value *string) ([]*model.StewardContact, error) {
+	if unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUnionRequired)
+		return nil, err
+	}
+	assignments, stewards, err := c.loadStewards(ctx, unionID.Hex())
+	if err != nil {
+		return nil, err
+	}
+
+	contacts := map[primitive.ObjectID]*model.StewardContact{}
+	directory := []*model.StewardContact{}
+	for _, assignment := range assignments {
+		steward, ok := stewards[assignment.StewardID]
+		if !ok || !canSignIn(steward) {
+			continue
+		}
+		if field != nil && *field != "" && !coversField(assignment, *field, value) {
+			continue
+		}
+		contact, ok := contacts[steward.ID]
+		if !ok {
+			contact = stewardContact(steward, []*model.StewardAssignment{})
+			contacts[steward.ID] = contact
+			directory = append(directory, contact)
+		}
+		contact.Assignments = append(contact.Assignments, assignment)
+	}
+	sort.SliceStable(directory, func(i, j int) bool {
+		return strings.ToLower(directory[i].Name) < strings.ToLower(directory[j].Name)
+	})
+	return directory, nil
+}
+
+// StewardWorkload counts the members each steward covers, and those nobody covers
+func (c *UserController) StewardWorkload(ctx context.Context, unionID primitive.ObjectID) (*model.StewardWorkloadReport, error) {
+	if unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUnionRequired)
+		return nil, err
+	}
+	union := unionID.Hex()
+	assignments, stewards, err := c.loadStewards(ctx, union)
+	if err != nil {
+		return nil, err
+	}
+	members, err := c.StewardMongoRepository.Members(ctx, union, bson.M{"level": bson.M{"$ne": 5}})
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrMembersLoad, err)
+	}
+
+	report := &model.StewardWorkloadReport{Stewards: []*model.StewardWorkload{}}
+	workloads := map[primitive.ObjectID]*model.StewardWorkload{}
+	workload := func(stewardID primitive.ObjectID) *model.StewardWorkload {
+		w, ok := workloads[stewardID]
+		if !ok {
+			w = &model.StewardWorkload{Steward: stewardContact(stewards[stewardID], []*model.StewardAssignment{})}
+			workloads[stewardID] = w
+			report.Stewards = append(report.Stewards, w)
+		}
+		return w
+	}
+	for _, assignment := range assignments {
+		if steward, ok := stewards[assignment.StewardID]; ok && canSignIn(steward) {
+			w := workload(assignment.StewardID)
+			w.Steward.Assignments = append(w.Steward.Assignments, assignment)
+		}
+	}
+	for _, member := range members {
+		if !canSignIn(member) {
+			continue
+		}
+		primary, backups := coveringStewards(member, assignments, stewards)
+		if primary == nil && len(backups) == 0 {
+			report.Uncovered++
+			continue
+		}
+		if primary != nil {
+			workload(primary.StewardID).Members++
+		}
+		for _, backup := range backups {
+			workload(backup).BackupMembers++
+		}
+	}
+	sort.SliceStable(report.Stewards, func(i, j int) bool {
+		return report.Stewards[i].Members > report.Stewards[j].Members
+	})
+	return report, nil
+}
+
+// loadStewards returns every assignment of the union and the stewards holding them
+func (c *UserController) loadStewards(ctx context.Context, unionID string) ([]*model.StewardAssignment, map[primitive.ObjectID]*model.User, error) {
+	assignments, err := c.StewardMongoRepository.Assignments(ctx, unionID, bson.M{})
+	if err != nil {
+		return nil, nil, i18n.Errorf(i18n.ErrStewardAssignmentsLoad, err)
+	}
+	ids := []primitive.ObjectID{}
+	for _, assignment := range assignments {
+		ids = append(ids, assignment.StewardID)
+	}
+	users, err := c.StewardMongoRepository.Members(ctx, unionID, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, nil, i18n.Errorf(i18n.ErrStewardsLoad, err)
+	}
+	stewards := map[primitive.ObjectID]*model.User{}
+	for _, user := range users {
+		stewards[user.ID] = user
+	}
+	return assignments, stewards, nil
+}
+
+// checkSteward makes sure a member can hold the steward role
+func (c *UserController) checkSteward(ctx context.Context, unionID string, stewardID primitive.ObjectID) error {
+	steward, err := c.UserMongoRepository.GetByID(ctx, unionID, stewardID)
+	if err != nil || steward == nil || steward.Deleted {
+		return i18n.Errorf(i18n.ErrStewardNotFound)
+	}
+	if !canSignIn(steward) {
+		return i18n.Errorf(i18n.ErrStewardStatus, steward.FirstName, steward.LastName, model.NormalizeStatus(steward.Status))
+	}
+	return nil
+}
+
+// syncStewardRole grants the steward role while the user has assignments and
+// withdraws it with the last one
+func (c *UserController) syncStewardRole(ctx context.Context, unionID string, stewardID primitive.ObjectID) {
+	assignments, err := c.StewardMongoRepository.Assignments(ctx, unionID, bson.M{"stewardID": stewardID})
+	if err != nil {
+		log.Printf("could not load assignments of steward %s: %v", stewardID.Hex(), err)
+		return
+	}
+	if err := c.StewardMongoRepository.SetSteward(ctx, unionID, stewardID, len(assignments) > 0); err != nil {
+		log.Printf("could not update steward role of user %s: %v", stewardID.Hex(), err)
+		return
+	}
+	go c.UserRedisRepository.InvalidateCache(context.Background(), stewardID.Hex())
+}
+
+// coveringStewards picks the steward of a member: the one whose assignment covers
+// the member most specifically, the oldest on a tie. Backups are ordered the same
+// way. Stewards never cover themselves, and stewards who cannot sign in are skipped.
+func coveringStewards(member *model.User, assignments []*model.StewardAssignment, stewards map[primitive.ObjectID]*model.User) (*model.StewardAssignment, []primitive.ObjectID) {
+	var primary *model.StewardAssignment
+	best := -1
+	type backup struct {
+		stewardID   primitive.ObjectID
+		specificity int
+	}
+	candidates := []backup{}
+	seen := map[primitive.ObjectID]bool{}
+	for _, assignment := range assignments {
+		steward, ok := stewards[assignment.StewardID]
+		if !ok || steward.ID == member.ID || !canSignIn(steward) {
+			continue
+		}
+		covers, specificity := assignment.Covers(member)
+		if !covers {
+			continue
+		}
+		if !assignment.Backup {
+			if specificity > best {
+				primary, best = assignment, specificity
+			}
+			continue
+		}
+		if !seen[assignment.StewardID] {
+			seen[assignment.StewardID] = true
+			candidates = append(candidates, backup{assignment.StewardID, specificity})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].specificity > candidates[j].specificity
+	})
+	backups := []primitive.ObjectID{}
+	for _, candidate := range candidates {
+		if primary == nil || candidate.stewardID != primary.StewardID {
+			backups = append(backups, candidate.stewardID)
+		}
+	}
+	return primary, backups
+}
+
+// coveringAssignments returns the assignments of a steward covering member
+func coveringAssignments(member *model.User, assignments []*model.StewardAssignment, stewardID primitive.ObjectID) []*model.StewardAssignment {
+	covering := []*model.StewardAssignment{}
+	for _, assignment := range assignments {
+		if assignment.StewardID != stewardID {
+			continue
+		}
+		if covers, _ := assignment.Covers(member); covers {
+			covering = append(covering, assignment)
+		}
+	}
+	return covering
+}
+
+func coversField(assignment *model.StewardAssignment, field string, value *string) bool {
+	for _, rule := range assignment.Coverage {
+		if rule.Field == field && (value == nil || *value == "" || strings.EqualFold(rule.Value, strings.TrimSpace(*value))) {
+			return true
+		}
+	}
+	return false
+}
+
+func stewardContact(steward *model.User, assignments []*model.StewardAssignment) *model.StewardContact {
+	phone := steward.Profile.Mobile
+	if phone == "" {
+		phone = steward.Profile.Phone
+	}
+	return &model.StewardContact{
+		UserID:      steward.ID,
+		Name:        strings.TrimSpace(steward.FirstName + " " + steward.LastName),
+		Email:       steward.Profile.Email,
+		Phone:       phone,
+		Location:    steward.Location,
+		Assignments: assignments,
+	}
+}
+
+// stewardCoverage validates coverage rules; a field may only be given once
+func stewardCoverage(input []*model.StewardCoverage) ([]*model.StewardCoverage, error) {
+	coverage := []*model.StewardCoverage{}
+	fields := map[string]bool{}
+	for _, rule := range input {
+		switch rule.Field {
+		case model.StewardCoverUnit, model.StewardCoverDepartment, model.StewardCoverZone, model.StewardCoverShift:
+		default:
+			return nil, i18n.Errorf(i18n.ErrCoverageField)
+		}
+		value := strings.TrimSpace(rule.Value)
+		if value == "" {
+			return nil, i18n.Errorf(i18n.ErrCoverageValue, rule.Field)
+		}
+		if fields[rule.Field] {
+			return nil, i18n.Errorf(i18n.ErrCoverageDuplicate, rule.Field)
+		}
+		fields[rule.Field] = true
+		coverage = append(coverage, &model.StewardCoverage{Field: rule.Field, Value: value})
+	}
+	return coverage, nil
+}
@@ -0,0 +1,458 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"younified-backend/contracts/user/model"
+	"younified-backend/services/userService/internal/auth"
+	"younified-backend/services/userService/internal/i18n"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// columns of the strike pay file handed to finance
+var strikeExportHeader = []string{
+	"payment_id", "member_id", "user_id", "first_name", "last_name", "week_start",
+	"days_attended", "days_paid", "amount", "captain_id", "approved_on",
+}
+
+func (c *UserController) CreateStrikePeriod(ctx context.Context, unionID primitive.ObjectID, input model.StrikePeriodInput) (*model.StrikePeriod, error) {
+	if unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUnionRequired)
+		return nil, err
+	}
+	if input.Name == nil || strings.TrimSpace(*input.Name) == "" || input.StartDate == nil || input.Rules == nil {
+		err := i18n.Errorf(i18n.ErrStrikeFieldsRequired)
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	period := &model.StrikePeriod{
+		UnionID:   unionID,
+		Name:      strings.TrimSpace(*input.Name),
+		StartDate: model.StrikeDay(*input.StartDate),
+		Rules:     input.Rules,
+		CreatedBy: auth.ForContext(ctx).UserID,
+	}
+	if input.EndDate != nil {
+		end := model.StrikeDay(*input.EndDate)
+		period.EndDate = &end
+	}
+	if err := validateStrikePeriod(period); err != nil {
+		return nil, err
+	}
+	return c.StrikeMongoRepository.CreatePeriod(ctx, unionID.Hex(), period)
+}
+
+// UpdateStrikePeriod changes the dates or rules of a strike, or closes it. Rule changes
+// apply to weeks computed afterwards; approved payments keep their amounts.
+func (c *UserController) UpdateStrikePeriod(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID, input model.StrikePeriodInput) (*model.StrikePeriod, error) {
+	if unionID.IsZero() || id.IsZero() {
+		err := i18n.Errorf(i18n.ErrIDAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	period, err := c.StrikeMongoRepository.GetPeriod(ctx, unionID.Hex(), id)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrStrikePeriodNotFound)
+	}
+
+	set := bson.M{}
+	if input.Name != nil && strings.TrimSpace(*input.Name) != "" {
+		period.Name = strings.TrimSpace(*input.Name)
+		set["name"] = period.Name
+	}
+	if input.StartDate != nil {
+		period.StartDate = model.StrikeDay(*input.StartDate)
+		set["startDate"] = period.StartDate
+	}
+	if input.EndDate != nil {
+		end := model.StrikeDay(*input.EndDate)
+		period.EndDate = &end
+		set["endDate"] = end
+	}
+	if input.Rules != nil {
+		period.Rules = input.Rules
+		set["rules"] = input.Rules
+	}
+	if input.Closed != nil {
+		set["closed"] = *input.Closed
+	}
+	if err := validateStrikePeriod(period); err != nil {
+		return nil, err
+	}
+	return c.StrikeMongoRepository.UpdatePeriod(ctx, unionID.Hex(), id, set)
+}
+
+func (c *UserController) StrikePeriods(ctx context.Context, unionID primitive.ObjectID) ([]*model.StrikePeriod, error) {
+	if unionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUnionRequired)
+		return nil, err
+	}
+	return c.StrikeMongoRepository.Periods(ctx, unionID.Hex())
+}
+
+// RecordPicketAttendance records a day on the picket line for each member of the input
+func (c *UserController) RecordPicketAttendance(ctx context.Context, unionID primitive.ObjectID, strikeID primitive.ObjectID, input []*model.PicketAttendanceInput) ([]*model.PicketAttendance, error) {
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	return c.recordPicketAttendance(ctx, unionID, strikeID, input, auth.ForContext(ctx).UserID)
+}
+
+// recordPicketAttendance records the attendance as recordedBy, who was allowed to
+func (c *UserController) recordPicketAttendance(ctx context.Context, unionID primitive.ObjectID, strikeID primitive.ObjectID, input []*model.PicketAttendanceInput, recordedBy primitive.ObjectID) ([]*model.PicketAttendance, error) {
+	period, err := c.openStrikePeriod(ctx, unionID, strikeID)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded := []*model.PicketAttendance{}
+	for _, entry := range input {
+		day := model.StrikeDay(entry.Date)
+		if day.Before(period.StartDate) || (period.EndDate != nil && day.After(*period.EndDate)) {
+			return recorded, i18n.Errorf(i18n.ErrOutsideStrikePeriod, day.Format("2006-01-02"))
+		}
+		if entry.Hours < 0 || entry.Hours > 24 {
+			return recorded, i18n.Errorf(i18n.ErrHoursRange)
+		}
+		attendance := &model.PicketAttendance{
+			UnionID:    unionID,
+			StrikeID:   strikeID,
+			UserID:     entry.UserID,
+			Date:       day,
+			Hours:      entry.Hours,
+			RecordedBy: recordedBy,
+		}
+		if entry.Location != nil {
+			attendance.Location = *entry.Location
+		}
+		if entry.CaptainID != nil {
+			attendance.CaptainID = *entry.CaptainID
+		}
+		stored, err := c.StrikeMongoRepository.RecordAttendance(ctx, unionID.Hex(), attendance)
+		if err != nil {
+			return recorded, err
+		}
+		recorded = append(recorded, stored)
+	}
+	return recorded, nil
+}
+
+func (c *UserController) PicketAttendance(ctx context.Context, unionID primitive.ObjectID, strikeID primitive.ObjectID, userID *primitive.ObjectID, from *time.Time, to *time.Time) ([]*model.PicketAttendance, error) {
+	if unionID.IsZero() || strikeID.IsZero() {
+		err := i18n.Errorf(i18n.ErrStrikeAndUnionRequired)
+		return nil, err
+	}
+	// members read their own attendance, admins anyone's
+	if userID != nil {
+		if err := c.requireSelfOrAdmin(ctx, *userID, unionID); err != nil {
+			return nil, err
+		}
+	} else if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	filter := bson.M{"strikeID": strikeID}
+	if userID != nil {
+		filter["userID"] = *userID
+	}
+	date := bson.M{}
+	if from != nil {
+		date["$gte"] = model.StrikeDay(*from)
+	}
+	if to != nil {
+		date["$lte"] = model.StrikeDay(*to)
+	}
+	if len(date) > 0 {
+		filter["date"] = date
+	}
+	return c.StrikeMongoRepository.Attendance(ctx, unionID.Hex(), filter)
+}
+
+// ComputeStrikePay turns the attendance of the week containing weekStart into pending
+// payments. Only active members flagged for strike pay qualify, and payments that
+// were already approved are not recomputed.
+func (c *UserController) ComputeStrikePay(ctx context.Context, unionID primitive.ObjectID, strikeID primitive.ObjectID, weekStart time.Time) ([]*model.StrikePayment, error) {
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	period, err := c.openStrikePeriod(ctx, unionID, strikeID)
+	if err != nil {
+		return nil, err
+	}
+	union := unionID.Hex()
+	week := model.WeekStart(weekStart)
+
+	attendance, err := c.StrikeMongoRepository.Attendance(ctx, union, bson.M{
+		"strikeID": strikeID,
+		"date":     bson.M{"$gte": week, "$lt": week.AddDate(0, 0, 7)},
+	})
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrAttendanceLoad, err)
+	}
+	settled, err := c.StrikeMongoRepository.Payments(ctx, union, bson.M{
+		"strikeID":  strikeID,
+		"weekStart": week,
+		"status":    bson.M{"$ne": model.StrikePaymentPending},
+	})
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrPaymentsLoad, err)
+	}
+	skip := map[primitive.ObjectID]bool{}
+	for _, payment := range settled {
+		skip[payment.UserID] = true
+	}
+
+	// attendance is ordered by day, so the last captain seen is the member's latest
+	days := map[primitive.ObjectID]int{}
+	captains := map[primitive.ObjectID]primitive.ObjectID{}
+	members := []primitive.ObjectID{}
+	for _, a := range attendance {
+		if _, seen := days[a.UserID]; !seen {
+			members = append(members, a.UserID)
+			days[a.UserID] = 0
+		}
+		if a.Hours >= period.Rules.MinHoursPerDay {
+			days[a.UserID]++
+		}
+		if !a.CaptainID.IsZero() {
+			captains[a.UserID] = a.CaptainID
+		}
+	}
+
+	now := time.Now()
+	payments := []*model.StrikePayment{}
+	for _, userID := range members {
+		if skip[userID] {
+			continue
+		}
+		user, err := c.UserMongoRepository.GetByID(ctx, union, userID)
+		if err != nil || user == nil || !strikePayEligible(user) {
+			log.Printf("strike %s: user %s is not eligible for strike pay", strikeID.Hex(), userID.Hex())
+			continue
+		}
+		paid, amount := period.Rules.Entitlement(days[userID])
+		payment, err := c.StrikeMongoRepository.SavePayment(ctx, union, &model.StrikePayment{
+			UnionID:      unionID,
+			StrikeID:     strikeID,
+			UserID:       userID,
+			CaptainID:    captains[userID],
+			WeekStart:    week,
+			DaysAttended: days[userID],
+			DaysPaid:     paid,
+			Amount:       amount,
+			Status:       model.StrikePaymentPending,
+			ComputedOn:   now,
+		})
+		if err != nil {
+			return payments, i18n.Errorf(i18n.ErrPaymentSave, err)
+		}
+		payments = append(payments, payment)
+	}
+	return payments, nil
+}
+
+// ApproveStrikePayments approves pending payments of the strike as the signed in admin
+func (c *UserController) ApproveStrikePayments(ctx context.Context, unionID primitive.ObjectID, strikeID primitive.ObjectID, ids []primitive.ObjectID) ([]*model.StrikePayment, error) {
+	if unionID.IsZero() || strikeID.IsZero() {
+		err := i18n.Errorf(i18n.ErrStrikeAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return []*model.StrikePayment{}, nil
+	}
+	union := unionID.Hex()
+	set := bson.M{"approvedBy": auth.ForContext(ctx).UserID, "approvedOn": time.Now()}
+	if _, err := c.StrikeMongoRepository.SetPaymentStatus(ctx, union, strikeID, ids, model.StrikePaymentPending, model.StrikePaymentApproved, set); err != nil {
+		return nil, i18n.Errorf(i18n.ErrPaymentsApprove, err)
+	}
+	return c.StrikeMongoRepository.Payments(ctx, union, bson.M{"strikeID": strikeID, "_id": bson.M{"$in": ids}})
+}
+
+func (c *UserController) StrikePayments(ctx context.Context, unionID primitive.ObjectID, strikeID primitive.ObjectID, weekStart *time.Time, status *string) ([]*model.StrikePayment, error) {
+	if unionID.IsZero() || strikeID.IsZero() {
+		err := i18n.Errorf(i18n.ErrStrikeAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+		return nil, err
+	}
+	return c.strikePayments(ctx, unionID, strikeID, weekStart, status)
+}
+
+func (c *UserController) strikePayments(ctx context.Context, unionID primitive.ObjectID, strikeID primitive.ObjectID, weekStart *time.Time, status *string) ([]*model.StrikePayment, error) {
+	filter := bson.M{"strikeID": strikeID}
+	if weekStart != nil {
+		filter["weekStart"] = model.WeekStart(*weekStart)
+	}
+	if status != nil && *status != "" {
+		filter["status"] = *status
+	}
+	return c.StrikeMongoRepository.Payments(ctx, unionID.Hex(), filter)
+}
+
+func (c *UserController) StrikePayMemberReport(ctx context.Context, unionID primitive.ObjectID, strikeID primitive.ObjectID, userID primitive.ObjectID) (*model.StrikePayReport, error) {
+	if unionID.IsZero() || strikeID.IsZero() {
+		err := i18n.Errorf(i18n.ErrStrikeAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireSelfOrAdmin(ctx, userID, unionID); err != nil {
+		return nil, err
+	}
+	payments, err := c.strikePayments(ctx, unionID, strikeID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	report := &model.StrikePayReport{StrikeID: strikeID, UserID: userID}
+	summariseStrikePay(report, payments, func(p *model.StrikePayment) bool { return p.UserID == userID })
+	return report, nil
+}
+
+func (c *UserController) StrikePayCaptainReport(ctx context.Context, unionID primitive.ObjectID, strikeID primitive.ObjectID, captainID primitive.ObjectID) (*model.StrikePayReport, error) {
+	if unionID.IsZero() || strikeID.IsZero() {
+		err := i18n.Errorf(i18n.ErrStrikeAndUnionRequired)
+		return nil, err
+	}
+	if err := c.requireSelfOrAdmin(ctx, captainID, unionID); err != nil {
+		return nil, err
+	}
+	payments, err := c.strikePayments(ctx, unionID, strikeID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	report := &model.StrikePayReport{StrikeID: strikeID, CaptainID: captainID}
+	summariseStrikePay(report, payments, func(p *model.StrikePayment) bool { return p.CaptainID == captainID })
+	return report, nil
+}
+
+// ExportStrikePayments writes the approved payments that finance has not received yet
+// to a CSV file in the private export bucket, marks them exported and returns a link
+// that soon stops working
+func (c *UserController) ExportStrikePayments(ctx context.Context, unionID primitive.ObjectID, strikeID primitive.ObjectID) (*string, error) {
+	status := model.StrikePaymentApproved
+	payments, err := c.StrikePayments(ctx, unionID, strikeID, nil, &status)
+	if err != nil {
+		return nil, err
+	}
+	if len(payments) == 0 {
+		return nil, i18n.Errorf(i18n.ErrNoApprovedPayments)
+	}
+	if c.awsProvider == nil || os.Getenv("AWS_EXPORT_BUCKET") == "" {
+		return nil, i18n.Errorf(i18n.ErrFileStorageMissing)
+	}
+
+	union := unionID.Hex()
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write(strikeExportHeader)
+	ids := make([]primitive.ObjectID, 0, len(payments))
+	for _, p := range payments {
+		row := []string{p.ID.Hex(), "", p.UserID.Hex(), "", "", p.WeekStart.Format("2006-01-02"),
+			strconv.Itoa(p.DaysAttended), strconv.Itoa(p.DaysPaid), strconv.FormatFloat(p.Amount, 'f', 2, 64), "", ""}
+		if user, err := c.UserMongoRepository.GetByID(ctx, union, p.UserID); err == nil && user != nil {
+			row[1], row[3], row[4] = user.EmployeeID, user.FirstName, user.LastName
+		}
+		if !p.CaptainID.IsZero() {
+			row[9] = p.CaptainID.Hex()
+		}
+		if p.ApprovedOn != nil {
+			row[10] = p.ApprovedOn.Format(time.RFC3339)
+		}
+		w.Write(row)
+		ids = append(ids, p.ID)
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	key := fmt.Sprintf("%s/strike-pay/%s-%s.csv", union, strikeID.Hex(), now.Format("20060102150405"))
+	if err := c.storePrivateExport(ctx, key, buf.Bytes()); err != nil {
+		return nil, i18n.Errorf(i18n.ErrExportUpload, err)
+	}
+	exported, err := c.StrikeMongoRepository.SetPaymentStatus(ctx, union, strikeID, ids, model.StrikePaymentApproved, model.StrikePaymentExported, bson.M{"exportedOn": now})
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrExportMark, key, err)
+	}
+	log.Printf("exported %d strike payments of strike %s to %s", exported, strikeID.Hex(), key)
+	url, err := c.privateExportURL(ctx, key)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrExportUpload, err)
+	}
+	return &url, nil
+}
+
+func (c *UserController) openStrikePeriod(ctx context.Context, unionID primitive.ObjectID, strikeID primitive.ObjectID) (*model.StrikePeriod, error) {
+	if unionID.IsZero() || strikeID.IsZero() {
+		err := i18n.Errorf(i18n.ErrStrikeAndUnionRequired)
+		return nil, err
+	}
+	period, err := c.StrikeMongoRepository.GetPeriod(ctx, unionID.Hex(), strikeID)
+	if err != nil {
+		return nil, i18n.Errorf(i18n.ErrStrikePeriodNotFound)
+	}
+	if period.Closed {
+		return nil, i18n.Errorf(i18n.ErrStrikePeriodClosed, period.Name)
+	}
+	if period.Rules == nil {
+		return nil, i18n.Errorf(i18n.ErrStrikePayRulesMissing, period.Name)
+	}
+	return period, nil
+}
+
+func validateStrikePeriod(period *model.StrikePeriod) error {
+	if period.EndDate != nil && period.EndDate.Before(period.StartDate) {
+		return i18n.Errorf(i18n.ErrEndBeforeStart)
+	}
+	rules := period.Rules
+	if rules == nil {
+		return nil
+	}
+	if rules.DailyRate < 0 || rules.MinHoursPerDay < 0 || rules.WeeklyCap < 0 {
+		return i18n.Errorf(i18n.ErrStrikePayRulesNegative)
+	}
+	if rules.MinDaysPerWeek < 0 || rules.MinDaysPerWeek > 7 || rules.MaxPaidDaysPerWeek < 0 || rules.MaxPaidDaysPerWeek > 7 {
+		return i18n.Errorf(i18n.ErrDaysPerWeek)
+	}
+	return nil
+}
+
+// strikePayEligible reports whether a member can be paid: flagged for strike pay and
+// an active member
+func strikePayEligible(user *model.User) bool {
+	return user.StrikePay && !user.Deleted && model.NormalizeStatus(user.Status) == model.StatusActive
+}
+
+func summariseStrikePay(report *model.StrikePayReport, payments []*model.StrikePayment, include func(*model.StrikePayment) bool) {
+	members := map[primitive.ObjectID]bool{}
+	report.Payments = []*model.StrikePayment{}
+	for _, p := range payments {
+		if !include(p) {
+			continue
+		}
+		members[p.UserID] = true
+		report.DaysAttended += p.DaysAttended
+		report.DaysPaid += p.DaysPaid
+		report.Amount += p.Amount
+		if p.Status != model.StrikePaymentPending {
+			report.ApprovedAmount += p.Amount
+		}
+		report.Payments = append(report.Payments, p)
+	}
+	report.Members = len(members)
+}
@@ -2,15 +2,15 @@ package controllers
 
 import (
 	"context"
-	"errors"
-	"fmt"
+	"log"
 	"os"
 	"time"
 	"younified-backend/contracts/user/model"
+	"younified-backend/providers/aws"
 	"younified-backend/providers/database"
-	email "younified-backend/providers/emailBodyProvider"
 	"younified-backend/providers/graphqlclient"
 	"younified-backend/services/userService/internal/auth"
+	"younified-backend/services/userService/internal/i18n"
 	"younified-backend/services/userService/internal/repository"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -20,46 +20,89 @@ import (
 )
 
 type UserController struct {
-	UserMongoRepository *repository.MongoUserRepository
-	UserRedisRepository *repository.RedisUserRepository
-	dbManager           *database.DBManager
-	graphqlManager      *graphqlclient.Graph
+	UserMongoRepository       *repository.MongoUserRepository
+	UserRedisRepository       *repository.RedisUserRepository
+	PrivacyMongoRepository    *repository.MongoPrivacyRepository
+	StrikeMongoRepository     *repository.MongoStrikeRepository
+	PicketMongoRepository     *repository.MongoPicketRepository
+	DuesMongoRepository       *repository.MongoDuesRepository
+	RemittanceMongoRepository *repository.MongoRemittanceRepository
+	StewardMongoRepository    *repository.MongoStewardRepository
+	MilestoneMongoRepository  *repository.MongoMilestoneRepository
+	MessageMongoRepository    *repository.MongoMessageRepository
+	LoginMongoRepository      *repository.MongoLoginRepository
+	dbManager                 *database.DBManager
+	settings                  *database.Settings
+	graphqlManager            *graphqlclient.Graph
+	awsProvider               *aws.AWSProvider
 }
 
-func NewUserController(dbManager *database.DBManager, graphqlManager *graphqlclient.Graph, redisClient *database.RedisClient) *UserController {
+func NewUserController(dbManager *database.DBManager, graphqlManager *graphqlclient.Graph, redisClient *database.RedisClient, awsProvider *aws.AWSProvider) *UserController {
 	if dbManager == nil {
 		panic("dbManager cannot be nil")
 	}
 	return &UserController{
-		UserMongoRepository: repository.NewMongoUserRepository(dbManager, "unified_base"),
-		UserRedisRepository: repository.NewRedisUserRepository(redisClient),
-		dbManager:           dbManager,
-		graphqlManager:      graphqlManager,
+		UserMongoRepository:       repository.NewMongoUserRepository(dbManager, "unified_base"),
+		UserRedisRepository:       repository.NewRedisUserRepository(redisClient),
+		PrivacyMongoRepository:    repository.NewMongoPrivacyRepository(dbManager),
+		StrikeMongoRepository:     repository.NewMongoStrikeRepository(dbManager),
+		PicketMongoRepository:     repository.NewMongoPicketRepository(dbManager),
+		DuesMongoRepository:       repository.NewMongoDuesRepository(dbManager),
+		RemittanceMongoRepository: repository.NewMongoRemittanceRepository(dbManager),
+		StewardMongoRepository:    repository.NewMongoStewardRepository(dbManager),
+		MilestoneMongoRepository:  repository.NewMongoMilestoneRepository(dbManager),
+		MessageMongoRepository:    repository.NewMongoMessageRepository(dbManager),
+		LoginMongoRepository:      repository.NewMongoLoginRepository(dbManager),
+		dbManager:                 dbManager,
+		settings:                  database.NewSettings(dbManager, redisClient),
+		graphqlManager:            graphqlManager,
+		awsProvider:               awsProvider,
 	}
 }
 
+// ListenForSettings reloads a union's settings as soon as unionService changes them
+func (c *UserController) ListenForSettings(ctx context.Context) {
+	c.settings.Listen(ctx)
+}
+
 var Response string = "Operation Successful"
 
 //	----------------------- --------------------- -------------------- MAKERS --------------------- ------------------------------- --------------------------
 //
 // function to create user from interservice communication
 func (c *UserController) CreateUser(ctx context.Context, input model.User) (*model.User, error) {
+	return c.createUser(ctx, input, false, 1, false)
+}
+
+// ProvisionUser creates the users of a new union with the role only unionService may
+// give them
+func (c *UserController) ProvisionUser(ctx context.Context, input model.User, role model.ProvisionedUser) (*model.User, error) {
+	if !graphqlclient.IsService(ctx) {
+		return nil, i18n.Errorf(i18n.ErrServiceOnly)
+	}
+	switch role {
+	case model.ProvisionedUserFirstAdmin:
+		return c.createUser(ctx, input, true, 1, false)
+	case model.ProvisionedUserDefaultUser:
+		// level 5 and deleted keep the default user out of member lists
+		return c.createUser(ctx, input, true, 5, true)
+	default:
+		err := i18n.Errorf(i18n.ErrProvisionedUser, role)
+		return nil, err
+	}
+}
+
+func (c *UserController) createUser(ctx context.Context, input model.User, isAdmin bool, level int, deleted bool) (*model.User, error) {
+	if input.UnionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUnionRequired)
+		return nil, err
+	}
 	if !auth.IsPasswordCompromised(input.Password) {
-		err := errors.New("password doesn't match the required criteria")
+		err := i18n.Errorf(i18n.ErrPasswordCriteria)
 		return nil, err
 	}
 	// hash the password
 	password, _ := auth.HashPassword(input.Password, input.UnionID.Hex())
-	var level int = 1
-	var deleted bool = false
-	var isAdmin bool = false
-
-	if input.Level == 5 {
-		// this is default user
-		level = 5
-		deleted = true
-		isAdmin = true
-	}
 	user := &model.User{
 		UnionID:   input.UnionID,
 		Username:  input.Username,
@@ -69,11 +112,14 @@ func (c *UserController) CreateUser(ctx context.Context, input model.User) (*mod
 		Profile:   input.Profile,
 		Deleted:   deleted,
 		Level:     level,
-		Status:    "active",
+		Status:    model.StatusActive,
 		IsAdmin:   isAdmin,
 	}
 
-	user, _ = c.UserMongoRepository.Create(ctx, input.UnionID.Hex(), user)
+	user, err := c.UserMongoRepository.Create(ctx, input.UnionID.Hex(), user)
+	if err != nil {
+		return nil, err
+	}
 	// cache it
 	go c.UserRedisRepository.CacheUser(ctx, user.ID.Hex(), user)
 
@@ -83,43 +129,58 @@ func (c *UserController) CreateUser(ctx context.Context, input model.User) (*mod
 // function to register user(member) from client side
 func (c *UserController) CreateMember(ctx context.Context, input model.User) (*model.User, error) {
 	if !auth.IsPasswordCompromised(input.Password) {
-		err := errors.New("password doesn't match the required criteria")
+		err := i18n.Errorf(i18n.ErrPasswordCriteria)
+		return nil, err
+	}
+	input.UnionID = tenantUnionID(ctx, input.UnionID)
+	if input.UnionID.IsZero() {
+		err := i18n.Errorf(i18n.ErrUnionRequired)
+		return nil, err
+	}
+	union, err := c.UserMongoRepository.GetUnion(ctx, input.UnionID)
+	if err != nil || union == nil {
+		return nil, i18n.Errorf(i18n.ErrUnionNotFound)
+	}
+	address := normalizeEmail(input.Profile.Email)
+	if err := checkRegistrationEmail(union, address); err != nil {
 		return nil, err
 	}
 	unionID := input.UnionID.Hex()
 	// hash the password
 	password, _ := auth.HashPassword(input.Password, unionID)
-	var level int = 1
-	var deleted bool = false
-	var isAdmin bool = false
-
-	if input.Level == 5 {
-		// this is default user
-		level = 5
-		deleted = true
-		isAdmin = true
-	}
 
 	user := &model.User{
+		ID:        primitive.NewObjectID(),
 		UnionID:   input.UnionID,
 		Username:  input.Username,
 		Password:  password, // Add user password hashing strategy here
 		FirstName: input.FirstName,
 		LastName:  input.LastName,
 		Profile:   input.Profile,
-		Deleted:   deleted,
-		Level:     level,
-		Status:    "registered",
-		IsAdmin:   isAdmin,
+		Level:     1,
+		Status:    model.StatusApplicant,
+	}
+	if union.RequireEmailDomain {
+		// held back from approval until the applicant confirms the address
+		user.EmailVerification = &model.EmailVerification{Email: address}
 	}
 
 	// no need to cache memeber registration requests - on approval cache it
-	return c.UserMongoRepository.CreateMember(ctx, unionID, user)
+	member, err := c.UserMongoRepository.CreateMember(ctx, unionID, user)
+	if err != nil {
+		return nil, err
+	}
+	if union.RequireEmailDomain {
+		if err := c.sendRegistrationCode(ctx, input.UnionID, member); err != nil {
+			return nil, err
+		}
+	}
+	return member, nil
 }
 
 func (c *UserController) UploadUsers(ctx context.Context, unionID primitive.ObjectID, input []*model.User) (*string, error) {
 	if unionID.IsZero() {
-		err := fmt.Errorf("unionID is required")
+		err := i18n.Errorf(i18n.ErrUnionRequired)
 		return nil, err
 	}
 	var bulkWriteModels []mongo.WriteModel
@@ -151,7 +212,7 @@ func (c *UserController) UploadUsers(ctx context.Context, unionID primitive.Obje
 // function to Approve user(member) from client side
 func (c *UserController) ApproveUser(ctx context.Context, unionID primitive.ObjectID, memberID primitive.ObjectID) (*model.User, error) {
 	if unionID.IsZero() || memberID.IsZero() {
-		err := fmt.Errorf("memberID and unionID both are required")
+		err := i18n.Errorf(i18n.ErrMemberAndUnionRequired)
 		return nil, err
 	}
 	//convert unionID to string
@@ -160,10 +221,30 @@ func (c *UserController) ApproveUser(ctx context.Context, unionID primitive.Obje
 	// find member from union's member collection
 
 	member, _ := c.UserMongoRepository.GetMemberByID(ctx, unionIdentifier, memberID)
+	if member == nil {
+		return nil, i18n.Errorf(i18n.ErrMemberNotFound)
+	}
+	if !model.CanTransition(member.Status, model.StatusActive) {
+		return nil, i18n.Errorf(i18n.ErrApproveStatus, member.Status)
+	}
+	if member.EmailVerification != nil {
+		return nil, i18n.Errorf(i18n.ErrRegistrationUnverified)
+	}
 	// activate the user
-	member.Status = "active"
+	from := model.NormalizeStatus(member.Status)
+	member.Status = model.StatusActive
+	member.StatusChangedAt = time.Now()
 
 	user, _ := c.UserMongoRepository.Create(ctx, unionIdentifier, member)
+	// start the status timeline with the approval
+	c.UserMongoRepository.RecordStatusChange(ctx, unionIdentifier, &model.StatusChange{
+		UnionID:       unionID,
+		UserID:        user.ID,
+		From:          from,
+		To:            model.StatusActive,
+		EffectiveDate: member.StatusChangedAt,
+		ChangedOn:     member.StatusChangedAt,
+	})
 	// cache it
 	go c.UserRedisRepository.CacheUser(ctx, user.ID.Hex(), user)
 	return user, nil
@@ -171,11 +252,35 @@ func (c *UserController) ApproveUser(ctx context.Context, unionID primitive.Obje
 
 func (c *UserController) UpdateUser(ctx context.Context, userID primitive.ObjectID, unionID primitive.ObjectID, update model.UserUpdateInput) (*model.User, error) {
 	if userID.IsZero() || unionID.IsZero() {
-		err := fmt.Errorf("userID and unionID both are required")
+		err := i18n.Errorf(i18n.ErrUserAndUnionRequired)
 		return nil, err
 	}
 
-	updatedUser, _ := c.UserMongoRepository.Update(ctx, unionID.Hex(), userID, update)
+	// status changes have to go through the lifecycle rules
+	if update.Status != "" {
+		current, err := c.UserMongoRepository.GetByID(ctx, unionID.Hex(), userID)
+		if err != nil || current == nil {
+			return nil, i18n.Errorf(i18n.ErrUserNotFound)
+		}
+		if update.Status != model.NormalizeStatus(current.Status) {
+			if err := c.requireUnionAdmin(ctx, unionID); err != nil {
+				return nil, err
+			}
+			if _, err := c.transitionStatus(ctx, current, model.StatusTransitionInput{Status: update.Status}); err != nil {
+				return nil, err
+			}
+		}
+		update.Status = ""
+	}
+
+	fields, err := userUpdateFields(update)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return c.UserMongoRepository.GetByID(ctx, unionID.Hex(), userID)
+	}
+	updatedUser, _ := c.UserMongoRepository.UpdateUser(ctx, unionID.Hex(), bson.M{"_id": userID}, bson.M{"$set": fields})
 	// check if existing cache
 	user, _ := c.UserRedisRepository.CacheExists(ctx, userID.Hex())
 	if user {
@@ -185,28 +290,60 @@ func (c *UserController) UpdateUser(ctx context.Context, userID primitive.Object
 	return updatedUser, nil
 }
 
+// userUpdateFields returns the fields update sets, leaving out the ones not given.
+// Profile fields are set one by one so the others of the profile stay.
+func userUpdateFields(update model.UserUpdateInput) (bson.M, error) {
+	fields := bson.M{}
+	if update.FirstName != "" {
+		fields["firstName"] = update.FirstName
+	}
+	if update.LastName != "" {
+		fields["lastName"] = update.LastName
+	}
+	raw, err := bson.Marshal(update.Profile)
+	if err != nil {
+		return nil, err
+	}
+	var profile bson.M
+	if err := bson.Unmarshal(raw, &profile); err != nil {
+		return nil, err
+	}
+	for key, value := range profile {
+		fields["profile."+key] = value
+	}
+	return fields, nil
+}
+
 func (c *UserController) Login(ctx context.Context, input *model.Credential, device *string) (*model.SingleUserAuth, error) {
 	// get the user first
+	input.UnionID = tenantUnionID(ctx, input.UnionID)
 	if input.UnionID.IsZero() {
-		err := fmt.Errorf("UnionID is required")
+		err := i18n.Errorf(i18n.ErrUnionRequired)
 		return nil, err
 	}
 	unionID := input.UnionID.Hex()
 
 	user, _ := c.UserMongoRepository.GetByUsername(ctx, unionID, input.Username)
 
-	// find the hashedpassword
-	hashedPassword, _ := auth.HashPassword(input.Password, unionID)
-	// verify the password
-	if !auth.VerifyPassword(hashedPassword, input.Password, unionID) {
-		err := errors.New("the password is invalid, please try with correct password")
+	// verify the password against the stored hash
+	if user == nil || !auth.VerifyPassword(user.Password, input.Password, unionID) {
+		err := i18n.Errorf(i18n.ErrPasswordInvalid)
+		return nil, err
+	}
+	if !canSignIn(user) {
+		err := i18n.Errorf(i18n.ErrCannotSignIn)
 		return nil, err
 	}
 	// generate token
-	token, err := auth.GenerateJWTToken(input.Username, input.UnionID, user.UnionID, 24)
+	token, err := auth.GenerateJWTToken(input.Username, user.ID, input.UnionID, 24)
 	if err != nil {
 		return nil, err
 	}
+	deviceName := ""
+	if device != nil {
+		deviceName = *device
+	}
+	c.recordLogin(ctx, unionID, user, model.LoginMethodPassword, deviceName)
 
 	authenticatedUser := &model.SingleUserAuth{
 		User:  *user,
@@ -219,13 +356,18 @@ func (c *UserController) Login(ctx context.Context, input *model.Credential, dev
 func (c *UserController) LoginWithToken(ctx context.Context, token *string) (*model.SingleUserAuth, error) {
 	userClaim, err := auth.ValidateJWTToken(*token)
 	if err != nil {
-		err = fmt.Errorf("session expired please login again")
+		err = i18n.Errorf(i18n.ErrSessionExpired)
 		return nil, err
 	}
 
 	user, _ := c.UserMongoRepository.GetByUsername(ctx, userClaim.UnionID.Hex(), userClaim.Username)
+	if user == nil || !canSignIn(user) || sessionRevoked(user, userClaim.IssuedAt) {
+		err = i18n.Errorf(i18n.ErrSessionExpired)
+		return nil, err
+	}
 
 	refreshedToken, _ := auth.RefreshJWTToken(*token, 24)
+	c.recordLogin(ctx, userClaim.UnionID.Hex(), user, model.LoginMethodToken, "")
 	authenticated := model.SingleUserAuth{
 		User:  *user,
 		Token: refreshedToken,
@@ -240,11 +382,11 @@ func (c *UserController) ResetPassword(ctx context.Context, unionID primitive.Ob
 	user, err := c.UserMongoRepository.GetUser(ctx, unionID.Hex(), filter)
 
 	if err != nil {
-		err = fmt.Errorf("could not find user with passwordResetKey")
+		err = i18n.Errorf(i18n.ErrResetKeyNotFound)
 		return nil, err
 	}
 	if user.PasswordResetExpireTime.Before(time.Now()) {
-		err = fmt.Errorf("password reset expired")
+		err = i18n.Errorf(i18n.ErrResetExpired)
 		return nil, err
 	}
 
@@ -271,7 +413,7 @@ func (c *UserController) ResetPassword(ctx context.Context, unionID primitive.Ob
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could not update User")
+		err = i18n.Errorf(i18n.ErrUserUpdate)
 		return nil, err
 	}
 	return &Response, nil
@@ -281,28 +423,41 @@ func (c *UserController) ResetPasswordRequest(ctx context.Context, unionID primi
 	//verify user existence
 	user, err := c.UserMongoRepository.GetByUsername(ctx, unionID.Hex(), username)
 	if err != nil {
-		err = fmt.Errorf("could not find user with username %v", username)
+		err = i18n.Errorf(i18n.ErrUsernameNotFound, username)
+		return nil, err
+	}
+	// archived unions can't reset passwords
+	if _, err := c.dbManager.GetDatabase(ctx, unionID.Hex()); err != nil {
 		return nil, err
 	}
-	resetPasswordLink := os.Getenv("PWD_RESET_PATH")
-	db, err := c.dbManager.GetDatabase(ctx, unionID.Hex())
+	resetPasswordLink, err := c.settings.String(ctx, unionID, database.SettingPasswordResetURL)
 	if err != nil {
+		log.Printf("could not read the settings of union %s: %v", unionID.Hex(), err)
+	}
+	if resetPasswordLink == "" {
+		resetPasswordLink = os.Getenv("PWD_RESET_PATH")
+	}
+	template, _ := c.settings.String(ctx, unionID, database.SettingResetPasswordEmail)
+	text := c.mailTextFor(ctx, unionID.Hex(), user)
+	mailContent := text.Bodies().ResetPassword(template, username, resetPasswordLink)
+	response, err := c.sendMail(ctx, user.Profile.Email, text.Subject(i18n.SubjectPasswordReset), mailContent, "password")
+	if err != nil {
+		err = i18n.Errorf(i18n.ErrResetRequest)
 		return nil, err
 	}
-	unionName := db.Name()
-	mailContent := email.GetResetPasswordBody(unionName, username, resetPasswordLink)
+	return &response, err
+}
+
+// sendMail hands an email to communicationService
+func (c *UserController) sendMail(ctx context.Context, to string, subject string, content string, category string) (string, error) {
 	mutationInput := map[string]interface{}{
-		"email":    user.Profile.Email,
-		"subject":  "Request Password Reset",
-		"content":  mailContent,
-		"category": "password",
+		"email":    to,
+		"subject":  subject,
+		"content":  content,
+		"category": category,
 	}
 
-	gqlEP := os.Getenv("Comm_GRAPHQL_ENDPOINT")
-	c.graphqlManager.SetgqlEndpoint(gqlEP)
-	mailMutationBuilder := c.graphqlManager.GetMutationBuilder()
-	// Create a user
-	createUserMutation, createVars := mailMutationBuilder.
+	mailMutation, mailVars := graphqlclient.NewMutationBuilder().
 		SetMutationName("sendMail").
 		SetInputName("SendMailInput").
 		SetInput(mutationInput).
@@ -311,24 +466,20 @@ func (c *UserController) ResetPasswordRequest(ctx context.Context, unionID primi
 		Response string `json:"sendMail"`
 	}
 
-	err = c.graphqlManager.Execute(ctx, createUserMutation, createVars, &result)
-	if err != nil {
-		err = fmt.Errorf("could not complete request for password reset please try after some time or contact Admin")
-		return nil, err
-	}
-	return &result.Response, err
+	err := c.graphqlManager.Endpoint(os.Getenv("Comm_GRAPHQL_ENDPOINT")).Execute(ctx, mailMutation, mailVars, &result)
+	return result.Response, err
 }
 
 func (c *UserController) DeleteUser(ctx context.Context, userID primitive.ObjectID, unionID primitive.ObjectID) (string, error) {
 	if userID.IsZero() || unionID.IsZero() {
-		err := fmt.Errorf("userID and unionID both are equally required")
+		err := i18n.Errorf(i18n.ErrUserAndUnionRequired)
 		return "", err
 	}
 
 	err := c.UserMongoRepository.Delete(ctx, unionID.Hex(), userID)
 
 	if err != nil {
-		err = fmt.Errorf("failed to delete user due to %v", err)
+		err = i18n.Errorf(i18n.ErrUserDelete, err)
 		return "", err
 	}
 	go c.UserRedisRepository.InvalidateCache(ctx, userID.Hex())
@@ -337,7 +488,7 @@ func (c *UserController) DeleteUser(ctx context.Context, userID primitive.Object
 
 func (c *UserController) RestoreUser(ctx context.Context, userID primitive.ObjectID, unionID primitive.ObjectID) (string, error) {
 	if userID.IsZero() || unionID.IsZero() {
-		err := fmt.Errorf("userID and unionID both are equally required")
+		err := i18n.Errorf(i18n.ErrUserAndUnionRequired)
 		return "", err
 	}
 
@@ -350,7 +501,7 @@ func (c *UserController) RestoreUser(ctx context.Context, userID primitive.Objec
 	err := c.UserMongoRepository.Restore(ctx, unionID.Hex(), userID, update)
 
 	if err != nil {
-		err = fmt.Errorf("failed to delete user due to %v", err)
+		err = i18n.Errorf(i18n.ErrUserDelete, err)
 		return "", err
 	}
 	// check if existing cache
@@ -0,0 +1,645 @@
+package i18n
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Key names a message of the catalogue
+type Key string
+
+// API error messages
+const (
+	ErrUnionRequired              Key = "error.unionRequired"
+	ErrUserAndUnionRequired       Key = "error.userAndUnionRequired"
+	ErrMemberAndUnionRequired     Key = "error.memberAndUnionRequired"
+	ErrUserNotFound               Key = "error.userNotFound"
+	ErrMemberNotFound             Key = "error.memberNotFound"
+	ErrUsernameNotFound           Key = "error.usernameNotFound"
+	ErrApproveStatus              Key = "error.approveStatus"
+	ErrUserUpdate                 Key = "error.userUpdate"
+	ErrUserDelete                 Key = "error.userDelete"
+	ErrPasswordCriteria           Key = "error.passwordCriteria"
+	ErrPasswordInvalid            Key = "error.passwordInvalid"
+	ErrCannotSignIn               Key = "error.cannotSignIn"
+	ErrSessionExpired             Key = "error.sessionExpired"
+	ErrResetKeyNotFound           Key = "error.resetKeyNotFound"
+	ErrResetExpired               Key = "error.resetExpired"
+	ErrResetRequest               Key = "error.resetRequest"
+	ErrAuthRequired               Key = "error.authRequired"
+	ErrStaffOnly                  Key = "error.staffOnly"
+	ErrNoEmailToVerify            Key = "error.noEmailToVerify"
+	ErrVerificationCode           Key = "error.verificationCode"
+	ErrVerificationStart          Key = "error.verificationStart"
+	ErrVerificationSend           Key = "error.verificationSend"
+	ErrVerificationExpired        Key = "error.verificationExpired"
+	ErrVerificationInvalid        Key = "error.verificationInvalid"
+	ErrVerificationChanged        Key = "error.verificationChanged"
+	ErrVerificationFailed         Key = "error.verificationFailed"
+	ErrNoMembership               Key = "error.noMembership"
+	ErrMembershipsLoad            Key = "error.membershipsLoad"
+	ErrEmployeeIDRequired         Key = "error.employeeIDRequired"
+	ErrEmployeeIDLookup           Key = "error.employeeIDLookup"
+	ErrUnknownLocale              Key = "error.unknownLocale"
+	ErrUnionNotFound              Key = "error.unionNotFound"
+	ErrEmailDomainBanned          Key = "error.emailDomainBanned"
+	ErrEmailDomainRequired        Key = "error.emailDomainRequired"
+	ErrRegistrationUnverified     Key = "error.registrationUnverified"
+	ErrOwnAccountOnly             Key = "error.ownAccountOnly"
+	ErrVerificationLocked         Key = "error.verificationLocked"
+	ErrUnionAdminOnly             Key = "error.unionAdminOnly"
+	ErrServiceOnly                Key = "error.serviceOnly"
+	ErrProvisionedUser            Key = "error.provisionedUser"
+	ErrVerificationThrottled      Key = "error.verificationThrottled"
+	ErrIDAndUnionRequired         Key = "error.idAndUnionRequired"
+	ErrShiftNotFound              Key = "error.shiftNotFound"
+	ErrMembersLoad                Key = "error.membersLoad"
+	ErrShiftMemberRequired        Key = "error.shiftMemberRequired"
+	ErrStrikeAndUnionRequired     Key = "error.strikeAndUnionRequired"
+	ErrPeriodFormat               Key = "error.periodFormat"
+	ErrNameRequired               Key = "error.nameRequired"
+	ErrRangeOrder                 Key = "error.rangeOrder"
+	ErrFileStorageMissing         Key = "error.fileStorageMissing"
+	ErrExportUpload               Key = "error.exportUpload"
+	ErrDuesSchedulesLoad          Key = "error.duesSchedulesLoad"
+	ErrStrikePeriodNotFound       Key = "error.strikePeriodNotFound"
+	ErrStewardAssignmentNotFound  Key = "error.stewardAssignmentNotFound"
+	ErrRemittanceFormatNotFound   Key = "error.remittanceFormatNotFound"
+	ErrMilestoneRuleNotFound      Key = "error.milestoneRuleNotFound"
+	ErrUserErased                 Key = "error.userErased"
+	ErrPrivacyRequestType         Key = "error.privacyRequestType"
+	ErrMemberStatusUnknown        Key = "error.memberStatusUnknown"
+	ErrSwapRequired               Key = "error.swapRequired"
+	ErrSwapResponseRequired       Key = "error.swapResponseRequired"
+	ErrRuleAndUnionRequired       Key = "error.ruleAndUnionRequired"
+	ErrFormatAndUnionRequired     Key = "error.formatAndUnionRequired"
+	ErrDuesEntryType              Key = "error.duesEntryType"
+	ErrRemittanceImported         Key = "error.remittanceImported"
+	ErrNoApprovedPayments         Key = "error.noApprovedPayments"
+	ErrSwapAnswered               Key = "error.swapAnswered"
+	ErrSwapUnavailable            Key = "error.swapUnavailable"
+	ErrShiftFull                  Key = "error.shiftFull"
+	ErrShiftWorkShift             Key = "error.shiftWorkShift"
+	ErrShiftZone                  Key = "error.shiftZone"
+	ErrShiftStarted               Key = "error.shiftStarted"
+	ErrShiftNotSignedUp           Key = "error.shiftNotSignedUp"
+	ErrNotCheckedIn               Key = "error.notCheckedIn"
+	ErrShiftOverlap               Key = "error.shiftOverlap"
+	ErrAlreadyCheckedIn           Key = "error.alreadyCheckedIn"
+	ErrMemberNoEmail              Key = "error.memberNoEmail"
+	ErrFileTooLarge               Key = "error.fileTooLarge"
+	ErrSubjectAndContentRequired  Key = "error.subjectAndContentRequired"
+	ErrStrikePeriodClosed         Key = "error.strikePeriodClosed"
+	ErrStrikePayRulesMissing      Key = "error.strikePayRulesMissing"
+	ErrStrikePayRulesNegative     Key = "error.strikePayRulesNegative"
+	ErrStewardRequired            Key = "error.stewardRequired"
+	ErrShiftFieldsRequired        Key = "error.shiftFieldsRequired"
+	ErrScopeField                 Key = "error.scopeField"
+	ErrPicketSiteInactive         Key = "error.picketSiteInactive"
+	ErrPhotoStorageMissing        Key = "error.photoStorageMissing"
+	ErrRemittanceRecord           Key = "error.remittanceRecord"
+	ErrCheckInNotAllowed          Key = "error.checkInNotAllowed"
+	ErrSwapRecipientOnly          Key = "error.swapRecipientOnly"
+	ErrWithdrawNotAllowed         Key = "error.withdrawNotAllowed"
+	ErrSwapNotAllowed             Key = "error.swapNotAllowed"
+	ErrNotifyDaysAhead            Key = "error.notifyDaysAhead"
+	ErrStrikeFieldsRequired       Key = "error.strikeFieldsRequired"
+	ErrDuesScheduleFieldsRequired Key = "error.duesScheduleFieldsRequired"
+	ErrDuesLimitsNegative         Key = "error.duesLimitsNegative"
+	ErrMilestoneNegative          Key = "error.milestoneNegative"
+	ErrDuesMethod                 Key = "error.duesMethod"
+	ErrCardInactive               Key = "error.cardInactive"
+	ErrPicketStatus               Key = "error.picketStatus"
+	ErrDuesMaxBelowMin            Key = "error.duesMaxBelowMin"
+	ErrMatchField                 Key = "error.matchField"
+	ErrSiteKind                   Key = "error.siteKind"
+	ErrRemittanceColumnsRequired  Key = "error.remittanceColumnsRequired"
+	ErrHoursRange                 Key = "error.hoursRange"
+	ErrRemittanceLayout           Key = "error.remittanceLayout"
+	ErrFixedWidthColumn           Key = "error.fixedWidthColumn"
+	ErrExportMark                 Key = "error.exportMark"
+	ErrEndBeforeStart             Key = "error.endBeforeStart"
+	ErrEffectiveToFormat          Key = "error.effectiveToFormat"
+	ErrEffectiveOrder             Key = "error.effectiveOrder"
+	ErrEffectiveFromFormat        Key = "error.effectiveFromFormat"
+	ErrDelimiter                  Key = "error.delimiter"
+	ErrDaysPerWeek                Key = "error.daysPerWeek"
+	ErrDaysPositive               Key = "error.daysPositive"
+	ErrCSVColumn                  Key = "error.csvColumn"
+	ErrCoverageValue              Key = "error.coverageValue"
+	ErrCoverageDuplicate          Key = "error.coverageDuplicate"
+	ErrCoverageField              Key = "error.coverageField"
+	ErrStewardAssignmentUpdate    Key = "error.stewardAssignmentUpdate"
+	ErrShiftCapacity              Key = "error.shiftCapacity"
+	ErrPhotoUpdate                Key = "error.photoUpdate"
+	ErrPicketSiteUpdate           Key = "error.picketSiteUpdate"
+	ErrExportStore                Key = "error.exportStore"
+	ErrDeletedUsersStamp          Key = "error.deletedUsersStamp"
+	ErrCardSign                   Key = "error.cardSign"
+	ErrStatementSend              Key = "error.statementSend"
+	ErrPaymentSave                Key = "error.paymentSave"
+	ErrStewardAssignmentRemove    Key = "error.stewardAssignmentRemove"
+	ErrPhotoRemove                Key = "error.photoRemove"
+	ErrPrivacyRequestRecord       Key = "error.privacyRequestRecord"
+	ErrDuesEntryRecord            Key = "error.duesEntryRecord"
+	ErrFileRead                   Key = "error.fileRead"
+	ErrLoginEventsPurge           Key = "error.loginEventsPurge"
+	ErrDeletedUsersPurge          Key = "error.deletedUsersPurge"
+	ErrStewardsLoad               Key = "error.stewardsLoad"
+	ErrStewardAssignmentsLoad     Key = "error.stewardAssignmentsLoad"
+	ErrPaymentsLoad               Key = "error.paymentsLoad"
+	ErrInactiveMembersLoad        Key = "error.inactiveMembersLoad"
+	ErrDuesLedgerLoad             Key = "error.duesLedgerLoad"
+	ErrDuesAssessedLoad           Key = "error.duesAssessedLoad"
+	ErrAttendanceLoad             Key = "error.attendanceLoad"
+	ErrArrearsLoad                Key = "error.arrearsLoad"
+	ErrSwapNotFound               Key = "error.swapNotFound"
+	ErrStewardNotFound            Key = "error.stewardNotFound"
+	ErrPrivacyRequestNotFound     Key = "error.privacyRequestNotFound"
+	ErrPicketSiteNotFound         Key = "error.picketSiteNotFound"
+	ErrDuesScheduleNotFound       Key = "error.duesScheduleNotFound"
+	ErrDeletedUsersFind           Key = "error.deletedUsersFind"
+	ErrStatusTimelineLoad         Key = "error.statusTimelineLoad"
+	ErrPrivacyRequestsLoad        Key = "error.privacyRequestsLoad"
+	ErrUserErase                  Key = "error.userErase"
+	ErrStatusHistoryErase         Key = "error.statusHistoryErase"
+	ErrApplicationErase           Key = "error.applicationErase"
+	ErrLoginEventsErase           Key = "error.loginEventsErase"
+	ErrMilestoneRuleDelete        Key = "error.milestoneRuleDelete"
+	ErrCampaignCreate             Key = "error.campaignCreate"
+	ErrLoginEventsCount           Key = "error.loginEventsCount"
+	ErrExportCollect              Key = "error.exportCollect"
+	ErrStatusConflict             Key = "error.statusConflict"
+	ErrExportBuild                Key = "error.exportBuild"
+	ErrStewardAssign              Key = "error.stewardAssign"
+	ErrDuesAssess                 Key = "error.duesAssess"
+	ErrPaymentsApprove            Key = "error.paymentsApprove"
+	ErrContentAnonymise           Key = "error.contentAnonymise"
+	ErrHeaderRowRequired          Key = "error.headerRowRequired"
+	ErrCheckInWindow              Key = "error.checkInWindow"
+	ErrCapacityMin                Key = "error.capacityMin"
+	ErrStatusTransition           Key = "error.statusTransition"
+	ErrArchiveStorageMissing      Key = "error.archiveStorageMissing"
+	ErrStatusEffectiveDate        Key = "error.statusEffectiveDate"
+	ErrAdjustmentZero             Key = "error.adjustmentZero"
+	ErrShiftOrder                 Key = "error.shiftOrder"
+	ErrStatusReason               Key = "error.statusReason"
+	ErrDuesPercentage             Key = "error.duesPercentage"
+	ErrPaymentPositive            Key = "error.paymentPositive"
+	ErrMilestoneCriteria          Key = "error.milestoneCriteria"
+	ErrDuesFlatAmount             Key = "error.duesFlatAmount"
+	ErrOutsideStrikePeriod        Key = "error.outsideStrikePeriod"
+	ErrStewardStatus              Key = "error.stewardStatus"
+	ErrEmailInvalid               Key = "error.emailInvalid"
+	ErrMessageOverridesLoad       Key = "error.messageOverridesLoad"
+	ErrMessageKeyUnknown          Key = "error.messageKeyUnknown"
+	ErrTextRequired               Key = "error.textRequired"
+	ErrMessagePlaceholders        Key = "error.messagePlaceholders"
+	ErrMessageOverrideRemove      Key = "error.messageOverrideRemove"
+)
+
+// Email subjects
+const (
+	SubjectPasswordReset     Key = "subject.passwordReset"
+	SubjectEmailVerification Key = "subject.emailVerification"
+	SubjectShiftReminder     Key = "subject.shiftReminder"
+	SubjectDuesStatement     Key = "subject.duesStatement"
+	SubjectMilestoneReport   Key = "subject.milestoneReport"
+)
+
+// messages are the translations of every key, by locale. English has them all.
+var messages = map[string]map[Key]string{
+	English: {
+		ErrUnionRequired:              "unionID is required",
+		ErrUserAndUnionRequired:       "userID and unionID both are required",
+		ErrMemberAndUnionRequired:     "memberID and unionID both are required",
+		ErrUserNotFound:               "could not find user",
+		ErrMemberNotFound:             "could not find member",
+		ErrUsernameNotFound:           "could not find user with username %v",
+		ErrApproveStatus:              "cannot approve a member with status %s",
+		ErrUserUpdate:                 "could not update User",
+		ErrUserDelete:                 "failed to delete user due to %v",
+		ErrPasswordCriteria:           "password doesn't match the required criteria",
+		ErrPasswordInvalid:            "the password is invalid, please try with correct password",
+		ErrCannotSignIn:               "this membership cannot sign in, please contact your union",
+		ErrSessionExpired:             "session expired please login again",
+		ErrResetKeyNotFound:           "could not find user with passwordResetKey",
+		ErrResetExpired:               "password reset expired",
+		ErrResetRequest:               "could not complete request for password reset please try after some time or contact Admin",
+		ErrAuthRequired:               "authentication required",
+		ErrStaffOnly:                  "only staff can do this",
+		ErrNoEmailToVerify:            "the profile has no email address to verify",
+		ErrVerificationCode:           "could not create verification code",
+		ErrVerificationStart:          "could not start email verification",
+		ErrVerificationSend:           "could not send the verification email please try after some time",
+		ErrVerificationExpired:        "verification code expired, please request a new one",
+		ErrVerificationInvalid:        "verification code is invalid",
+		ErrVerificationChanged:        "the profile email changed, please request a new code",
+		ErrVerificationFailed:         "could not verify email",
+		ErrNoMembership:               "you have no active membership in this union",
+		ErrMembershipsLoad:            "could not load memberships",
+		ErrEmployeeIDRequired:         "employeeID is required",
+		ErrEmployeeIDLookup:           "could not look up employee id",
+		ErrUnknownLocale:              "unsupported locale %s",
+		ErrUnionNotFound:              "could not find union",
+		ErrEmailDomainBanned:          "email addresses at %s cannot register with this union",
+		ErrEmailDomainRequired:        "please register with an email address at %s",
+		ErrRegistrationUnverified:     "the applicant has not confirmed their email address yet",
+		ErrOwnAccountOnly:             "you can only do this for your own account",
+		ErrVerificationLocked:         "too many wrong codes, please request a new one",
+		ErrUnionAdminOnly:             "only the union's admins can do this",
+		ErrServiceOnly:                "only backend services can do this",
+		ErrProvisionedUser:            "%s is not a provisioned user",
+		ErrVerificationThrottled:      "a code was sent a moment ago, please wait a minute before asking for another",
+		ErrIDAndUnionRequired:         "unionID and id both are required",
+		ErrShiftNotFound:              "could not find shift",
+		ErrMembersLoad:                "could not load members: %v",
+		ErrShiftMemberRequired:        "unionID, shiftID and userID are required",
+		ErrStrikeAndUnionRequired:     "unionID and strikeID both are required",
+		ErrPeriodFormat:               "period must be formatted YYYY-MM",
+		ErrNameRequired:               "name is required",
+		ErrRangeOrder:                 "to cannot be before from",
+		ErrFileStorageMissing:         "file storage is not configured",
+		ErrExportUpload:               "could not upload export: %v",
+		ErrDuesSchedulesLoad:          "could not load dues schedules: %v",
+		ErrStrikePeriodNotFound:       "could not find strike period",
+		ErrStewardAssignmentNotFound:  "could not find steward assignment",
+		ErrRemittanceFormatNotFound:   "could not find remittance format",
+		ErrMilestoneRuleNotFound:      "could not find milestone rule",
+		ErrUserErased:                 "user has already been erased",
+		ErrPrivacyRequestType:         "unknown privacy request type %q",
+		ErrMemberStatusUnknown:        "unknown member status %q",
+		ErrSwapRequired:               "unionID, shiftID, fromUserID and toUserID are required",
+		ErrSwapResponseRequired:       "unionID, id and userID are required",
+		ErrRuleAndUnionRequired:       "unionID and ruleID both are required",
+		ErrFormatAndUnionRequired:     "unionID and formatID both are required",
+		ErrDuesEntryType:              "type must be %s or %s",
+		ErrRemittanceImported:         "this file was already imported for %s on %s",
+		ErrNoApprovedPayments:         "there are no approved payments to export",
+		ErrSwapAnswered:               "the swap request was already %s",
+		ErrSwapUnavailable:            "the swap is no longer possible",
+		ErrShiftFull:                  "the shift is full or the member is already on it",
+		ErrShiftWorkShift:             "the shift is for members working the %s shift",
+		ErrShiftZone:                  "the shift is for members of zone %s",
+		ErrShiftStarted:               "the shift has already started",
+		ErrShiftNotSignedUp:           "the member is not signed up for this shift",
+		ErrNotCheckedIn:               "the member is not checked in",
+		ErrShiftOverlap:               "the member is already signed up for a shift at that time",
+		ErrAlreadyCheckedIn:           "the member is already checked in",
+		ErrMemberNoEmail:              "the member has no email address",
+		ErrFileTooLarge:               "the file is larger than %d MB",
+		ErrSubjectAndContentRequired:  "subject and content both are required",
+		ErrStrikePeriodClosed:         "strike period %s is closed",
+		ErrStrikePayRulesMissing:      "strike period %s has no pay rules",
+		ErrStrikePayRulesNegative:     "strike pay rules cannot be negative",
+		ErrStewardRequired:            "stewardID is required",
+		ErrShiftFieldsRequired:        "siteID, start, end and capacity are required",
+		ErrScopeField:                 "scopeField must be unit, location, department or employmentType",
+		ErrPicketSiteInactive:         "picket site %s is not active",
+		ErrPhotoStorageMissing:        "photo storage is not configured",
+		ErrRemittanceRecord:           "payments were posted but the import could not be recorded: %v",
+		ErrCheckInNotAllowed:          "only the member, a captain of the shift or a union admin can check members in and out",
+		ErrSwapRecipientOnly:          "only the member asked can respond to a swap",
+		ErrWithdrawNotAllowed:         "only members signed up and not checked in can withdraw",
+		ErrSwapNotAllowed:             "only members signed up and not checked in can swap",
+		ErrNotifyDaysAhead:            "notifyDaysAhead must be between 0 and 366",
+		ErrStrikeFieldsRequired:       "name, startDate and rules are required",
+		ErrDuesScheduleFieldsRequired: "name, method and effectiveFrom are required",
+		ErrDuesLimitsNegative:         "minAmount, maxAmount and gracePeriods cannot be negative",
+		ErrMilestoneNegative:          "minAge and minServiceYears cannot be negative",
+		ErrDuesMethod:                 "method must be %s or %s",
+		ErrCardInactive:               "membership card is only available for active members",
+		ErrPicketStatus:               "members with status %s cannot picket",
+		ErrDuesMaxBelowMin:            "maxAmount cannot be below minAmount",
+		ErrMatchField:                 "matchField must be %s, %s or %s",
+		ErrSiteKind:                   "kind must be %s or %s",
+		ErrRemittanceColumnsRequired:  "key and amount columns are required",
+		ErrHoursRange:                 "hours must be between 0 and 24",
+		ErrRemittanceLayout:           "headerRows cannot be negative and impliedDecimals must be between 0 and 4",
+		ErrFixedWidthColumn:           "fixed-width columns need a start and a length",
+		ErrExportMark:                 "export uploaded to %s but payments could not be marked exported: %v",
+		ErrEndBeforeStart:             "endDate cannot be before startDate",
+		ErrEffectiveToFormat:          "effectiveTo must be formatted YYYY-MM",
+		ErrEffectiveOrder:             "effectiveTo cannot be before effectiveFrom",
+		ErrEffectiveFromFormat:        "effectiveFrom must be formatted YYYY-MM",
+		ErrDelimiter:                  "delimiter must be a single character",
+		ErrDaysPerWeek:                "days per week must be between 0 and 7",
+		ErrDaysPositive:               "days must be positive",
+		ErrCSVColumn:                  "csv column index cannot be negative",
+		ErrCoverageValue:              "coverage of %s needs a value",
+		ErrCoverageDuplicate:          "coverage of %s is given twice",
+		ErrCoverageField:              "coverage field must be unit, department, zone or shift",
+		ErrStewardAssignmentUpdate:    "could not update steward assignment",
+		ErrShiftCapacity:              "could not update shift, capacity cannot be below the members signed up",
+		ErrPhotoUpdate:                "could not update profile photo",
+		ErrPicketSiteUpdate:           "could not update picket site",
+		ErrExportStore:                "could not store export archive: %v",
+		ErrDeletedUsersStamp:          "could not stamp deleted users: %v",
+		ErrCardSign:                   "could not sign membership card",
+		ErrStatementSend:              "could not send the statement please try after some time",
+		ErrPaymentSave:                "could not save payment: %v",
+		ErrStewardAssignmentRemove:    "could not remove steward assignment: %v",
+		ErrPhotoRemove:                "could not remove profile photo",
+		ErrPrivacyRequestRecord:       "could not record privacy request",
+		ErrDuesEntryRecord:            "could not record dues entry",
+		ErrFileRead:                   "could not read the file: %v",
+		ErrLoginEventsPurge:           "could not purge login events: %v",
+		ErrDeletedUsersPurge:          "could not purge deleted users: %v",
+		ErrStewardsLoad:               "could not load stewards: %v",
+		ErrStewardAssignmentsLoad:     "could not load steward assignments: %v",
+		ErrPaymentsLoad:               "could not load payments: %v",
+		ErrInactiveMembersLoad:        "could not load inactive members: %v",
+		ErrDuesLedgerLoad:             "could not load dues ledger",
+		ErrDuesAssessedLoad:           "could not load dues assessed: %v",
+		ErrAttendanceLoad:             "could not load attendance: %v",
+		ErrArrearsLoad:                "could not load arrears: %v",
+		ErrSwapNotFound:               "could not find swap request",
+		ErrStewardNotFound:            "could not find steward",
+		ErrPrivacyRequestNotFound:     "could not find privacy request",
+		ErrPicketSiteNotFound:         "could not find picket site",
+		ErrDuesScheduleNotFound:       "could not find dues schedule",
+		ErrDeletedUsersFind:           "could not find deleted users: %v",
+		ErrStatusTimelineLoad:         "could not fetch status timeline",
+		ErrPrivacyRequestsLoad:        "could not fetch privacy requests",
+		ErrUserErase:                  "could not erase user: %v",
+		ErrStatusHistoryErase:         "could not erase status history: %v",
+		ErrApplicationErase:           "could not erase membership application: %v",
+		ErrLoginEventsErase:           "could not erase login events: %v",
+		ErrMilestoneRuleDelete:        "could not delete milestone rule: %v",
+		ErrCampaignCreate:             "could not create campaign: %v",
+		ErrLoginEventsCount:           "could not count login events: %v",
+		ErrExportCollect:              "could not collect %s: %v",
+		ErrStatusConflict:             "could not change status, it may have been changed meanwhile",
+		ErrExportBuild:                "could not build export archive: %v",
+		ErrStewardAssign:              "could not assign steward: %v",
+		ErrDuesAssess:                 "could not assess dues: %v",
+		ErrPaymentsApprove:            "could not approve payments: %v",
+		ErrContentAnonymise:           "could not anonymise cms content: %v",
+		ErrHeaderRowRequired:          "columns found by header need a header row",
+		ErrCheckInWindow:              "check-in opens an hour before the shift and closes at its end",
+		ErrCapacityMin:                "capacity must be at least 1",
+		ErrStatusTransition:           "cannot change status from %s to %s",
+		ErrArchiveStorageMissing:      "archive storage is not configured",
+		ErrStatusEffectiveDate:        "an effective date is required to change status to %s",
+		ErrAdjustmentZero:             "an adjustment cannot be zero",
+		ErrShiftOrder:                 "a shift must end after it starts",
+		ErrStatusReason:               "a reason is required to change status to %s",
+		ErrDuesPercentage:             "a percentage schedule needs a percentage between 0 and 100",
+		ErrPaymentPositive:            "a payment must be a positive amount",
+		ErrMilestoneCriteria:          "a milestone rule needs a minimum age or years of service",
+		ErrDuesFlatAmount:             "a flat schedule needs a positive amount",
+		ErrOutsideStrikePeriod:        "%s is outside the strike period",
+		ErrStewardStatus:              "%s %s cannot be a steward while %s",
+		ErrEmailInvalid:               "%q is not an email address",
+		ErrMessageOverridesLoad:       "could not load message overrides: %v",
+		ErrMessageKeyUnknown:          "unknown message key %q",
+		ErrTextRequired:               "text is required",
+		ErrMessagePlaceholders:        "text must have %d placeholders like the original",
+		ErrMessageOverrideRemove:      "could not remove message override: %v",
+
+		SubjectPasswordReset:     "Request Password Reset",
+		SubjectEmailVerification: "Confirm your email address",
+		SubjectShiftReminder:     "Picket shift reminder",
+		SubjectDuesStatement:     "Your dues statement",
+		SubjectMilestoneReport:   "%s: %d upcoming",
+	},
+	French: {
+		ErrUnionRequired:              "unionID est obligatoire",
+		ErrUserAndUnionRequired:       "userID et unionID sont tous deux obligatoires",
+		ErrMemberAndUnionRequired:     "memberID et unionID sont tous deux obligatoires",
+		ErrUserNotFound:               "utilisateur introuvable",
+		ErrMemberNotFound:             "membre introuvable",
+		ErrUsernameNotFound:           "aucun utilisateur avec le nom d'utilisateur %v",
+		ErrApproveStatus:              "impossible d'approuver un membre au statut %s",
+		ErrUserUpdate:                 "impossible de mettre à jour l'utilisateur",
+		ErrUserDelete:                 "la suppression de l'utilisateur a échoué : %v",
+		ErrPasswordCriteria:           "le mot de passe ne respecte pas les critères requis",
+		ErrPasswordInvalid:            "le mot de passe est invalide, veuillez réessayer avec le bon mot de passe",
+		ErrCannotSignIn:               "cette adhésion ne permet pas de se connecter, veuillez communiquer avec votre syndicat",
+		ErrSessionExpired:             "session expirée, veuillez vous reconnecter",
+		ErrResetKeyNotFound:           "lien de réinitialisation introuvable",
+		ErrResetExpired:               "la réinitialisation du mot de passe a expiré",
+		ErrResetRequest:               "impossible de traiter la demande de réinitialisation, veuillez réessayer plus tard ou communiquer avec l'administrateur",
+		ErrAuthRequired:               "authentification requise",
+		ErrStaffOnly:                  "réservé au personnel",
+		ErrNoEmailToVerify:            "le profil n'a aucune adresse courriel à vérifier",
+		ErrVerificationCode:           "impossible de créer le code de vérification",
+		ErrVerificationStart:          "impossible de lancer la vérification du courriel",
+		ErrVerificationSend:           "impossible d'envoyer le courriel de vérification, veuillez réessayer plus tard",
+		ErrVerificationExpired:        "le code de vérification a expiré, veuillez en demander un nouveau",
+		ErrVerificationInvalid:        "le code de vérification est invalide",
+		ErrVerificationChanged:        "le courriel du profil a changé, veuillez demander un nouveau code",
+		ErrVerificationFailed:         "impossible de vérifier le courriel",
+		ErrNoMembership:               "vous n'avez aucune adhésion active dans ce syndicat",
+		ErrMembershipsLoad:            "impossible de charger les adhésions",
+		ErrEmployeeIDRequired:         "employeeID est obligatoire",
+		ErrEmployeeIDLookup:           "impossible de rechercher le numéro d'employé",
+		ErrUnknownLocale:              "langue non prise en charge : %s",
+		ErrUnionNotFound:              "syndicat introuvable",
+		ErrEmailDomainBanned:          "les adresses courriel de %s ne peuvent pas s'inscrire auprès de ce syndicat",
+		ErrEmailDomainRequired:        "veuillez vous inscrire avec une adresse courriel de %s",
+		ErrRegistrationUnverified:     "le demandeur n'a pas encore confirmé son adresse courriel",
+		ErrOwnAccountOnly:             "vous ne pouvez faire ceci que pour votre propre compte",
+		ErrVerificationLocked:         "trop de codes erronés, veuillez en demander un nouveau",
+		ErrUnionAdminOnly:             "réservé aux administrateurs du syndicat",
+		ErrServiceOnly:                "réservé aux services internes",
+		ErrProvisionedUser:            "%s n'est pas un utilisateur de provisionnement",
+		ErrVerificationThrottled:      "un code vient d'être envoyé, veuillez patienter une minute avant d'en demander un autre",
+		ErrIDAndUnionRequired:         "unionID et id sont tous deux obligatoires",
+		ErrShiftNotFound:              "quart introuvable",
+		ErrMembersLoad:                "impossible de charger les membres : %v",
+		ErrShiftMemberRequired:        "unionID, shiftID et userID sont obligatoires",
+		ErrStrikeAndUnionRequired:     "unionID et strikeID sont tous deux obligatoires",
+		ErrPeriodFormat:               "la période doit être au format AAAA-MM",
+		ErrNameRequired:               "le nom est obligatoire",
+		ErrRangeOrder:                 "la date de fin ne peut pas précéder la date de début",
+		ErrFileStorageMissing:         "le stockage de fichiers n'est pas configuré",
+		ErrExportUpload:               "impossible de téléverser l'exportation : %v",
+		ErrDuesSchedulesLoad:          "impossible de charger les barèmes de cotisations : %v",
+		ErrStrikePeriodNotFound:       "période de grève introuvable",
+		ErrStewardAssignmentNotFound:  "affectation de délégué introuvable",
+		ErrRemittanceFormatNotFound:   "format de remise introuvable",
+		ErrMilestoneRuleNotFound:      "règle d'étape introuvable",
+		ErrUserErased:                 "l'utilisateur a déjà été effacé",
+		ErrPrivacyRequestType:         "type de demande de confidentialité inconnu : %q",
+		ErrMemberStatusUnknown:        "statut de membre inconnu : %q",
+		ErrSwapRequired:               "unionID, shiftID, fromUserID et toUserID sont obligatoires",
+		ErrSwapResponseRequired:       "unionID, id et userID sont obligatoires",
+		ErrRuleAndUnionRequired:       "unionID et ruleID sont tous deux obligatoires",
+		ErrFormatAndUnionRequired:     "unionID et formatID sont tous deux obligatoires",
+		ErrDuesEntryType:              "le type doit être %s ou %s",
+		ErrRemittanceImported:         "ce fichier a déjà été importé pour %s le %s",
+		ErrNoApprovedPayments:         "il n'y a aucun paiement approuvé à exporter",
+		ErrSwapAnswered:               "la demande d'échange a déjà été %s",
+		ErrSwapUnavailable:            "l'échange n'est plus possible",
+		ErrShiftFull:                  "le quart est complet ou le membre y est déjà inscrit",
+		ErrShiftWorkShift:             "le quart est réservé aux membres qui travaillent le quart %s",
+		ErrShiftZone:                  "le quart est réservé aux membres de la zone %s",
+		ErrShiftStarted:               "le quart a déjà commencé",
+		ErrShiftNotSignedUp:           "le membre n'est pas inscrit à ce quart",
+		ErrNotCheckedIn:               "le membre n'est pas pointé",
+		ErrShiftOverlap:               "le membre est déjà inscrit à un quart à ce moment",
+		ErrAlreadyCheckedIn:           "le membre est déjà pointé",
+		ErrMemberNoEmail:              "le membre n'a aucune adresse courriel",
+		ErrFileTooLarge:               "le fichier dépasse %d Mo",
+		ErrSubjectAndContentRequired:  "l'objet et le contenu sont tous deux obligatoires",
+		ErrStrikePeriodClosed:         "la période de grève %s est close",
+		ErrStrikePayRulesMissing:      "la période de grève %s n'a aucune règle de paie",
+		ErrStrikePayRulesNegative:     "les règles de paie de grève ne peuvent pas être négatives",
+		ErrStewardRequired:            "stewardID est obligatoire",
+		ErrShiftFieldsRequired:        "siteID, start, end et capacity sont obligatoires",
+		ErrScopeField:                 "scopeField doit être unit, location, department ou employmentType",
+		ErrPicketSiteInactive:         "le site de piquetage %s n'est pas actif",
+		ErrPhotoStorageMissing:        "le stockage des photos n'est pas configuré",
+		ErrRemittanceRecord:           "les paiements ont été inscrits, mais l'importation n'a pas pu être enregistrée : %v",
+		ErrCheckInNotAllowed:          "seul le membre, un capitaine du quart ou un administrateur du syndicat peut pointer l'arrivée et le départ",
+		ErrSwapRecipientOnly:          "seul le membre sollicité peut répondre à un échange",
+		ErrWithdrawNotAllowed:         "seuls les membres inscrits et non pointés peuvent se retirer",
+		ErrSwapNotAllowed:             "seuls les membres inscrits et non pointés peuvent échanger",
+		ErrNotifyDaysAhead:            "notifyDaysAhead doit être entre 0 et 366",
+		ErrStrikeFieldsRequired:       "name, startDate et rules sont obligatoires",
+		ErrDuesScheduleFieldsRequired: "name, method et effectiveFrom sont obligatoires",
+		ErrDuesLimitsNegative:         "minAmount, maxAmount et gracePeriods ne peuvent pas être négatifs",
+		ErrMilestoneNegative:          "minAge et minServiceYears ne peuvent pas être négatifs",
+		ErrDuesMethod:                 "la méthode doit être %s ou %s",
+		ErrCardInactive:               "la carte de membre n'est offerte qu'aux membres actifs",
+		ErrPicketStatus:               "les membres au statut %s ne peuvent pas faire de piquetage",
+		ErrDuesMaxBelowMin:            "maxAmount ne peut pas être inférieur à minAmount",
+		ErrMatchField:                 "matchField doit être %s, %s ou %s",
+		ErrSiteKind:                   "le type doit être %s ou %s",
+		ErrRemittanceColumnsRequired:  "les colonnes de clé et de montant sont obligatoires",
+		ErrHoursRange:                 "les heures doivent être entre 0 et 24",
+		ErrRemittanceLayout:           "headerRows ne peut pas être négatif et impliedDecimals doit être entre 0 et 4",
+		ErrFixedWidthColumn:           "les colonnes à largeur fixe exigent un début et une longueur",
+		ErrExportMark:                 "l'exportation a été téléversée vers %s, mais les paiements n'ont pas pu être marqués comme exportés : %v",
+		ErrEndBeforeStart:             "endDate ne peut pas précéder startDate",
+		ErrEffectiveToFormat:          "effectiveTo doit être au format AAAA-MM",
+		ErrEffectiveOrder:             "effectiveTo ne peut pas précéder effectiveFrom",
+		ErrEffectiveFromFormat:        "effectiveFrom doit être au format AAAA-MM",
+		ErrDelimiter:                  "le délimiteur doit être un seul caractère",
+		ErrDaysPerWeek:                "les jours par semaine doivent être entre 0 et 7",
+		ErrDaysPositive:               "le nombre de jours doit être positif",
+		ErrCSVColumn:                  "l'index de colonne csv ne peut pas être négatif",
+		ErrCoverageValue:              "la couverture %s exige une valeur",
+		ErrCoverageDuplicate:          "la couverture %s est donnée deux fois",
+		ErrCoverageField:              "le champ de couverture doit être unit, department, zone ou shift",
+		ErrStewardAssignmentUpdate:    "impossible de mettre à jour l'affectation de délégué",
+		ErrShiftCapacity:              "impossible de mettre à jour le quart, la capacité ne peut pas être inférieure au nombre d'inscrits",
+		ErrPhotoUpdate:                "impossible de mettre à jour la photo de profil",
+		ErrPicketSiteUpdate:           "impossible de mettre à jour le site de piquetage",
+		ErrExportStore:                "impossible d'enregistrer l'archive d'exportation : %v",
+		ErrDeletedUsersStamp:          "impossible d'horodater les utilisateurs supprimés : %v",
+		ErrCardSign:                   "impossible de signer la carte de membre",
+		ErrStatementSend:              "impossible d'envoyer le relevé, veuillez réessayer plus tard",
+		ErrPaymentSave:                "impossible d'enregistrer le paiement : %v",
+		ErrStewardAssignmentRemove:    "impossible de retirer l'affectation de délégué : %v",
+		ErrPhotoRemove:                "impossible de retirer la photo de profil",
+		ErrPrivacyRequestRecord:       "impossible d'enregistrer la demande de confidentialité",
+		ErrDuesEntryRecord:            "impossible d'enregistrer l'écriture de cotisation",
+		ErrFileRead:                   "impossible de lire le fichier : %v",
+		ErrLoginEventsPurge:           "impossible de purger les événements de connexion : %v",
+		ErrDeletedUsersPurge:          "impossible de purger les utilisateurs supprimés : %v",
+		ErrStewardsLoad:               "impossible de charger les délégués : %v",
+		ErrStewardAssignmentsLoad:     "impossible de charger les affectations de délégués : %v",
+		ErrPaymentsLoad:               "impossible de charger les paiements : %v",
+		ErrInactiveMembersLoad:        "impossible de charger les membres inactifs : %v",
+		ErrDuesLedgerLoad:             "impossible de charger le registre des cotisations",
+		ErrDuesAssessedLoad:           "impossible de charger les cotisations imposées : %v",
+		ErrAttendanceLoad:             "impossible de charger les présences : %v",
+		ErrArrearsLoad:                "impossible de charger les arriérés : %v",
+		ErrSwapNotFound:               "demande d'échange introuvable",
+		ErrStewardNotFound:            "délégué introuvable",
+		ErrPrivacyRequestNotFound:     "demande de confidentialité introuvable",
+		ErrPicketSiteNotFound:         "site de piquetage introuvable",
+		ErrDuesScheduleNotFound:       "barème de cotisations introuvable",
+		ErrDeletedUsersFind:           "impossible de trouver les utilisateurs supprimés : %v",
+		ErrStatusTimelineLoad:         "impossible de charger l'historique des statuts",
+		ErrPrivacyRequestsLoad:        "impossible de charger les demandes de confidentialité",
+		ErrUserErase:                  "impossible d'effacer l'utilisateur : %v",
+		ErrStatusHistoryErase:         "impossible d'effacer l'historique des statuts : %v",
+		ErrApplicationErase:           "impossible d'effacer la demande d'adhésion : %v",
+		ErrLoginEventsErase:           "impossible d'effacer les événements de connexion : %v",
+		ErrMilestoneRuleDelete:        "impossible de supprimer la règle d'étape : %v",
+		ErrCampaignCreate:             "impossible de créer la campagne : %v",
+		ErrLoginEventsCount:           "impossible de compter les événements de connexion : %v",
+		ErrExportCollect:              "impossible de recueillir %s : %v",
+		ErrStatusConflict:             "impossible de changer le statut, il a peut-être été modifié entre-temps",
+		ErrExportBuild:                "impossible de créer l'archive d'exportation : %v",
+		ErrStewardAssign:              "impossible d'affecter le délégué : %v",
+		ErrDuesAssess:                 "impossible d'imposer les cotisations : %v",
+		ErrPaymentsApprove:            "impossible d'approuver les paiements : %v",
+		ErrContentAnonymise:           "impossible d'anonymiser le contenu du cms : %v",
+		ErrHeaderRowRequired:          "les colonnes repérées par en-tête exigent une ligne d'en-tête",
+		ErrCheckInWindow:              "le pointage ouvre une heure avant le quart et ferme à sa fin",
+		ErrCapacityMin:                "la capacité doit être d'au moins 1",
+		ErrStatusTransition:           "impossible de passer du statut %s au statut %s",
+		ErrArchiveStorageMissing:      "le stockage des archives n'est pas configuré",
+		ErrStatusEffectiveDate:        "une date d'effet est obligatoire pour passer au statut %s",
+		ErrAdjustmentZero:             "un ajustement ne peut pas être nul",
+		ErrShiftOrder:                 "un quart doit finir après son début",
+		ErrStatusReason:               "une raison est obligatoire pour passer au statut %s",
+		ErrDuesPercentage:             "un barème en pourcentage exige un pourcentage entre 0 et 100",
+		ErrPaymentPositive:            "un paiement doit être un montant positif",
+		ErrMilestoneCriteria:          "une règle d'étape exige un âge minimum ou des années de service",
+		ErrDuesFlatAmount:             "un barème fixe exige un montant positif",
+		ErrOutsideStrikePeriod:        "%s est en dehors de la période de grève",
+		ErrStewardStatus:              "%s %s ne peut pas être délégué tant que son statut est %s",
+		ErrEmailInvalid:               "%q n'est pas une adresse courriel",
+		ErrMessageOverridesLoad:       "impossible de charger les messages personnalisés : %v",
+		ErrMessageKeyUnknown:          "clé de message inconnue : %q",
+		ErrTextRequired:               "le texte est obligatoire",
+		ErrMessagePlaceholders:        "le texte doit avoir %d paramètres comme l'original",
+		ErrMessageOverrideRemove:      "impossible de retirer le message personnalisé : %v",
+
+		SubjectPasswordReset:     "Demande de réinitialisation du mot de passe",
+		SubjectEmailVerification: "Confirmez votre adresse courriel",
+		SubjectShiftReminder:     "Rappel de quart de piquetage",
+		SubjectDuesStatement:     "Votre relevé de cotisations",
+		SubjectMilestoneReport:   "%s : %d à venir",
+	},
+}
+
+// Keys returns every key of the catalogue
+func Keys() []Key {
+	keys := make([]Key, 0, len(messages[DefaultLocale]))
+	for key := range messages[DefaultLocale] {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Known reports whether key is in the catalogue
+func Known(key Key) bool {
+	_, ok := messages[DefaultLocale][key]
+	return ok
+}
+
+// Text returns the unformatted message of key in locale: a union's override first,
+// then the translation, then English
+func Text(locale string, key Key, overrides map[string]string) string {
+	if text, ok := overrides[string(key)]; ok && text != "" {
+		return text
+	}
+	if text, ok := messages[locale][key]; ok {
+		return text
+	}
+	if text, ok := messages[DefaultLocale][key]; ok {
+		return text
+	}
+	return string(key)
+}
+
+// Message formats key in locale with args
+func Message(locale string, key Key, overrides map[string]string, args ...interface{}) string {
+	text := Text(locale, key, overrides)
+	if len(args) == 0 {
+		return text
+	}
+	return fmt.Sprintf(text, args...)
+}
+
+// Placeholders counts the formatting verbs of a message, so an override can be
+// checked against the text it replaces
+func Placeholders(text string) int {
+	return strings.Count(text, "%") - 2*strings.Count(text, "%%")
+}
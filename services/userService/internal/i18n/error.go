@@ -0,0 +1,31 @@
+package i18n
+
+import "errors"
+
+// Error is an API error that is translated when it reaches the caller
+type Error struct {
+	Key  Key
+	Args []interface{}
+}
+
+// Errorf returns the error of key formatted with args
+func Errorf(key Key, args ...interface{}) error {
+	return &Error{Key: key, Args: args}
+}
+
+// Error renders the message in English, for logs
+func (e *Error) Error() string {
+	return Message(DefaultLocale, e.Key, nil, e.Args...)
+}
+
+// Localize renders the message in locale
+func (e *Error) Localize(locale string, overrides map[string]string) string {
+	return Message(locale, e.Key, overrides, e.Args...)
+}
+
+// AsError returns the catalogue error err wraps, if any
+func AsError(err error) (*Error, bool) {
+	var e *Error
+	ok := errors.As(err, &e)
+	return e, ok
+}
@@ -0,0 +1,105 @@
+// Package i18n holds the translated messages of userService and picks the locale a
+// caller is answered in
+package i18n
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Supported locales
+const (
+	English = "en"
+	French  = "fr"
+)
+
+// DefaultLocale answers callers whose locale is unknown
+const DefaultLocale = English
+
+type contextKey string
+
+const localeContextKey contextKey = "locale"
+
+// Locales returns the supported locales
+func Locales() []string {
+	return []string{English, French}
+}
+
+// Normalize reduces a language tag such as "fr-CA" to a supported locale, or ""
+func Normalize(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i > 0 {
+		tag = tag[:i]
+	}
+	if _, ok := messages[tag]; ok {
+		return tag
+	}
+	return ""
+}
+
+// Negotiate picks the supported locale preferred by an Accept-Language header, or ""
+func Negotiate(acceptLanguage string) string {
+	type choice struct {
+		locale string
+		q      float64
+	}
+	choices := []choice{}
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		fields := strings.Split(part, ";")
+		locale := Normalize(fields[0])
+		if locale == "" {
+			continue
+		}
+		q := 1.0
+		for _, param := range fields[1:] {
+			if value, ok := strings.CutPrefix(strings.TrimSpace(param), "q="); ok {
+				if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+					q = parsed
+				}
+			}
+		}
+		if q > 0 {
+			choices = append(choices, choice{locale, q})
+		}
+	}
+	if len(choices) == 0 {
+		return ""
+	}
+	sort.SliceStable(choices, func(i, j int) bool { return choices[i].q > choices[j].q })
+	return choices[0].locale
+}
+
+// Pick returns the first supported locale among preferences, or the default
+func Pick(preferences ...string) string {
+	for _, preference := range preferences {
+		if locale := Normalize(preference); locale != "" {
+			return locale
+		}
+	}
+	return DefaultLocale
+}
+
+// WithLocale stores the locale a request asked for
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeContextKey, locale)
+}
+
+// FromContext returns the locale the request asked for through Accept-Language, or ""
+func FromContext(ctx context.Context) string {
+	locale, _ := ctx.Value(localeContextKey).(string)
+	return locale
+}
+
+// Middleware puts the locale negotiated from the Accept-Language header into the
+// request context
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if locale := Negotiate(r.Header.Get("Accept-Language")); locale != "" {
+			r = r.WithContext(WithLocale(r.Context(), locale))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,206 @@
+// Package remittance reads employer payroll deduction files
+package remittance
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"younified-backend/contracts/user/model"
+)
+
+// MaxFileBytes bounds the size of a remittance file
+const MaxFileBytes = 10 << 20
+
+// Parse reads the deduction lines of a remittance file. Lines that cannot be read are
+// returned as errors so the rest of the file is still imported.
+func Parse(r io.Reader, format *model.RemittanceFormat) ([]*model.RemittanceLine, []*model.RemittanceLineError, error) {
+	if format.Key == nil || format.Amount == nil {
+		return nil, nil, errors.New("the format needs a key and an amount column")
+	}
+	switch format.Kind {
+	case model.RemittanceCSV:
+		return parseCSV(r, format)
+	case model.RemittanceFixedWidth:
+		return parseFixedWidth(r, format)
+	}
+	return nil, nil, fmt.Errorf("unknown remittance format kind %q", format.Kind)
+}
+
+func parseCSV(r io.Reader, format *model.RemittanceFormat) ([]*model.RemittanceLine, []*model.RemittanceLineError, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	if format.Delimiter != "" {
+		reader.Comma = []rune(format.Delimiter)[0]
+	}
+
+	columns := map[*model.RemittanceColumn]int{}
+	lines := []*model.RemittanceLine{}
+	lineErrors := []*model.RemittanceLineError{}
+	row := 0
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		row++
+		if err != nil {
+			lineErrors = append(lineErrors, &model.RemittanceLineError{Line: row, Error: err.Error()})
+			continue
+		}
+		if row <= format.HeaderRows {
+			if row == format.HeaderRows {
+				if err := resolveHeaders(format, record, columns); err != nil {
+					return nil, nil, err
+				}
+			}
+			continue
+		}
+		if blank(record) {
+			continue
+		}
+		value := func(column *model.RemittanceColumn) string {
+			index, ok := columns[column]
+			if !ok {
+				index = column.Index
+			}
+			if index < 0 || index >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[index])
+		}
+		line, err := readLine(row, format, value)
+		if err != nil {
+			lineErrors = append(lineErrors, &model.RemittanceLineError{Line: row, Error: err.Error()})
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, lineErrors, nil
+}
+
+func parseFixedWidth(r io.Reader, format *model.RemittanceFormat) ([]*model.RemittanceLine, []*model.RemittanceLineError, error) {
+	scanner := bufio.NewScanner(r)
+	lines := []*model.RemittanceLine{}
+	lineErrors := []*model.RemittanceLineError{}
+	row := 0
+	for scanner.Scan() {
+		row++
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if row <= format.HeaderRows || strings.TrimSpace(text) == "" {
+			continue
+		}
+		value := func(column *model.RemittanceColumn) string {
+			start := column.Start - 1
+			if start < 0 || start >= len(text) {
+				return ""
+			}
+			end := start + column.Length
+			if column.Length <= 0 || end > len(text) {
+				end = len(text)
+			}
+			return strings.TrimSpace(text[start:end])
+		}
+		line, err := readLine(row, format, value)
+		if err != nil {
+			lineErrors = append(lineErrors, &model.RemittanceLineError{Line: row, Error: err.Error()})
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+	return lines, lineErrors, nil
+}
+
+func readLine(row int, format *model.RemittanceFormat, value func(*model.RemittanceColumn) string) (*model.RemittanceLine, error) {
+	line := &model.RemittanceLine{Line: row, Key: value(format.Key)}
+	if line.Key == "" {
+		return nil, errors.New("the member key is empty")
+	}
+	amount, err := ParseAmount(value(format.Amount), format.ImpliedDecimals)
+	if err != nil {
+		return nil, fmt.Errorf("amount: %v", err)
+	}
+	line.Amount = amount
+	if format.Earnings != nil {
+		if raw := value(format.Earnings); raw != "" {
+			earnings, err := ParseAmount(raw, format.ImpliedDecimals)
+			if err != nil {
+				return nil, fmt.Errorf("earnings: %v", err)
+			}
+			line.Earnings = earnings
+		}
+	}
+	if format.MemberName != nil {
+		line.Name = value(format.MemberName)
+	}
+	return line, nil
+}
+
+// resolveHeaders finds the CSV columns that are named by header
+func resolveHeaders(format *model.RemittanceFormat, header []string, columns map[*model.RemittanceColumn]int) error {
+	for _, column := range []*model.RemittanceColumn{format.Key, format.Amount, format.Earnings, format.MemberName} {
+		if column == nil || column.Header == "" {
+			continue
+		}
+		found := false
+		for i, name := range header {
+			if strings.EqualFold(strings.TrimSpace(name), column.Header) {
+				columns[column] = i
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("the file has no %q column", column.Header)
+		}
+	}
+	return nil
+}
+
+// ParseAmount reads a money amount, ignoring currency signs and thousands separators.
+// Amounts without a decimal point carry impliedDecimals decimals, so "1250" with two
+// implied decimals is 12.50. Parentheses or a trailing minus mark negative amounts.
+func ParseAmount(raw string, impliedDecimals int) (float64, error) {
+	s := strings.TrimSpace(raw)
+	negative := false
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		negative = true
+		s = s[1 : len(s)-1]
+	}
+	if strings.HasSuffix(s, "-") {
+		negative = true
+		s = strings.TrimSuffix(s, "-")
+	}
+	s = strings.NewReplacer("$", "", ",", "", " ", "").Replace(s)
+	if s == "" {
+		return 0, errors.New("empty amount")
+	}
+	amount, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not an amount", raw)
+	}
+	if impliedDecimals > 0 && !strings.Contains(s, ".") {
+		amount /= math.Pow10(impliedDecimals)
+	}
+	if negative {
+		amount = -amount
+	}
+	return model.RoundCents(amount), nil
+}
+
+func blank(record []string) bool {
+	for _, field := range record {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
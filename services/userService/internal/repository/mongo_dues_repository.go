@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"younified-backend/contracts/user/model"
+	"younified-backend/providers/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	duesScheduleCollection = "dues_schedules"
+	duesLedgerCollection   = "dues_ledger"
+)
+
+type MongoDuesRepository struct {
+	dbManager *database.DBManager
+}
+
+func NewMongoDuesRepository(dbManager *database.DBManager) *MongoDuesRepository {
+	return &MongoDuesRepository{
+		dbManager: dbManager,
+	}
+}
+
+func (r *MongoDuesRepository) CreateSchedule(ctx context.Context, unionID string, schedule *model.DuesSchedule) (*model.DuesSchedule, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, duesScheduleCollection)
+	if err != nil {
+		return nil, err
+	}
+	schedule.ID = primitive.NewObjectID()
+	schedule.CreatedOn = time.Now()
+	if _, err := collection.InsertOne(ctx, schedule); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+func (r *MongoDuesRepository) UpdateSchedule(ctx context.Context, unionID string, id primitive.ObjectID, set bson.M) (*model.DuesSchedule, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, duesScheduleCollection)
+	if err != nil {
+		return nil, err
+	}
+	set["updatedOn"] = time.Now()
+	var schedule model.DuesSchedule
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	if err := collection.FindOneAndUpdate(ctx, bson.M{"_id": id}, bson.M{"$set": set}, opts).Decode(&schedule); err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+func (r *MongoDuesRepository) GetSchedule(ctx context.Context, unionID string, id primitive.ObjectID) (*model.DuesSchedule, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, duesScheduleCollection)
+	if err != nil {
+		return nil, err
+	}
+	var schedule model.DuesSchedule
+	if err := collection.FindOne(ctx, bson.M{"_id": id}).Decode(&schedule); err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+func (r *MongoDuesRepository) Schedules(ctx context.Context, unionID string) ([]*model.DuesSchedule, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, duesScheduleCollection)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.M{"effectiveFrom": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	schedules := []*model.DuesSchedule{}
+	if err := cursor.All(ctx, &schedules); err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+// AddEntry books an entry on a member's ledger
+func (r *MongoDuesRepository) AddEntry(ctx context.Context, unionID string, entry *model.DuesLedgerEntry) (*model.DuesLedgerEntry, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, duesLedgerCollection)
+	if err != nil {
+		return nil, err
+	}
+	entry.ID = primitive.NewObjectID()
+	entry.CreatedOn = time.Now()
+	if _, err := collection.InsertOne(ctx, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// AddAssessment books the assessment of a period unless the member already has one
+// for it, and reports whether it was booked
+func (r *MongoDuesRepository) AddAssessment(ctx context.Context, unionID string, entry *model.DuesLedgerEntry) (bool, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, duesLedgerCollection)
+	if err != nil {
+		return false, err
+	}
+	entry.ID = primitive.NewObjectID()
+	entry.CreatedOn = time.Now()
+	filter := bson.M{"userID": entry.UserID, "type": model.DuesEntryAssessment, "period": entry.Period}
+	result, err := collection.UpdateOne(ctx, filter, bson.M{"$setOnInsert": entry}, options.Update().SetUpsert(true))
+	if err != nil {
+		return false, err
+	}
+	return result.UpsertedCount == 1, nil
+}
+
+// Entries returns ledger entries matching filter ordered by period and booking time
+func (r *MongoDuesRepository) Entries(ctx context.Context, unionID string, filter bson.M) ([]*model.DuesLedgerEntry, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, duesLedgerCollection)
+	if err != nil {
+		return nil, err
+	}
+	sort := bson.D{{Key: "period", Value: 1}, {Key: "effectiveDate", Value: 1}, {Key: "createdOn", Value: 1}}
+	cursor, err := collection.Find(ctx, filter, options.Find().SetSort(sort))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	entries := []*model.DuesLedgerEntry{}
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// DuesMembers returns the users that can be assessed dues: not deleted and not the
+// union default user
+func (r *MongoDuesRepository) DuesMembers(ctx context.Context, unionID string) ([]*model.User, error) {
+	return r.findUsers(ctx, unionID, bson.M{"deleted": bson.M{"$ne": true}, "level": bson.M{"$ne": 5}})
+}
+
+// UsersInArrears returns the users with at least minPeriods periods in arrears
+func (r *MongoDuesRepository) UsersInArrears(ctx context.Context, unionID string, minPeriods int) ([]*model.User, error) {
+	return r.findUsers(ctx, unionID, bson.M{
+		"deleted":                     bson.M{"$ne": true},
+		"duesStanding.arrearsPeriods": bson.M{"$gte": minPeriods},
+	})
+}
+
+// SetStanding stores the recomputed standing on the user
+func (r *MongoDuesRepository) SetStanding(ctx context.Context, unionID string, userID primitive.ObjectID, standing *model.DuesStanding) error {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, userCollection)
+	if err != nil {
+		return err
+	}
+	_, err = collection.UpdateOne(ctx, bson.M{"_id": userID}, bson.M{"$set": bson.M{"duesStanding": standing}})
+	return err
+}
+
+func (r *MongoDuesRepository) findUsers(ctx context.Context, unionID string, filter bson.M) ([]*model.User, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, userCollection)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	users := []*model.User{}
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
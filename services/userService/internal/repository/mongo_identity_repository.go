@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"younified-backend/contracts/user/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const identityCollection = "identities"
+
+func membershipOf(unionID, userID primitive.ObjectID) bson.M {
+	return bson.M{"unionID": unionID, "userID": userID}
+}
+
+// LinkIdentity attaches a user record to the identity of email, moving it away from
+// any identity it was linked to before
+func (r *MongoUserRepository) LinkIdentity(ctx context.Context, email string, membership *model.IdentityMembership) (*model.Identity, error) {
+	collection := r.dbManager.GetBaseDatabase(ctx).Collection(identityCollection)
+	now := time.Now()
+	membership.LinkedOn = now
+
+	if err := r.UnlinkIdentity(ctx, membership.UnionID, membership.UserID); err != nil {
+		return nil, err
+	}
+	addToSet := bson.M{"memberships": membership}
+	if membership.EmployeeID != "" {
+		addToSet["employeeIDs"] = membership.EmployeeID
+	}
+	update := bson.M{
+		"$setOnInsert": bson.M{"createdOn": now},
+		"$set":         bson.M{"updatedOn": now},
+		"$addToSet":    addToSet,
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	var identity model.Identity
+	if err := collection.FindOneAndUpdate(ctx, bson.M{"email": email}, update, opts).Decode(&identity); err != nil {
+		return nil, err
+	}
+	return &identity, nil
+}
+
+// UnlinkIdentity removes a user record from every identity
+func (r *MongoUserRepository) UnlinkIdentity(ctx context.Context, unionID, userID primitive.ObjectID) error {
+	collection := r.dbManager.GetBaseDatabase(ctx).Collection(identityCollection)
+	_, err := collection.UpdateMany(ctx,
+		bson.M{"memberships": bson.M{"$elemMatch": membershipOf(unionID, userID)}},
+		bson.M{"$pull": bson.M{"memberships": membershipOf(unionID, userID)}, "$set": bson.M{"updatedOn": time.Now()}},
+	)
+	return err
+}
+
+// IdentityForUser returns the identity a user record is linked to, or nil
+func (r *MongoUserRepository) IdentityForUser(ctx context.Context, unionID, userID primitive.ObjectID) (*model.Identity, error) {
+	collection := r.dbManager.GetBaseDatabase(ctx).Collection(identityCollection)
+	var identity model.Identity
+	err := collection.FindOne(ctx, bson.M{"memberships": bson.M{"$elemMatch": membershipOf(unionID, userID)}}).Decode(&identity)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &identity, nil
+}
+
+func (r *MongoUserRepository) IdentitiesByEmployeeID(ctx context.Context, employeeID string) ([]*model.Identity, error) {
+	collection := r.dbManager.GetBaseDatabase(ctx).Collection(identityCollection)
+	cursor, err := collection.Find(ctx, bson.M{"employeeIDs": employeeID})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	identities := []*model.Identity{}
+	if err := cursor.All(ctx, &identities); err != nil {
+		return nil, err
+	}
+	return identities, nil
+}
+
+// UserByEmployeeID resolves an employee ID through the identity index to the first
+// user record carrying it
+func (r *MongoUserRepository) UserByEmployeeID(ctx context.Context, employeeID string) (*model.User, error) {
+	identities, err := r.IdentitiesByEmployeeID(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	for _, identity := range identities {
+		for _, membership := range identity.Memberships {
+			if membership.EmployeeID == employeeID {
+				return r.GetByID(ctx, membership.UnionID.Hex(), membership.UserID)
+			}
+		}
+	}
+	return nil, mongo.ErrNoDocuments
+}
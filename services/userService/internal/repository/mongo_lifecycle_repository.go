@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"younified-backend/contracts/user/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const statusHistoryCollection = "status_history"
+
+func (r *MongoUserRepository) RecordStatusChange(ctx context.Context, unionID string, change *model.StatusChange) (*model.StatusChange, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, statusHistoryCollection)
+	if err != nil {
+		return nil, err
+	}
+	change.ID = primitive.NewObjectID()
+	if _, err := collection.InsertOne(ctx, change); err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// StatusTimeline returns a member's status changes, oldest first
+func (r *MongoUserRepository) StatusTimeline(ctx context.Context, unionID string, userID primitive.ObjectID) ([]*model.StatusChange, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, statusHistoryCollection)
+	if err != nil {
+		return nil, err
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "effectiveDate", Value: 1}, {Key: "changedOn", Value: 1}})
+	cursor, err := collection.Find(ctx, bson.M{"userID": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	timeline := []*model.StatusChange{}
+	if err := cursor.All(ctx, &timeline); err != nil {
+		return nil, err
+	}
+	return timeline, nil
+}
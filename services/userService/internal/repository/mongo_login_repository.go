@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"younified-backend/contracts/user/model"
+	"younified-backend/providers/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	loginEventCollection = "login_events"
+	campaignCollection   = "reengagement_campaigns"
+)
+
+type MongoLoginRepository struct {
+	dbManager *database.DBManager
+}
+
+func NewMongoLoginRepository(dbManager *database.DBManager) *MongoLoginRepository {
+	return &MongoLoginRepository{
+		dbManager: dbManager,
+	}
+}
+
+// RecordLogin stores a sign in and marks the member as logged in
+func (r *MongoLoginRepository) RecordLogin(ctx context.Context, unionID string, event *model.LoginEvent) error {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, loginEventCollection)
+	if err != nil {
+		return err
+	}
+	event.ID = primitive.NewObjectID()
+	if _, err := collection.InsertOne(ctx, event); err != nil {
+		return err
+	}
+
+	users, err := r.dbManager.GetCollection(ctx, unionID, userCollection)
+	if err != nil {
+		return err
+	}
+	set := bson.M{"lastLoginDate": event.At, "loggedIn": true}
+	if event.Device != "" {
+		set["device"] = event.Device
+	}
+	_, err = users.UpdateOne(ctx, bson.M{"_id": event.UserID}, bson.M{"$set": set})
+	return err
+}
+
+// History returns a member's sign ins, newest first
+func (r *MongoLoginRepository) History(ctx context.Context, unionID string, userID primitive.ObjectID, limit int64) ([]*model.LoginEvent, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, loginEventCollection)
+	if err != nil {
+		return nil, err
+	}
+	opts := options.Find().SetSort(bson.M{"at": -1}).SetLimit(limit)
+	cursor, err := collection.Find(ctx, bson.M{"userID": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	events := []*model.LoginEvent{}
+	if err := cursor.All(ctx, &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// CountEvents counts the sign ins of the given members
+func (r *MongoLoginRepository) CountEvents(ctx context.Context, unionID string, userIDs []primitive.ObjectID) (int64, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, loginEventCollection)
+	if err != nil {
+		return 0, err
+	}
+	return collection.CountDocuments(ctx, bson.M{"userID": bson.M{"$in": userIDs}})
+}
+
+// DeleteEvents removes the sign ins of the given members
+func (r *MongoLoginRepository) DeleteEvents(ctx context.Context, unionID string, userIDs []primitive.ObjectID) (int64, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, loginEventCollection)
+	if err != nil {
+		return 0, err
+	}
+	result, err := collection.DeleteMany(ctx, bson.M{"userID": bson.M{"$in": userIDs}})
+	if err != nil {
+		return 0, err
+	}
+	return result.DeletedCount, nil
+}
+
+// Inactive returns the members who have not signed in since, including those who never did
+func (r *MongoLoginRepository) Inactive(ctx context.Context, unionID string, since time.Time) ([]*model.User, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, userCollection)
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{
+		"deleted": bson.M{"$ne": true},
+		"level":   bson.M{"$ne": 5},
+		"$or": []bson.M{
+			{"lastLoginDate": bson.M{"$lt": since}},
+			{"lastLoginDate": bson.M{"$exists": false}},
+		},
+	}
+	cursor, err := collection.Find(ctx, filter, options.Find().SetSort(bson.M{"lastLoginDate": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	users := []*model.User{}
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+func (r *MongoLoginRepository) CreateCampaign(ctx context.Context, unionID string, campaign *model.ReengagementCampaign) (*model.ReengagementCampaign, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, campaignCollection)
+	if err != nil {
+		return nil, err
+	}
+	campaign.ID = primitive.NewObjectID()
+	campaign.CreatedOn = time.Now()
+	if _, err := collection.InsertOne(ctx, campaign); err != nil {
+		return nil, err
+	}
+	return campaign, nil
+}
+
+// CompleteCampaign records how many emails of a campaign went out
+func (r *MongoLoginRepository) CompleteCampaign(ctx context.Context, unionID string, id primitive.ObjectID, sent int, failed int) error {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, campaignCollection)
+	if err != nil {
+		return err
+	}
+	update := bson.M{"$set": bson.M{"status": model.CampaignSent, "sent": sent, "failed": failed, "completedOn": time.Now()}}
+	_, err = collection.UpdateOne(ctx, bson.M{"_id": id}, update)
+	return err
+}
+
+func (r *MongoLoginRepository) Campaigns(ctx context.Context, unionID string) ([]*model.ReengagementCampaign, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, campaignCollection)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.M{"createdOn": -1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	campaigns := []*model.ReengagementCampaign{}
+	if err := cursor.All(ctx, &campaigns); err != nil {
+		return nil, err
+	}
+	return campaigns, nil
+}
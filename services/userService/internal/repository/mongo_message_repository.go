@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"younified-backend/contracts/user/model"
+	"younified-backend/providers/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const messageOverrideCollection = "message_overrides"
+
+type MongoMessageRepository struct {
+	dbManager *database.DBManager
+}
+
+func NewMongoMessageRepository(dbManager *database.DBManager) *MongoMessageRepository {
+	return &MongoMessageRepository{
+		dbManager: dbManager,
+	}
+}
+
+// SetOverride creates or replaces the union's override of key in locale
+func (r *MongoMessageRepository) SetOverride(ctx context.Context, unionID string, override *model.MessageOverride) (*model.MessageOverride, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, messageOverrideCollection)
+	if err != nil {
+		return nil, err
+	}
+	override.UpdatedOn = time.Now()
+	filter := bson.M{"locale": override.Locale, "key": override.Key}
+	update := bson.M{"$set": bson.M{"text": override.Text, "updatedBy": override.UpdatedBy, "updatedOn": override.UpdatedOn}}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	var saved model.MessageOverride
+	if err := collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&saved); err != nil {
+		return nil, err
+	}
+	return &saved, nil
+}
+
+func (r *MongoMessageRepository) RemoveOverride(ctx context.Context, unionID string, locale string, key string) error {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, messageOverrideCollection)
+	if err != nil {
+		return err
+	}
+	_, err = collection.DeleteOne(ctx, bson.M{"locale": locale, "key": key})
+	return err
+}
+
+// Overrides returns the union's overrides in locale by key
+func (r *MongoMessageRepository) Overrides(ctx context.Context, unionID string, locale string) (map[string]string, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, messageOverrideCollection)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := collection.Find(ctx, bson.M{"locale": locale})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	overrides := []*model.MessageOverride{}
+	if err := cursor.All(ctx, &overrides); err != nil {
+		return nil, err
+	}
+	texts := map[string]string{}
+	for _, override := range overrides {
+		texts[override.Key] = override.Text
+	}
+	return texts, nil
+}
@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"younified-backend/contracts/user/model"
+	"younified-backend/providers/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const milestoneRuleCollection = "milestone_rules"
+
+type MongoMilestoneRepository struct {
+	dbManager *database.DBManager
+}
+
+func NewMongoMilestoneRepository(dbManager *database.DBManager) *MongoMilestoneRepository {
+	return &MongoMilestoneRepository{
+		dbManager: dbManager,
+	}
+}
+
+func (r *MongoMilestoneRepository) CreateRule(ctx context.Context, unionID string, rule *model.MilestoneRule) (*model.MilestoneRule, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, milestoneRuleCollection)
+	if err != nil {
+		return nil, err
+	}
+	rule.ID = primitive.NewObjectID()
+	rule.CreatedOn = time.Now()
+	if _, err := collection.InsertOne(ctx, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// ReplaceRule stores an edited rule
+func (r *MongoMilestoneRepository) ReplaceRule(ctx context.Context, unionID string, rule *model.MilestoneRule) (*model.MilestoneRule, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, milestoneRuleCollection)
+	if err != nil {
+		return nil, err
+	}
+	rule.UpdatedOn = time.Now()
+	if _, err := collection.ReplaceOne(ctx, bson.M{"_id": rule.ID}, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (r *MongoMilestoneRepository) GetRule(ctx context.Context, unionID string, id primitive.ObjectID) (*model.MilestoneRule, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, milestoneRuleCollection)
+	if err != nil {
+		return nil, err
+	}
+	var rule model.MilestoneRule
+	if err := collection.FindOne(ctx, bson.M{"_id": id}).Decode(&rule); err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func (r *MongoMilestoneRepository) Rules(ctx context.Context, unionID string) ([]*model.MilestoneRule, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, milestoneRuleCollection)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.M{"name": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	rules := []*model.MilestoneRule{}
+	if err := cursor.All(ctx, &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (r *MongoMilestoneRepository) DeleteRule(ctx context.Context, unionID string, id primitive.ObjectID) error {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, milestoneRuleCollection)
+	if err != nil {
+		return err
+	}
+	_, err = collection.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
+
+// SetNotified records when the staff were last told about a rule
+func (r *MongoMilestoneRepository) SetNotified(ctx context.Context, unionID string, id primitive.ObjectID, on time.Time) error {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, milestoneRuleCollection)
+	if err != nil {
+		return err
+	}
+	_, err = collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"lastNotifiedOn": on}})
+	return err
+}
+
+// Candidates returns the members with a date of birth or start date, the only ones
+// a milestone rule can match
+func (r *MongoMilestoneRepository) Candidates(ctx context.Context, unionID string) ([]*model.User, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, userCollection)
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{
+		"deleted": bson.M{"$ne": true},
+		"level":   bson.M{"$ne": 5},
+		"$or": []bson.M{
+			{"dateOfBirth": bson.M{"$gt": time.Time{}}},
+			{"startDate": bson.M{"$gt": time.Time{}}},
+		},
+	}
+	cursor, err := collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	users := []*model.User{}
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
@@ -0,0 +1,255 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"younified-backend/contracts/user/model"
+	"younified-backend/providers/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	picketSiteCollection  = "picket_sites"
+	picketShiftCollection = "picket_shifts"
+	shiftSwapCollection   = "shift_swaps"
+)
+
+type MongoPicketRepository struct {
+	dbManager *database.DBManager
+}
+
+func NewMongoPicketRepository(dbManager *database.DBManager) *MongoPicketRepository {
+	return &MongoPicketRepository{
+		dbManager: dbManager,
+	}
+}
+
+func (r *MongoPicketRepository) CreateSite(ctx context.Context, unionID string, site *model.PicketSite) (*model.PicketSite, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, picketSiteCollection)
+	if err != nil {
+		return nil, err
+	}
+	site.ID = primitive.NewObjectID()
+	site.CreatedOn = time.Now()
+	if _, err := collection.InsertOne(ctx, site); err != nil {
+		return nil, err
+	}
+	return site, nil
+}
+
+func (r *MongoPicketRepository) UpdateSite(ctx context.Context, unionID string, id primitive.ObjectID, set bson.M) (*model.PicketSite, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, picketSiteCollection)
+	if err != nil {
+		return nil, err
+	}
+	var site model.PicketSite
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	if err := collection.FindOneAndUpdate(ctx, bson.M{"_id": id}, bson.M{"$set": set}, opts).Decode(&site); err != nil {
+		return nil, err
+	}
+	return &site, nil
+}
+
+func (r *MongoPicketRepository) GetSite(ctx context.Context, unionID string, id primitive.ObjectID) (*model.PicketSite, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, picketSiteCollection)
+	if err != nil {
+		return nil, err
+	}
+	var site model.PicketSite
+	if err := collection.FindOne(ctx, bson.M{"_id": id}).Decode(&site); err != nil {
+		return nil, err
+	}
+	return &site, nil
+}
+
+func (r *MongoPicketRepository) Sites(ctx context.Context, unionID string, filter bson.M) ([]*model.PicketSite, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, picketSiteCollection)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := collection.Find(ctx, filter, options.Find().SetSort(bson.M{"name": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	sites := []*model.PicketSite{}
+	if err := cursor.All(ctx, &sites); err != nil {
+		return nil, err
+	}
+	return sites, nil
+}
+
+func (r *MongoPicketRepository) CreateShift(ctx context.Context, unionID string, shift *model.PicketShift) (*model.PicketShift, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, picketShiftCollection)
+	if err != nil {
+		return nil, err
+	}
+	shift.ID = primitive.NewObjectID()
+	shift.CreatedOn = time.Now()
+	if shift.Signups == nil {
+		shift.Signups = []*model.ShiftSignup{}
+	}
+	if _, err := collection.InsertOne(ctx, shift); err != nil {
+		return nil, err
+	}
+	return shift, nil
+}
+
+// UpdateShift applies update to the shift when it still matches filter, returning
+// mongo.ErrNoDocuments otherwise
+func (r *MongoPicketRepository) UpdateShift(ctx context.Context, unionID string, filter bson.M, update bson.M, opts ...*options.FindOneAndUpdateOptions) (*model.PicketShift, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, picketShiftCollection)
+	if err != nil {
+		return nil, err
+	}
+	var shift model.PicketShift
+	opts = append(opts, options.FindOneAndUpdate().SetReturnDocument(options.After))
+	if err := collection.FindOneAndUpdate(ctx, filter, update, opts...).Decode(&shift); err != nil {
+		return nil, err
+	}
+	return &shift, nil
+}
+
+func (r *MongoPicketRepository) GetShift(ctx context.Context, unionID string, id primitive.ObjectID) (*model.PicketShift, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, picketShiftCollection)
+	if err != nil {
+		return nil, err
+	}
+	var shift model.PicketShift
+	if err := collection.FindOne(ctx, bson.M{"_id": id}).Decode(&shift); err != nil {
+		return nil, err
+	}
+	return &shift, nil
+}
+
+// Shifts returns the shifts matching filter in start order
+func (r *MongoPicketRepository) Shifts(ctx context.Context, unionID string, filter bson.M) ([]*model.PicketShift, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, picketShiftCollection)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := collection.Find(ctx, filter, options.Find().SetSort(bson.M{"start": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	shifts := []*model.PicketShift{}
+	if err := cursor.All(ctx, &shifts); err != nil {
+		return nil, err
+	}
+	return shifts, nil
+}
+
+// AddSignup books userID on the shift if there is room and they are not on it yet
+func (r *MongoPicketRepository) AddSignup(ctx context.Context, unionID string, shiftID primitive.ObjectID, signup *model.ShiftSignup) (*model.PicketShift, error) {
+	filter := bson.M{
+		"_id":            shiftID,
+		"signups.userID": bson.M{"$ne": signup.UserID},
+		"$expr":          bson.M{"$lt": bson.A{bson.M{"$size": "$signups"}, "$capacity"}},
+	}
+	return r.UpdateShift(ctx, unionID, filter, bson.M{"$push": bson.M{"signups": signup}})
+}
+
+func (r *MongoPicketRepository) RemoveSignup(ctx context.Context, unionID string, shiftID primitive.ObjectID, userID primitive.ObjectID) (*model.PicketShift, error) {
+	filter := bson.M{"_id": shiftID, "signups.userID": userID}
+	return r.UpdateShift(ctx, unionID, filter, bson.M{"$pull": bson.M{"signups": bson.M{"userID": userID}}})
+}
+
+// ReplaceSignup hands the sign-up of from over to the member of signup
+func (r *MongoPicketRepository) ReplaceSignup(ctx context.Context, unionID string, shiftID primitive.ObjectID, from primitive.ObjectID, signup *model.ShiftSignup) (*model.PicketShift, error) {
+	filter := bson.M{
+		"_id": shiftID,
+		"$and": bson.A{
+			bson.M{"signups": bson.M{"$elemMatch": bson.M{"userID": from, "checkedInAt": bson.M{"$exists": false}}}},
+			bson.M{"signups.userID": bson.M{"$ne": signup.UserID}},
+		},
+	}
+	opts := options.FindOneAndUpdate().SetArrayFilters(options.ArrayFilters{Filters: []interface{}{bson.M{"s.userID": from}}})
+	return r.UpdateShift(ctx, unionID, filter, bson.M{"$set": bson.M{"signups.$[s]": signup}}, opts)
+}
+
+// CheckIn stamps the check-in of a member who has not checked in yet
+func (r *MongoPicketRepository) CheckIn(ctx context.Context, unionID string, shiftID primitive.ObjectID, userID primitive.ObjectID, at time.Time) (*model.PicketShift, error) {
+	filter := bson.M{
+		"_id":     shiftID,
+		"signups": bson.M{"$elemMatch": bson.M{"userID": userID, "checkedInAt": bson.M{"$exists": false}}},
+	}
+	return r.UpdateShift(ctx, unionID, filter, bson.M{"$set": bson.M{"signups.$.checkedInAt": at}})
+}
+
+// CheckOut stamps the check-out of a member who is checked in
+func (r *MongoPicketRepository) CheckOut(ctx context.Context, unionID string, shiftID primitive.ObjectID, userID primitive.ObjectID, at time.Time) (*model.PicketShift, error) {
+	filter := bson.M{
+		"_id": shiftID,
+		"signups": bson.M{"$elemMatch": bson.M{
+			"userID":       userID,
+			"checkedInAt":  bson.M{"$exists": true},
+			"checkedOutAt": bson.M{"$exists": false},
+		}},
+	}
+	return r.UpdateShift(ctx, unionID, filter, bson.M{"$set": bson.M{"signups.$.checkedOutAt": at}})
+}
+
+func (r *MongoPicketRepository) CreateSwap(ctx context.Context, unionID string, swap *model.ShiftSwap) (*model.ShiftSwap, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, shiftSwapCollection)
+	if err != nil {
+		return nil, err
+	}
+	swap.ID = primitive.NewObjectID()
+	swap.CreatedOn = time.Now()
+	if _, err := collection.InsertOne(ctx, swap); err != nil {
+		return nil, err
+	}
+	return swap, nil
+}
+
+func (r *MongoPicketRepository) GetSwap(ctx context.Context, unionID string, id primitive.ObjectID) (*model.ShiftSwap, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, shiftSwapCollection)
+	if err != nil {
+		return nil, err
+	}
+	var swap model.ShiftSwap
+	if err := collection.FindOne(ctx, bson.M{"_id": id}).Decode(&swap); err != nil {
+		return nil, err
+	}
+	return &swap, nil
+}
+
+// Swaps returns the swap requests matching filter, newest first
+func (r *MongoPicketRepository) Swaps(ctx context.Context, unionID string, filter bson.M) ([]*model.ShiftSwap, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, shiftSwapCollection)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := collection.Find(ctx, filter, options.Find().SetSort(bson.M{"createdOn": -1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	swaps := []*model.ShiftSwap{}
+	if err := cursor.All(ctx, &swaps); err != nil {
+		return nil, err
+	}
+	return swaps, nil
+}
+
+// RespondToSwap settles a pending swap request
+func (r *MongoPicketRepository) RespondToSwap(ctx context.Context, unionID string, id primitive.ObjectID, status string) (*model.ShiftSwap, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, shiftSwapCollection)
+	if err != nil {
+		return nil, err
+	}
+	update := bson.M{"$set": bson.M{"status": status, "respondedOn": time.Now()}}
+	var swap model.ShiftSwap
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	if err := collection.FindOneAndUpdate(ctx, bson.M{"_id": id, "status": model.ShiftSwapPending}, update, opts).Decode(&swap); err != nil {
+		return nil, err
+	}
+	return &swap, nil
+}
@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"younified-backend/contracts/user/model"
+	"younified-backend/providers/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const privacyRequestCollection = "privacy_requests"
+
+type MongoPrivacyRepository struct {
+	dbManager *database.DBManager
+}
+
+func NewMongoPrivacyRepository(dbManager *database.DBManager) *MongoPrivacyRepository {
+	return &MongoPrivacyRepository{
+		dbManager: dbManager,
+	}
+}
+
+func (r *MongoPrivacyRepository) Create(ctx context.Context, unionID string, request *model.PrivacyRequest) (*model.PrivacyRequest, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, privacyRequestCollection)
+	if err != nil {
+		return nil, err
+	}
+	request.ID = primitive.NewObjectID()
+	request.CreatedOn = time.Now()
+	if _, err := collection.InsertOne(ctx, request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func (r *MongoPrivacyRepository) GetByID(ctx context.Context, unionID string, id primitive.ObjectID) (*model.PrivacyRequest, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, privacyRequestCollection)
+	if err != nil {
+		return nil, err
+	}
+	var request model.PrivacyRequest
+	if err := collection.FindOne(ctx, bson.M{"_id": id}).Decode(&request); err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+// Find returns the requests of a union, newest first
+func (r *MongoPrivacyRepository) Find(ctx context.Context, unionID string, filter interface{}) ([]*model.PrivacyRequest, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, privacyRequestCollection)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := collection.Find(ctx, filter, options.Find().SetSort(bson.M{"createdOn": -1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	requests := []*model.PrivacyRequest{}
+	if err := cursor.All(ctx, &requests); err != nil {
+		return nil, err
+	}
+	return requests, nil
+}
+
+// SetStatus moves a request to status and appends the change to its history
+func (r *MongoPrivacyRepository) SetStatus(ctx context.Context, unionID string, id primitive.ObjectID, status string, note string, fields bson.M) error {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, privacyRequestCollection)
+	if err != nil {
+		return err
+	}
+	set := bson.M{"status": status}
+	for k, v := range fields {
+		set[k] = v
+	}
+	update := bson.M{
+		"$set":  set,
+		"$push": bson.M{"history": &model.PrivacyRequestEvent{Status: status, At: time.Now(), Note: note}},
+	}
+	if _, err := collection.UpdateOne(ctx, bson.M{"_id": id}, update); err != nil {
+		return fmt.Errorf("could not update privacy request: %v", err)
+	}
+	return nil
+}
+
+// FindRaw returns untyped documents so exports carry every stored field
+func (r *MongoPrivacyRepository) FindRaw(ctx context.Context, unionID string, collectionName string, filter interface{}) ([]bson.M, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	docs := []bson.M{}
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// Scrub applies an erasure update to the member's document in collectionName
+func (r *MongoPrivacyRepository) Scrub(ctx context.Context, unionID string, collectionName string, id primitive.ObjectID, update interface{}) (int64, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, collectionName)
+	if err != nil {
+		return 0, err
+	}
+	result, err := collection.UpdateOne(ctx, bson.M{"_id": id}, update)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+// ScrubOwned applies an erasure update to every document of collectionName that
+// belongs to the member
+func (r *MongoPrivacyRepository) ScrubOwned(ctx context.Context, unionID string, collectionName string, userID primitive.ObjectID, update interface{}) (int64, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, collectionName)
+	if err != nil {
+		return 0, err
+	}
+	result, err := collection.UpdateMany(ctx, bson.M{"userID": userID}, update)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+// DeleteOwned removes every document of collectionName that belongs to the member
+func (r *MongoPrivacyRepository) DeleteOwned(ctx context.Context, unionID string, collectionName string, userID primitive.ObjectID) (int64, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, collectionName)
+	if err != nil {
+		return 0, err
+	}
+	result, err := collection.DeleteMany(ctx, bson.M{"userID": userID})
+	if err != nil {
+		return 0, err
+	}
+	return result.DeletedCount, nil
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"younified-backend/contracts/user/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// purgeableUsers matches soft-deleted users. The union default user is created
+// deleted so it stays hidden; it is never purged.
+func purgeableUsers() bson.M {
+	return bson.M{"deleted": true, "level": bson.M{"$ne": 5}}
+}
+
+// StampDeletedAt gives soft-deleted users without a recorded deletion time one, so
+// their retention period starts now instead of never
+func (r *MongoUserRepository) StampDeletedAt(ctx context.Context, unionID string, now time.Time) (int64, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, userCollection)
+	if err != nil {
+		return 0, err
+	}
+	filter := purgeableUsers()
+	filter["deletedAt"] = bson.M{"$in": bson.A{nil, time.Time{}}}
+	result, err := collection.UpdateMany(ctx, filter, bson.M{"$set": bson.M{"deletedAt": now}})
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+// FindDeletedBefore returns users soft-deleted before cutoff
+func (r *MongoUserRepository) FindDeletedBefore(ctx context.Context, unionID string, cutoff time.Time) ([]*model.User, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, userCollection)
+	if err != nil {
+		return nil, err
+	}
+	filter := purgeableUsers()
+	filter["deletedAt"] = bson.M{"$lt": cutoff}
+	cursor, err := collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var users []*model.User
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// PurgeUsers permanently removes the given users; only soft-deleted ones are matched
+// so a user restored in the meantime survives
+func (r *MongoUserRepository) PurgeUsers(ctx context.Context, unionID string, ids []primitive.ObjectID) (int64, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, userCollection)
+	if err != nil {
+		return 0, err
+	}
+	filter := purgeableUsers()
+	filter["_id"] = bson.M{"$in": ids}
+	result, err := collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	return result.DeletedCount, nil
+}
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"younified-backend/contracts/user/model"
+	"younified-backend/providers/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	remittanceFormatCollection = "remittance_formats"
+	remittanceImportCollection = "remittance_imports"
+)
+
+type MongoRemittanceRepository struct {
+	dbManager *database.DBManager
+}
+
+func NewMongoRemittanceRepository(dbManager *database.DBManager) *MongoRemittanceRepository {
+	return &MongoRemittanceRepository{
+		dbManager: dbManager,
+	}
+}
+
+func (r *MongoRemittanceRepository) CreateFormat(ctx context.Context, unionID string, format *model.RemittanceFormat) (*model.RemittanceFormat, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, remittanceFormatCollection)
+	if err != nil {
+		return nil, err
+	}
+	format.ID = primitive.NewObjectID()
+	format.CreatedOn = time.Now()
+	if _, err := collection.InsertOne(ctx, format); err != nil {
+		return nil, err
+	}
+	return format, nil
+}
+
+// ReplaceFormat stores an edited format
+func (r *MongoRemittanceRepository) ReplaceFormat(ctx context.Context, unionID string, format *model.RemittanceFormat) (*model.RemittanceFormat, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, remittanceFormatCollection)
+	if err != nil {
+		return nil, err
+	}
+	format.UpdatedOn = time.Now()
+	if _, err := collection.ReplaceOne(ctx, bson.M{"_id": format.ID}, format); err != nil {
+		return nil, err
+	}
+	return format, nil
+}
+
+func (r *MongoRemittanceRepository) GetFormat(ctx context.Context, unionID string, id primitive.ObjectID) (*model.RemittanceFormat, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, remittanceFormatCollection)
+	if err != nil {
+		return nil, err
+	}
+	var format model.RemittanceFormat
+	if err := collection.FindOne(ctx, bson.M{"_id": id}).Decode(&format); err != nil {
+		return nil, err
+	}
+	return &format, nil
+}
+
+func (r *MongoRemittanceRepository) Formats(ctx context.Context, unionID string) ([]*model.RemittanceFormat, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, remittanceFormatCollection)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.M{"name": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	formats := []*model.RemittanceFormat{}
+	if err := cursor.All(ctx, &formats); err != nil {
+		return nil, err
+	}
+	return formats, nil
+}
+
+func (r *MongoRemittanceRepository) CreateImport(ctx context.Context, unionID string, record *model.RemittanceImport) (*model.RemittanceImport, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, remittanceImportCollection)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := collection.InsertOne(ctx, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// FindPostedImport returns the earlier import that posted the same file for the
+// period, or nil when there is none
+func (r *MongoRemittanceRepository) FindPostedImport(ctx context.Context, unionID string, formatID primitive.ObjectID, period string, fileHash string) (*model.RemittanceImport, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, remittanceImportCollection)
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{"formatID": formatID, "period": period, "fileHash": fileHash, "dryRun": false}
+	var record model.RemittanceImport
+	err = collection.FindOne(ctx, filter).Decode(&record)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+func (r *MongoRemittanceRepository) GetImport(ctx context.Context, unionID string, id primitive.ObjectID) (*model.RemittanceImport, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, remittanceImportCollection)
+	if err != nil {
+		return nil, err
+	}
+	var record model.RemittanceImport
+	if err := collection.FindOne(ctx, bson.M{"_id": id}).Decode(&record); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// Imports returns the imports matching filter, newest first
+func (r *MongoRemittanceRepository) Imports(ctx context.Context, unionID string, filter bson.M) ([]*model.RemittanceImport, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, remittanceImportCollection)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := collection.Find(ctx, filter, options.Find().SetSort(bson.M{"importedOn": -1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	records := []*model.RemittanceImport{}
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"time"
+	unionModel "younified-backend/contracts/union/model"
 	"younified-backend/contracts/user/model"
 	"younified-backend/providers/database"
 
@@ -16,6 +17,7 @@ import (
 
 const userCollection = "users"
 const memberCollection = "members"
+const unionCollection = "unions"
 
 type MongoUserRepository struct {
 	dbManager  *database.DBManager
@@ -151,6 +153,25 @@ func (r *MongoUserRepository) UpdateUser(ctx context.Context, unionID string, fi
 	return &updatedUser, nil
 }
 
+// UpdateMember updates a registration waiting in the member collection
+func (r *MongoUserRepository) UpdateMember(ctx context.Context, unionID string, filter interface{}, update interface{}) (*model.User, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, memberCollection)
+	if err != nil {
+		return nil, err
+	}
+	result := collection.FindOneAndUpdate(
+		ctx,
+		filter,
+		update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	)
+	var member model.User
+	if err := result.Decode(&member); err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
 func (r *MongoUserRepository) Delete(ctx context.Context, unionID string, id primitive.ObjectID) error {
 	collection, _ := r.dbManager.GetCollection(ctx, unionID, userCollection)
 
@@ -246,3 +267,36 @@ func (r *MongoUserRepository) Count(ctx context.Context, unionID string, filter
 
 	return collection.CountDocuments(ctx, findFilter)
 }
+
+// GetUnion reads the union document from the base database for branding and naming
+func (r *MongoUserRepository) GetUnion(ctx context.Context, unionID primitive.ObjectID) (*unionModel.Union, error) {
+	collection := r.dbManager.GetBaseDatabase(ctx).Collection(unionCollection)
+	var union unionModel.Union
+	err := collection.FindOne(ctx, bson.M{"_id": unionID}).Decode(&union)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &union, nil
+}
+
+// GetByIDs returns the users of a union among ids, in no particular order
+func (r *MongoUserRepository) GetByIDs(ctx context.Context, unionID string, ids []primitive.ObjectID) ([]*model.User, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, userCollection)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := collection.Find(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	users := []*model.User{}
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
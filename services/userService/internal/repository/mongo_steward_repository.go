@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"younified-backend/contracts/user/model"
+	"younified-backend/providers/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const stewardAssignmentCollection = "steward_assignments"
+
+type MongoStewardRepository struct {
+	dbManager *database.DBManager
+}
+
+func NewMongoStewardRepository(dbManager *database.DBManager) *MongoStewardRepository {
+	return &MongoStewardRepository{
+		dbManager: dbManager,
+	}
+}
+
+func (r *MongoStewardRepository) CreateAssignment(ctx context.Context, unionID string, assignment *model.StewardAssignment) (*model.StewardAssignment, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, stewardAssignmentCollection)
+	if err != nil {
+		return nil, err
+	}
+	assignment.ID = primitive.NewObjectID()
+	assignment.CreatedOn = time.Now()
+	if _, err := collection.InsertOne(ctx, assignment); err != nil {
+		return nil, err
+	}
+	return assignment, nil
+}
+
+func (r *MongoStewardRepository) UpdateAssignment(ctx context.Context, unionID string, id primitive.ObjectID, set bson.M) (*model.StewardAssignment, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, stewardAssignmentCollection)
+	if err != nil {
+		return nil, err
+	}
+	set["updatedOn"] = time.Now()
+	var assignment model.StewardAssignment
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	if err := collection.FindOneAndUpdate(ctx, bson.M{"_id": id}, bson.M{"$set": set}, opts).Decode(&assignment); err != nil {
+		return nil, err
+	}
+	return &assignment, nil
+}
+
+func (r *MongoStewardRepository) GetAssignment(ctx context.Context, unionID string, id primitive.ObjectID) (*model.StewardAssignment, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, stewardAssignmentCollection)
+	if err != nil {
+		return nil, err
+	}
+	var assignment model.StewardAssignment
+	if err := collection.FindOne(ctx, bson.M{"_id": id}).Decode(&assignment); err != nil {
+		return nil, err
+	}
+	return &assignment, nil
+}
+
+// Assignments returns the assignments matching filter, oldest first
+func (r *MongoStewardRepository) Assignments(ctx context.Context, unionID string, filter bson.M) ([]*model.StewardAssignment, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, stewardAssignmentCollection)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := collection.Find(ctx, filter, options.Find().SetSort(bson.M{"createdOn": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	assignments := []*model.StewardAssignment{}
+	if err := cursor.All(ctx, &assignments); err != nil {
+		return nil, err
+	}
+	return assignments, nil
+}
+
+func (r *MongoStewardRepository) DeleteAssignments(ctx context.Context, unionID string, filter bson.M) error {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, stewardAssignmentCollection)
+	if err != nil {
+		return err
+	}
+	_, err = collection.DeleteMany(ctx, filter)
+	return err
+}
+
+// SetSteward grants or withdraws the steward role of a user
+func (r *MongoStewardRepository) SetSteward(ctx context.Context, unionID string, userID primitive.ObjectID, steward bool) error {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, userCollection)
+	if err != nil {
+		return err
+	}
+	update := bson.M{"$set": bson.M{"steward": true}}
+	if !steward {
+		update = bson.M{"$unset": bson.M{"steward": ""}}
+	}
+	_, err = collection.UpdateOne(ctx, bson.M{"_id": userID}, update)
+	return err
+}
+
+// Members returns the users matching filter that are not deleted
+func (r *MongoStewardRepository) Members(ctx context.Context, unionID string, filter bson.M) ([]*model.User, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, userCollection)
+	if err != nil {
+		return nil, err
+	}
+	filter["deleted"] = bson.M{"$ne": true}
+	cursor, err := collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	users := []*model.User{}
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"younified-backend/contracts/user/model"
+	"younified-backend/providers/database"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	strikePeriodCollection     = "strike_periods"
+	picketAttendanceCollection = "picket_attendance"
+	strikePaymentCollection    = "strike_payments"
+)
+
+type MongoStrikeRepository struct {
+	dbManager *database.DBManager
+}
+
+func NewMongoStrikeRepository(dbManager *database.DBManager) *MongoStrikeRepository {
+	return &MongoStrikeRepository{
+		dbManager: dbManager,
+	}
+}
+
+func (r *MongoStrikeRepository) CreatePeriod(ctx context.Context, unionID string, period *model.StrikePeriod) (*model.StrikePeriod, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, strikePeriodCollection)
+	if err != nil {
+		return nil, err
+	}
+	period.ID = primitive.NewObjectID()
+	period.CreatedOn = time.Now()
+	if _, err := collection.InsertOne(ctx, period); err != nil {
+		return nil, err
+	}
+	return period, nil
+}
+
+func (r *MongoStrikeRepository) UpdatePeriod(ctx context.Context, unionID string, id primitive.ObjectID, set bson.M) (*model.StrikePeriod, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, strikePeriodCollection)
+	if err != nil {
+		return nil, err
+	}
+	set["updatedOn"] = time.Now()
+	var period model.StrikePeriod
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	if err := collection.FindOneAndUpdate(ctx, bson.M{"_id": id}, bson.M{"$set": set}, opts).Decode(&period); err != nil {
+		return nil, err
+	}
+	return &period, nil
+}
+
+func (r *MongoStrikeRepository) GetPeriod(ctx context.Context, unionID string, id primitive.ObjectID) (*model.StrikePeriod, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, strikePeriodCollection)
+	if err != nil {
+		return nil, err
+	}
+	var period model.StrikePeriod
+	if err := collection.FindOne(ctx, bson.M{"_id": id}).Decode(&period); err != nil {
+		return nil, err
+	}
+	return &period, nil
+}
+
+// Periods returns the strike periods of a union, latest first
+func (r *MongoStrikeRepository) Periods(ctx context.Context, unionID string) ([]*model.StrikePeriod, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, strikePeriodCollection)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.M{"startDate": -1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	periods := []*model.StrikePeriod{}
+	if err := cursor.All(ctx, &periods); err != nil {
+		return nil, err
+	}
+	return periods, nil
+}
+
+// RecordAttendance stores the attendance of a member on a day, replacing what was
+// recorded for that day before
+func (r *MongoStrikeRepository) RecordAttendance(ctx context.Context, unionID string, attendance *model.PicketAttendance) (*model.PicketAttendance, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, picketAttendanceCollection)
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{"strikeID": attendance.StrikeID, "userID": attendance.UserID, "date": attendance.Date}
+	attendance.RecordedOn = time.Now()
+	update := bson.M{
+		"$set":         attendance,
+		"$setOnInsert": bson.M{"_id": primitive.NewObjectID()},
+	}
+	var stored model.PicketAttendance
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	if err := collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&stored); err != nil {
+		return nil, fmt.Errorf("could not record attendance: %v", err)
+	}
+	return &stored, nil
+}
+
+// Attendance returns the attendance matching filter ordered by day
+func (r *MongoStrikeRepository) Attendance(ctx context.Context, unionID string, filter bson.M) ([]*model.PicketAttendance, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, picketAttendanceCollection)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := collection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "date", Value: 1}, {Key: "userID", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	attendance := []*model.PicketAttendance{}
+	if err := cursor.All(ctx, &attendance); err != nil {
+		return nil, err
+	}
+	return attendance, nil
+}
+
+// SavePayment stores the computed entitlement of a member for a week. A payment that
+// was approved or exported in the meantime is left as it is and returned.
+func (r *MongoStrikeRepository) SavePayment(ctx context.Context, unionID string, payment *model.StrikePayment) (*model.StrikePayment, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, strikePaymentCollection)
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{
+		"strikeID":  payment.StrikeID,
+		"userID":    payment.UserID,
+		"weekStart": payment.WeekStart,
+	}
+	computed := bson.M{
+		"unionID":      payment.UnionID,
+		"daysAttended": payment.DaysAttended,
+		"daysPaid":     payment.DaysPaid,
+		"amount":       payment.Amount,
+		"status":       model.StrikePaymentPending,
+		"computedOn":   payment.ComputedOn,
+	}
+	if !payment.CaptainID.IsZero() {
+		computed["captainID"] = payment.CaptainID
+	}
+	// the check and the write are one update, so an approval in between is never undone
+	pending := bson.M{"$eq": bson.A{bson.M{"$ifNull": bson.A{"$status", model.StrikePaymentPending}}, model.StrikePaymentPending}}
+	set := bson.M{}
+	for field, value := range computed {
+		set[field] = bson.M{"$cond": bson.A{pending, bson.M{"$literal": value}, "$" + field}}
+	}
+	update := bson.A{bson.M{"$set": set}}
+	var stored model.StrikePayment
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	if err := collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&stored); err != nil {
+		return nil, err
+	}
+	return &stored, nil
+}
+
+// Payments returns the payments matching filter ordered by week and member
+func (r *MongoStrikeRepository) Payments(ctx context.Context, unionID string, filter bson.M) ([]*model.StrikePayment, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, strikePaymentCollection)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := collection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "weekStart", Value: 1}, {Key: "userID", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	payments := []*model.StrikePayment{}
+	if err := cursor.All(ctx, &payments); err != nil {
+		return nil, err
+	}
+	return payments, nil
+}
+
+// SetPaymentStatus moves the payments of the strike in ids that are currently in status
+// from to status to and returns how many moved
+func (r *MongoStrikeRepository) SetPaymentStatus(ctx context.Context, unionID string, strikeID primitive.ObjectID, ids []primitive.ObjectID, from string, to string, set bson.M) (int64, error) {
+	collection, err := r.dbManager.GetCollection(ctx, unionID, strikePaymentCollection)
+	if err != nil {
+		return 0, err
+	}
+	if set == nil {
+		set = bson.M{}
+	}
+	set["status"] = to
+	result, err := collection.UpdateMany(ctx, bson.M{"_id": bson.M{"$in": ids}, "strikeID": strikeID, "status": from}, bson.M{"$set": set})
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
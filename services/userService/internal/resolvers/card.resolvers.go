@@ -0,0 +1,22 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"younified-backend/contracts/user/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MembershipCard is the resolver for the membershipCard field.
+func (r *queryResolver) MembershipCard(ctx context.Context, id primitive.ObjectID, unionID primitive.ObjectID) (*model.MembershipCard, error) {
+	return r.UserController.MembershipCard(ctx, id, unionID)
+}
+
+// VerifyMembership is the resolver for the verifyMembership field.
+func (r *queryResolver) VerifyMembership(ctx context.Context, token string) (*model.MembershipVerification, error) {
+	return r.UserController.VerifyMembership(ctx, token)
+}
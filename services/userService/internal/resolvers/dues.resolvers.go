@@ -0,0 +1,63 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"time"
+	"younified-backend/contracts/user/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CreateDuesSchedule is the resolver for the createDuesSchedule field.
+func (r *mutationResolver) CreateDuesSchedule(ctx context.Context, unionID primitive.ObjectID, input model.DuesScheduleInput) (*model.DuesSchedule, error) {
+	return r.UserController.CreateDuesSchedule(ctx, unionID, input)
+}
+
+// UpdateDuesSchedule is the resolver for the updateDuesSchedule field.
+func (r *mutationResolver) UpdateDuesSchedule(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID, input model.DuesScheduleInput) (*model.DuesSchedule, error) {
+	return r.UserController.UpdateDuesSchedule(ctx, unionID, id, input)
+}
+
+// AssessDues is the resolver for the assessDues field.
+func (r *mutationResolver) AssessDues(ctx context.Context, unionID primitive.ObjectID, period string, earnings []*model.MemberEarningsInput) (*model.DuesAssessmentReport, error) {
+	return r.UserController.AssessDues(ctx, unionID, period, earnings)
+}
+
+// RecordDuesEntry is the resolver for the recordDuesEntry field.
+func (r *mutationResolver) RecordDuesEntry(ctx context.Context, unionID primitive.ObjectID, input model.DuesEntryInput) (*model.DuesLedgerEntry, error) {
+	return r.UserController.RecordDuesEntry(ctx, unionID, input)
+}
+
+// EmailDuesStatement is the resolver for the emailDuesStatement field.
+func (r *mutationResolver) EmailDuesStatement(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID, from time.Time, to time.Time) (*string, error) {
+	return r.UserController.EmailDuesStatement(ctx, unionID, userID, from, to)
+}
+
+// DuesSchedules is the resolver for the duesSchedules field.
+func (r *queryResolver) DuesSchedules(ctx context.Context, unionID primitive.ObjectID) ([]*model.DuesSchedule, error) {
+	return r.UserController.DuesSchedules(ctx, unionID)
+}
+
+// DuesLedger is the resolver for the duesLedger field.
+func (r *queryResolver) DuesLedger(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID) ([]*model.DuesLedgerEntry, error) {
+	return r.UserController.DuesLedger(ctx, unionID, userID)
+}
+
+// DuesStanding is the resolver for the duesStanding field.
+func (r *queryResolver) DuesStanding(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID) (*model.DuesStanding, error) {
+	return r.UserController.DuesStanding(ctx, unionID, userID)
+}
+
+// DuesArrears is the resolver for the duesArrears field.
+func (r *queryResolver) DuesArrears(ctx context.Context, unionID primitive.ObjectID, minPeriods *int) ([]*model.DuesArrears, error) {
+	return r.UserController.DuesArrears(ctx, unionID, minPeriods)
+}
+
+// DuesStatement is the resolver for the duesStatement field.
+func (r *queryResolver) DuesStatement(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID, from time.Time, to time.Time) (*model.DuesStatement, error) {
+	return r.UserController.DuesStatement(ctx, unionID, userID, from, to)
+}
@@ -0,0 +1,26 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"younified-backend/contracts/user/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// FindManyUserByIDs is the resolver for the findManyUserByIDs field.
+func (r *entityResolver) FindManyUserByIDs(ctx context.Context, reps []*model.UserByIDsInput) ([]*model.User, error) {
+	ids := make([]primitive.ObjectID, len(reps))
+	for i, rep := range reps {
+		ids[i] = rep.ID
+	}
+	return r.UserController.UsersByIDs(ctx, ids)
+}
+
+// Entity returns EntityResolver implementation.
+func (r *Resolver) Entity() EntityResolver { return &entityResolver{r} }
+
+type entityResolver struct{ *Resolver }
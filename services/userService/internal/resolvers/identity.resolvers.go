@@ -0,0 +1,37 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"younified-backend/contracts/user/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RequestEmailVerification is the resolver for the requestEmailVerification field.
+func (r *mutationResolver) RequestEmailVerification(ctx context.Context, id primitive.ObjectID, unionID primitive.ObjectID) (*string, error) {
+	return r.UserController.RequestEmailVerification(ctx, id, unionID)
+}
+
+// VerifyEmail is the resolver for the verifyEmail field.
+func (r *mutationResolver) VerifyEmail(ctx context.Context, id primitive.ObjectID, unionID primitive.ObjectID, code string) (*model.User, error) {
+	return r.UserController.VerifyEmail(ctx, id, unionID, code)
+}
+
+// SwitchUnion is the resolver for the switchUnion field.
+func (r *mutationResolver) SwitchUnion(ctx context.Context, unionID primitive.ObjectID) (*model.SingleUserAuth, error) {
+	return r.UserController.SwitchUnion(ctx, unionID)
+}
+
+// MyMemberships is the resolver for the myMemberships field.
+func (r *queryResolver) MyMemberships(ctx context.Context) ([]*model.UnionMembership, error) {
+	return r.UserController.MyMemberships(ctx)
+}
+
+// IdentitiesByEmployeeID is the resolver for the identitiesByEmployeeID field.
+func (r *queryResolver) IdentitiesByEmployeeID(ctx context.Context, employeeID string) ([]*model.Identity, error) {
+	return r.UserController.IdentitiesByEmployeeID(ctx, employeeID)
+}
@@ -0,0 +1,27 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"younified-backend/contracts/user/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ChangeMemberStatus is the resolver for the changeMemberStatus field.
+func (r *mutationResolver) ChangeMemberStatus(ctx context.Context, id primitive.ObjectID, unionID primitive.ObjectID, input model.StatusTransitionInput) (*model.User, error) {
+	return r.UserController.ChangeMemberStatus(ctx, id, unionID, input)
+}
+
+// StatusTimeline is the resolver for the statusTimeline field.
+func (r *queryResolver) StatusTimeline(ctx context.Context, id primitive.ObjectID, unionID primitive.ObjectID) ([]*model.StatusChange, error) {
+	return r.UserController.StatusTimeline(ctx, id, unionID)
+}
+
+// AllowedStatusTransitions is the resolver for the allowedStatusTransitions field.
+func (r *queryResolver) AllowedStatusTransitions(ctx context.Context, id primitive.ObjectID, unionID primitive.ObjectID) ([]string, error) {
+	return r.UserController.AllowedStatusTransitions(ctx, id, unionID)
+}
@@ -0,0 +1,32 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"younified-backend/contracts/user/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// StartReengagementCampaign is the resolver for the startReengagementCampaign field.
+func (r *mutationResolver) StartReengagementCampaign(ctx context.Context, unionID primitive.ObjectID, days *int, subject string, content string) (*model.ReengagementCampaign, error) {
+	return r.UserController.StartReengagementCampaign(ctx, unionID, days, subject, content)
+}
+
+// LoginHistory is the resolver for the loginHistory field.
+func (r *queryResolver) LoginHistory(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID, limit *int) ([]*model.LoginEvent, error) {
+	return r.UserController.LoginHistory(ctx, unionID, userID, limit)
+}
+
+// InactiveMembers is the resolver for the inactiveMembers field.
+func (r *queryResolver) InactiveMembers(ctx context.Context, unionID primitive.ObjectID, days *int) (*model.InactiveMemberReport, error) {
+	return r.UserController.InactiveMembers(ctx, unionID, days)
+}
+
+// ReengagementCampaigns is the resolver for the reengagementCampaigns field.
+func (r *queryResolver) ReengagementCampaigns(ctx context.Context, unionID primitive.ObjectID) ([]*model.ReengagementCampaign, error) {
+	return r.UserController.ReengagementCampaigns(ctx, unionID)
+}
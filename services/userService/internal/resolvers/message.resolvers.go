@@ -0,0 +1,37 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"younified-backend/contracts/user/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SetMessageOverride is the resolver for the setMessageOverride field.
+func (r *mutationResolver) SetMessageOverride(ctx context.Context, unionID primitive.ObjectID, locale string, key string, text string) (*model.MessageOverride, error) {
+	return r.UserController.SetMessageOverride(ctx, unionID, locale, key, text)
+}
+
+// RemoveMessageOverride is the resolver for the removeMessageOverride field.
+func (r *mutationResolver) RemoveMessageOverride(ctx context.Context, unionID primitive.ObjectID, locale string, key string) (string, error) {
+	return r.UserController.RemoveMessageOverride(ctx, unionID, locale, key)
+}
+
+// SetPreferredLanguage is the resolver for the setPreferredLanguage field.
+func (r *mutationResolver) SetPreferredLanguage(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID, locale string) (*model.User, error) {
+	return r.UserController.SetPreferredLanguage(ctx, unionID, userID, locale)
+}
+
+// SupportedLocales is the resolver for the supportedLocales field.
+func (r *queryResolver) SupportedLocales(ctx context.Context) ([]string, error) {
+	return r.UserController.SupportedLocales(ctx)
+}
+
+// MessageCatalogue is the resolver for the messageCatalogue field.
+func (r *queryResolver) MessageCatalogue(ctx context.Context, unionID primitive.ObjectID, locale string) ([]*model.CatalogueMessage, error) {
+	return r.UserController.MessageCatalogue(ctx, unionID, locale)
+}
@@ -0,0 +1,43 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"time"
+	"younified-backend/contracts/user/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CreateMilestoneRule is the resolver for the createMilestoneRule field.
+func (r *mutationResolver) CreateMilestoneRule(ctx context.Context, unionID primitive.ObjectID, input model.MilestoneRuleInput) (*model.MilestoneRule, error) {
+	return r.UserController.CreateMilestoneRule(ctx, unionID, input)
+}
+
+// UpdateMilestoneRule is the resolver for the updateMilestoneRule field.
+func (r *mutationResolver) UpdateMilestoneRule(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID, input model.MilestoneRuleInput) (*model.MilestoneRule, error) {
+	return r.UserController.UpdateMilestoneRule(ctx, unionID, id, input)
+}
+
+// DeleteMilestoneRule is the resolver for the deleteMilestoneRule field.
+func (r *mutationResolver) DeleteMilestoneRule(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID) (string, error) {
+	return r.UserController.DeleteMilestoneRule(ctx, unionID, id)
+}
+
+// ExportMilestoneReport is the resolver for the exportMilestoneReport field.
+func (r *mutationResolver) ExportMilestoneReport(ctx context.Context, unionID primitive.ObjectID, ruleID primitive.ObjectID, from time.Time, to time.Time) (*string, error) {
+	return r.UserController.ExportMilestoneReport(ctx, unionID, ruleID, from, to)
+}
+
+// MilestoneRules is the resolver for the milestoneRules field.
+func (r *queryResolver) MilestoneRules(ctx context.Context, unionID primitive.ObjectID) ([]*model.MilestoneRule, error) {
+	return r.UserController.MilestoneRules(ctx, unionID)
+}
+
+// MilestoneReport is the resolver for the milestoneReport field.
+func (r *queryResolver) MilestoneReport(ctx context.Context, unionID primitive.ObjectID, ruleID primitive.ObjectID, from time.Time, to time.Time) (*model.MilestoneReport, error) {
+	return r.UserController.MilestoneReport(ctx, unionID, ruleID, from, to)
+}
@@ -0,0 +1,23 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"younified-backend/contracts/user/model"
+
+	"github.com/99designs/gqlgen/graphql"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// UploadProfilePhoto is the resolver for the uploadProfilePhoto field.
+func (r *mutationResolver) UploadProfilePhoto(ctx context.Context, id primitive.ObjectID, unionID primitive.ObjectID, file graphql.Upload) (*model.User, error) {
+	return r.UserController.UploadProfilePhoto(ctx, id, unionID, file)
+}
+
+// RemoveProfilePhoto is the resolver for the removeProfilePhoto field.
+func (r *mutationResolver) RemoveProfilePhoto(ctx context.Context, id primitive.ObjectID, unionID primitive.ObjectID) (*model.User, error) {
+	return r.UserController.RemoveProfilePhoto(ctx, id, unionID)
+}
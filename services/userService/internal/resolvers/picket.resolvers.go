@@ -0,0 +1,88 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"time"
+	"younified-backend/contracts/user/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CreatePicketSite is the resolver for the createPicketSite field.
+func (r *mutationResolver) CreatePicketSite(ctx context.Context, unionID primitive.ObjectID, input model.PicketSiteInput) (*model.PicketSite, error) {
+	return r.UserController.CreatePicketSite(ctx, unionID, input)
+}
+
+// UpdatePicketSite is the resolver for the updatePicketSite field.
+func (r *mutationResolver) UpdatePicketSite(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID, input model.PicketSiteInput) (*model.PicketSite, error) {
+	return r.UserController.UpdatePicketSite(ctx, unionID, id, input)
+}
+
+// CreatePicketShift is the resolver for the createPicketShift field.
+func (r *mutationResolver) CreatePicketShift(ctx context.Context, unionID primitive.ObjectID, input model.PicketShiftInput) (*model.PicketShift, error) {
+	return r.UserController.CreatePicketShift(ctx, unionID, input)
+}
+
+// UpdatePicketShift is the resolver for the updatePicketShift field.
+func (r *mutationResolver) UpdatePicketShift(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID, input model.PicketShiftInput) (*model.PicketShift, error) {
+	return r.UserController.UpdatePicketShift(ctx, unionID, id, input)
+}
+
+// SignUpForShift is the resolver for the signUpForShift field.
+func (r *mutationResolver) SignUpForShift(ctx context.Context, unionID primitive.ObjectID, shiftID primitive.ObjectID, userID primitive.ObjectID) (*model.PicketShift, error) {
+	return r.UserController.SignUpForShift(ctx, unionID, shiftID, userID)
+}
+
+// WithdrawFromShift is the resolver for the withdrawFromShift field.
+func (r *mutationResolver) WithdrawFromShift(ctx context.Context, unionID primitive.ObjectID, shiftID primitive.ObjectID, userID primitive.ObjectID) (*model.PicketShift, error) {
+	return r.UserController.WithdrawFromShift(ctx, unionID, shiftID, userID)
+}
+
+// RequestShiftSwap is the resolver for the requestShiftSwap field.
+func (r *mutationResolver) RequestShiftSwap(ctx context.Context, unionID primitive.ObjectID, shiftID primitive.ObjectID, fromUserID primitive.ObjectID, toUserID primitive.ObjectID) (*model.ShiftSwap, error) {
+	return r.UserController.RequestShiftSwap(ctx, unionID, shiftID, fromUserID, toUserID)
+}
+
+// RespondToShiftSwap is the resolver for the respondToShiftSwap field.
+func (r *mutationResolver) RespondToShiftSwap(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID, userID primitive.ObjectID, accept bool) (*model.ShiftSwap, error) {
+	return r.UserController.RespondToShiftSwap(ctx, unionID, id, userID, accept)
+}
+
+// CheckInToShift is the resolver for the checkInToShift field.
+func (r *mutationResolver) CheckInToShift(ctx context.Context, unionID primitive.ObjectID, shiftID primitive.ObjectID, userID primitive.ObjectID) (*model.PicketShift, error) {
+	return r.UserController.CheckInToShift(ctx, unionID, shiftID, userID)
+}
+
+// CheckOutOfShift is the resolver for the checkOutOfShift field.
+func (r *mutationResolver) CheckOutOfShift(ctx context.Context, unionID primitive.ObjectID, shiftID primitive.ObjectID, userID primitive.ObjectID) (*model.PicketShift, error) {
+	return r.UserController.CheckOutOfShift(ctx, unionID, shiftID, userID)
+}
+
+// PicketSites is the resolver for the picketSites field.
+func (r *queryResolver) PicketSites(ctx context.Context, unionID primitive.ObjectID, strikeID *primitive.ObjectID) ([]*model.PicketSite, error) {
+	return r.UserController.PicketSites(ctx, unionID, strikeID)
+}
+
+// PicketShifts is the resolver for the picketShifts field.
+func (r *queryResolver) PicketShifts(ctx context.Context, unionID primitive.ObjectID, siteID *primitive.ObjectID, from *time.Time, to *time.Time) ([]*model.PicketShift, error) {
+	return r.UserController.PicketShifts(ctx, unionID, siteID, from, to)
+}
+
+// MyPicketShifts is the resolver for the myPicketShifts field.
+func (r *queryResolver) MyPicketShifts(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID) ([]*model.PicketShift, error) {
+	return r.UserController.MyPicketShifts(ctx, unionID, userID)
+}
+
+// ShiftSwapRequests is the resolver for the shiftSwapRequests field.
+func (r *queryResolver) ShiftSwapRequests(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID) ([]*model.ShiftSwap, error) {
+	return r.UserController.ShiftSwapRequests(ctx, unionID, userID)
+}
+
+// ShiftCoverage is the resolver for the shiftCoverage field.
+func (r *queryResolver) ShiftCoverage(ctx context.Context, unionID primitive.ObjectID, from time.Time, to time.Time, siteID *primitive.ObjectID, gapsOnly *bool) ([]*model.ShiftCoverage, error) {
+	return r.UserController.ShiftCoverage(ctx, unionID, from, to, siteID, gapsOnly)
+}
@@ -0,0 +1,32 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"younified-backend/contracts/user/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RequestDataExport is the resolver for the requestDataExport field.
+func (r *mutationResolver) RequestDataExport(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID, reason *string) (*model.PrivacyRequest, error) {
+	return r.UserController.RequestDataExport(ctx, unionID, userID, reason)
+}
+
+// RequestErasure is the resolver for the requestErasure field.
+func (r *mutationResolver) RequestErasure(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID, reason *string) (*model.PrivacyRequest, error) {
+	return r.UserController.RequestErasure(ctx, unionID, userID, reason)
+}
+
+// PrivacyRequests is the resolver for the privacyRequests field.
+func (r *queryResolver) PrivacyRequests(ctx context.Context, unionID primitive.ObjectID, userID *primitive.ObjectID) ([]*model.PrivacyRequest, error) {
+	return r.UserController.PrivacyRequests(ctx, unionID, userID)
+}
+
+// PrivacyRequest is the resolver for the privacyRequest field.
+func (r *queryResolver) PrivacyRequest(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID) (*model.PrivacyRequest, error) {
+	return r.UserController.PrivacyRequest(ctx, unionID, id)
+}
@@ -0,0 +1,17 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"younified-backend/contracts/user/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PurgeDeletedUsers is the resolver for the purgeDeletedUsers field.
+func (r *mutationResolver) PurgeDeletedUsers(ctx context.Context, unionID primitive.ObjectID, dryRun bool) (*model.PurgeReport, error) {
+	return r.UserController.PurgeDeletedUsers(ctx, unionID, dryRun)
+}
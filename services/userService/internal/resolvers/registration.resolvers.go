@@ -0,0 +1,22 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"younified-backend/contracts/user/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// VerifyRegistration is the resolver for the verifyRegistration field.
+func (r *mutationResolver) VerifyRegistration(ctx context.Context, unionID *primitive.ObjectID, memberID primitive.ObjectID, code string) (*model.User, error) {
+	return r.UserController.VerifyRegistration(ctx, unionID, memberID, code)
+}
+
+// RequestRegistrationCode is the resolver for the requestRegistrationCode field.
+func (r *mutationResolver) RequestRegistrationCode(ctx context.Context, unionID *primitive.ObjectID, memberID primitive.ObjectID) (*string, error) {
+	return r.UserController.RequestRegistrationCode(ctx, unionID, memberID)
+}
@@ -0,0 +1,43 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"younified-backend/contracts/user/model"
+
+	"github.com/99designs/gqlgen/graphql"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CreateRemittanceFormat is the resolver for the createRemittanceFormat field.
+func (r *mutationResolver) CreateRemittanceFormat(ctx context.Context, unionID primitive.ObjectID, input model.RemittanceFormatInput) (*model.RemittanceFormat, error) {
+	return r.UserController.CreateRemittanceFormat(ctx, unionID, input)
+}
+
+// UpdateRemittanceFormat is the resolver for the updateRemittanceFormat field.
+func (r *mutationResolver) UpdateRemittanceFormat(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID, input model.RemittanceFormatInput) (*model.RemittanceFormat, error) {
+	return r.UserController.UpdateRemittanceFormat(ctx, unionID, id, input)
+}
+
+// ImportRemittance is the resolver for the importRemittance field.
+func (r *mutationResolver) ImportRemittance(ctx context.Context, unionID primitive.ObjectID, formatID primitive.ObjectID, period string, file graphql.Upload, dryRun *bool) (*model.RemittanceImport, error) {
+	return r.UserController.ImportRemittance(ctx, unionID, formatID, period, file, dryRun)
+}
+
+// RemittanceFormats is the resolver for the remittanceFormats field.
+func (r *queryResolver) RemittanceFormats(ctx context.Context, unionID primitive.ObjectID) ([]*model.RemittanceFormat, error) {
+	return r.UserController.RemittanceFormats(ctx, unionID)
+}
+
+// RemittanceImports is the resolver for the remittanceImports field.
+func (r *queryResolver) RemittanceImports(ctx context.Context, unionID primitive.ObjectID, period *string) ([]*model.RemittanceImport, error) {
+	return r.UserController.RemittanceImports(ctx, unionID, period)
+}
+
+// RemittanceImport is the resolver for the remittanceImport field.
+func (r *queryResolver) RemittanceImport(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID) (*model.RemittanceImport, error) {
+	return r.UserController.RemittanceImport(ctx, unionID, id)
+}
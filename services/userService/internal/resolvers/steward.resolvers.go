@@ -0,0 +1,47 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"younified-backend/contracts/user/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// AssignSteward is the resolver for the assignSteward field.
+func (r *mutationResolver) AssignSteward(ctx context.Context, unionID primitive.ObjectID, input model.StewardAssignmentInput) (*model.StewardAssignment, error) {
+	return r.UserController.AssignSteward(ctx, unionID, input)
+}
+
+// UpdateStewardAssignment is the resolver for the updateStewardAssignment field.
+func (r *mutationResolver) UpdateStewardAssignment(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID, input model.StewardAssignmentInput) (*model.StewardAssignment, error) {
+	return r.UserController.UpdateStewardAssignment(ctx, unionID, id, input)
+}
+
+// RemoveStewardAssignment is the resolver for the removeStewardAssignment field.
+func (r *mutationResolver) RemoveStewardAssignment(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID) (string, error) {
+	return r.UserController.RemoveStewardAssignment(ctx, unionID, id)
+}
+
+// MyStewards is the resolver for the myStewards field.
+func (r *queryResolver) MyStewards(ctx context.Context, unionID primitive.ObjectID, userID primitive.ObjectID) (*model.MyStewards, error) {
+	return r.UserController.MyStewards(ctx, unionID, userID)
+}
+
+// StewardDirectory is the resolver for the stewardDirectory field.
+func (r *queryResolver) StewardDirectory(ctx context.Context, unionID primitive.ObjectID, field *string, value *string) ([]*model.StewardContact, error) {
+	return r.UserController.StewardDirectory(ctx, unionID, field, value)
+}
+
+// StewardAssignments is the resolver for the stewardAssignments field.
+func (r *queryResolver) StewardAssignments(ctx context.Context, unionID primitive.ObjectID, stewardID *primitive.ObjectID) ([]*model.StewardAssignment, error) {
+	return r.UserController.StewardAssignments(ctx, unionID, stewardID)
+}
+
+// StewardWorkload is the resolver for the stewardWorkload field.
+func (r *queryResolver) StewardWorkload(ctx context.Context, unionID primitive.ObjectID) (*model.StewardWorkloadReport, error) {
+	return r.UserController.StewardWorkload(ctx, unionID)
+}
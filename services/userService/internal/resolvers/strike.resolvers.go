@@ -0,0 +1,68 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+	"time"
+	"younified-backend/contracts/user/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CreateStrikePeriod is the resolver for the createStrikePeriod field.
+func (r *mutationResolver) CreateStrikePeriod(ctx context.Context, unionID primitive.ObjectID, input model.StrikePeriodInput) (*model.StrikePeriod, error) {
+	return r.UserController.CreateStrikePeriod(ctx, unionID, input)
+}
+
+// UpdateStrikePeriod is the resolver for the updateStrikePeriod field.
+func (r *mutationResolver) UpdateStrikePeriod(ctx context.Context, unionID primitive.ObjectID, id primitive.ObjectID, input model.StrikePeriodInput) (*model.StrikePeriod, error) {
+	return r.UserController.UpdateStrikePeriod(ctx, unionID, id, input)
+}
+
+// RecordPicketAttendance is the resolver for the recordPicketAttendance field.
+func (r *mutationResolver) RecordPicketAttendance(ctx context.Context, unionID primitive.ObjectID, strikeID primitive.ObjectID, input []*model.PicketAttendanceInput) ([]*model.PicketAttendance, error) {
+	return r.UserController.RecordPicketAttendance(ctx, unionID, strikeID, input)
+}
+
+// ComputeStrikePay is the resolver for the computeStrikePay field.
+func (r *mutationResolver) ComputeStrikePay(ctx context.Context, unionID primitive.ObjectID, strikeID primitive.ObjectID, weekStart time.Time) ([]*model.StrikePayment, error) {
+	return r.UserController.ComputeStrikePay(ctx, unionID, strikeID, weekStart)
+}
+
+// ApproveStrikePayments is the resolver for the approveStrikePayments field.
+func (r *mutationResolver) ApproveStrikePayments(ctx context.Context, unionID primitive.ObjectID, strikeID primitive.ObjectID, ids []primitive.ObjectID) ([]*model.StrikePayment, error) {
+	return r.UserController.ApproveStrikePayments(ctx, unionID, strikeID, ids)
+}
+
+// ExportStrikePayments is the resolver for the exportStrikePayments field.
+func (r *mutationResolver) ExportStrikePayments(ctx context.Context, unionID primitive.ObjectID, strikeID primitive.ObjectID) (*string, error) {
+	return r.UserController.ExportStrikePayments(ctx, unionID, strikeID)
+}
+
+// StrikePeriods is the resolver for the strikePeriods field.
+func (r *queryResolver) StrikePeriods(ctx context.Context, unionID primitive.ObjectID) ([]*model.StrikePeriod, error) {
+	return r.UserController.StrikePeriods(ctx, unionID)
+}
+
+// PicketAttendance is the resolver for the picketAttendance field.
+func (r *queryResolver) PicketAttendance(ctx context.Context, unionID primitive.ObjectID, strikeID primitive.ObjectID, userID *primitive.ObjectID, from *time.Time, to *time.Time) ([]*model.PicketAttendance, error) {
+	return r.UserController.PicketAttendance(ctx, unionID, strikeID, userID, from, to)
+}
+
+// StrikePayments is the resolver for the strikePayments field.
+func (r *queryResolver) StrikePayments(ctx context.Context, unionID primitive.ObjectID, strikeID primitive.ObjectID, weekStart *time.Time, status *string) ([]*model.StrikePayment, error) {
+	return r.UserController.StrikePayments(ctx, unionID, strikeID, weekStart, status)
+}
+
+// StrikePayMemberReport is the resolver for the strikePayMemberReport field.
+func (r *queryResolver) StrikePayMemberReport(ctx context.Context, unionID primitive.ObjectID, strikeID primitive.ObjectID, userID primitive.ObjectID) (*model.StrikePayReport, error) {
+	return r.UserController.StrikePayMemberReport(ctx, unionID, strikeID, userID)
+}
+
+// StrikePayCaptainReport is the resolver for the strikePayCaptainReport field.
+func (r *queryResolver) StrikePayCaptainReport(ctx context.Context, unionID primitive.ObjectID, strikeID primitive.ObjectID, captainID primitive.ObjectID) (*model.StrikePayReport, error) {
+	return r.UserController.StrikePayCaptainReport(ctx, unionID, strikeID, captainID)
+}
@@ -21,6 +21,11 @@ func (r *mutationResolver) CreateUser(ctx context.Context, input model.User) (*m
 	return r.UserController.CreateUser(ctx, input)
 }
 
+// ProvisionUser is the resolver for the provisionUser field.
+func (r *mutationResolver) ProvisionUser(ctx context.Context, input model.User, role model.ProvisionedUser) (*model.User, error) {
+	return r.UserController.ProvisionUser(ctx, input, role)
+}
+
 // Login is the resolver for the login field.
 func (r *mutationResolver) Login(ctx context.Context, input *model.Credential, device *string) (*model.SingleUserAuth, error) {
 	return r.UserController.Login(ctx, input, device)
@@ -2,21 +2,29 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"log"
 	"net/http"
 	"os"
 	"strconv"
+	"time"
 
+	"younified-backend/providers/aws"
 	"younified-backend/providers/database"
 
 	"younified-backend/providers/graphqlclient"
 
+	"younified-backend/services/userService/internal/auth"
+	"younified-backend/services/userService/internal/client"
 	controller "younified-backend/services/userService/internal/controller"
+	"younified-backend/services/userService/internal/i18n"
 	resolver "younified-backend/services/userService/internal/resolvers"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/joho/godotenv"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 const (
@@ -25,6 +33,8 @@ const (
 	defaultDatabaseName = "unified_base"
 	defaultRedisHost    = "localhost"
 	defaultRedisPort    = 6379
+	defaultPurgeHours   = 24
+	defaultReminderLead = 12
 )
 
 // Config holds the application configuration
@@ -35,6 +45,9 @@ type Config struct {
 	RedisPort     int
 	RedisPassword string
 	DatabaseName  string
+	awsRegion     string
+	awsAccessID   string
+	awsAccessKey  string
 }
 
 // loadConfiguration reads environment variables and returns a Config
@@ -67,6 +80,12 @@ func loadConfiguration() Config {
 
 	redisPassword := os.Getenv("REDIS_PASSWORD")
 
+	awsAccessKeyID := os.Getenv("AWS_ACCESS_KEY_ID")
+
+	awsAccessKey := os.Getenv("AWS_SECRET_ACCESS_KEY")
+
+	awsRegion := os.Getenv("AWS_REGION")
+
 	return Config{
 		Port:          port,
 		MongoURI:      mongoURI,
@@ -74,6 +93,9 @@ func loadConfiguration() Config {
 		RedisHost:     redisHost,
 		RedisPort:     redisPort,
 		RedisPassword: redisPassword,
+		awsRegion:     awsRegion,
+		awsAccessID:   awsAccessKeyID,
+		awsAccessKey:  awsAccessKey,
 	}
 }
 
@@ -86,7 +108,6 @@ func initializeDatabase(ctx context.Context, config Config) *database.DBManager
 	return dbManager
 }
 
-
 func initializeRedis(config Config) *database.RedisClient {
 	redisClient, err := database.NewRedisClient(database.RedisConfig{Host: config.RedisHost, Port: config.RedisPort})
 	if err != nil {
@@ -96,6 +117,14 @@ func initializeRedis(config Config) *database.RedisClient {
 	return redisClient
 }
 
+func initializeAwsService(config Config) *aws.AWSProvider {
+	awsProvider, err := aws.NewAWSProvider(config.awsRegion, config.awsAccessID, config.awsAccessKey)
+	if err != nil {
+		log.Fatalf("Failed to create a session with aws : %v", err)
+	}
+	return &awsProvider
+}
+
 // initializeGraphQLManager creates a new GraphQL client
 func initializeGraphQLManager() *graphqlclient.Graph {
 	return graphqlclient.NewGraphql()
@@ -104,23 +133,100 @@ func initializeGraphQLManager() *graphqlclient.Graph {
 // createGraphQLServer sets up the GraphQL server with resolvers
 func createGraphQLServer(
 	dbManager *database.DBManager,
-
-	graphqlManager *graphqlclient.Graph,
-	redisClient *database.RedisClient,
-
+	userController *controller.UserController,
 ) *handler.Server {
-	return handler.NewDefaultServer(resolver.NewExecutableSchema(resolver.Config{
+	srv := handler.NewDefaultServer(resolver.NewExecutableSchema(resolver.Config{
 		Resolvers: &resolver.Resolver{
 			DBManager:      dbManager,
-			UserController: controller.NewUserController(dbManager, graphqlManager, redisClient),
+			UserController: userController,
 		},
 	}))
+	// catalogue errors are answered in the caller's language
+	srv.SetErrorPresenter(func(ctx context.Context, err error) *gqlerror.Error {
+		presented := graphql.DefaultErrorPresenter(ctx, err)
+		if e, ok := i18n.AsError(err); ok {
+			presented.Message = userController.LocalizeError(ctx, e)
+		}
+		return presented
+	})
+	return srv
+}
+
+// membershipVerificationHandler is the public endpoint a membership card QR code points at
+func membershipVerificationHandler(userController *controller.UserController) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		result, err := userController.VerifyMembership(r.Context(), r.URL.Query().Get("token"))
+		if err != nil {
+			http.Error(w, "could not verify membership", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		json.NewEncoder(w).Encode(result)
+	}
 }
 
 // setupRoutes configures HTTP routes
-func setupRoutes(srv *handler.Server) {
+func setupRoutes(srv *handler.Server, userController *controller.UserController, domains *database.DomainIndex) {
 	http.Handle("/", playground.Handler("GraphQL playground", "/graphql"))
-	http.Handle("/graphql", srv)
+	http.Handle("/graphql", client.Middleware(domains.Middleware(i18n.Middleware(graphqlclient.ServiceMiddleware(auth.Middleware(userController.SessionActive, srv))))))
+	http.Handle("/membership/verify", membershipVerificationHandler(userController))
+}
+
+// startRetentionPurge applies the unions' retention policies on a fixed interval.
+// RETENTION_PURGE_DRY_RUN=true only logs what would be removed.
+func startRetentionPurge(ctx context.Context, userController *controller.UserController) {
+	hours, err := strconv.Atoi(os.Getenv("RETENTION_PURGE_INTERVAL_HOURS"))
+	if err != nil || hours <= 0 {
+		hours = defaultPurgeHours
+	}
+	dryRun := os.Getenv("RETENTION_PURGE_DRY_RUN") == "true"
+
+	ticker := time.NewTicker(time.Duration(hours) * time.Hour)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			userController.RunRetentionPurge(ctx, dryRun)
+		}
+	}
+}
+
+// startShiftReminders reminds members of their picket shifts ahead of time.
+// SHIFT_REMINDER_LEAD_HOURS sets how far ahead.
+func startShiftReminders(ctx context.Context, userController *controller.UserController) {
+	hours, err := strconv.Atoi(os.Getenv("SHIFT_REMINDER_LEAD_HOURS"))
+	if err != nil || hours <= 0 {
+		hours = defaultReminderLead
+	}
+
+	ticker := time.NewTicker(15 * time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			userController.RunShiftReminders(ctx, time.Duration(hours)*time.Hour)
+		}
+	}
+}
+
+// startMilestoneNotifications emails staff the members reaching a milestone soon.
+// Each rule is reported once a month.
+func startMilestoneNotifications(ctx context.Context, userController *controller.UserController) {
+	ticker := time.NewTicker(6 * time.Hour)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			userController.RunMilestoneNotifications(ctx)
+		}
+	}
 }
 
 // startServer begins listening on the specified port
@@ -144,11 +250,21 @@ func main() {
 	defer redisClient.Close()
 
 	graphqlManager := initializeGraphQLManager()
+
+	awsProvider := initializeAwsService(config)
+
+	userController := controller.NewUserController(dbManager, graphqlManager, redisClient, awsProvider)
+
+	go startRetentionPurge(ctx, userController)
+	go startShiftReminders(ctx, userController)
+	go startMilestoneNotifications(ctx, userController)
+	go userController.ListenForSettings(ctx)
+
 	// Create GraphQL server
-	srv := createGraphQLServer(dbManager, graphqlManager, redisClient)
+	srv := createGraphQLServer(dbManager, userController)
 
 	// Setup routes
-	setupRoutes(srv)
+	setupRoutes(srv, userController, database.NewDomainIndex(dbManager, redisClient))
 
 	// Start server
 	startServer(config.Port)